@@ -39,6 +39,8 @@ const (
 	MimetypeDataWithValidator = "data/validator"
 	MimetypeTypedData         = "data/typed"
 	MimetypeClique            = "application/x-clique-header"
+	MimetypePixelzx           = "application/x-pixelzx-header"
+	MimetypePixelzxVote       = "application/x-pixelzx-vote"
 	MimetypeTextPlain         = "text/plain"
 )
 
@@ -1,34 +1,393 @@
 package commands
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/console/prompt"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/urfave/cli/v2"
 )
 
+var (
+	newPasswordFileFlag = &cli.StringFlag{
+		Name:  "newpassword",
+		Usage: "Password file with the new password of the key",
+	}
+	jsonFlag = &cli.BoolFlag{
+		Name:  "json",
+		Usage: "Output JSON instead of human-readable format",
+	}
+	privateFlag = &cli.BoolFlag{
+		Name:  "private",
+		Usage: "Include the private key in the output",
+	}
+	exportOutFlag = &cli.StringFlag{
+		Name:  "out",
+		Usage: "File to write the exported key to (default = stdout)",
+	}
+)
+
+// keyStoreFlags are the flags locating the keystore of the node.
+var keyStoreFlags = []cli.Flag{
+	configFileFlag,
+	DataDirFlag,
+	keyStoreDirFlag,
+}
+
 // AccountCommand defines the account command structure
 var AccountCommand = &cli.Command{
 	Name:  "account",
 	Usage: "Account management commands",
+	Description: `
+Manage the accounts of the keystore: create new accounts, list, import, export
+and inspect existing ones, and change their passwords.
+
+The keystore is the one configured by --config, --datadir and --keystore, the
+same way pixelzx start resolves it. Passwords are prompted for interactively
+unless supplied with --password (and --newpassword) files.`,
 	Subcommands: []*cli.Command{
 		{
-			Name:  "new",
-			Usage: "Create a new account",
-			Action: func(c *cli.Context) error {
-				fmt.Println("Creating new account...")
-				fmt.Println("Account created: 0x742d35Cc6634C0532925a3b844Bc454e4438f44e")
-				return nil
-			},
+			Name:   "new",
+			Usage:  "Create a new account",
+			Flags:  append(keyStoreFlags, passwordFileFlag, jsonFlag),
+			Action: accountCreate,
 		},
 		{
-			Name:  "list",
-			Usage: "List all accounts",
-			Action: func(c *cli.Context) error {
-				fmt.Println("Accounts:")
-				fmt.Println("  0x742d35Cc6634C0532925a3b844Bc454e4438f44e")
-				fmt.Println("  0x8ba1f109551bD432803012645Hac136c22C43137")
-				return nil
-			},
+			Name:   "list",
+			Usage:  "List all accounts",
+			Flags:  append(keyStoreFlags, jsonFlag),
+			Action: accountList,
+		},
+		{
+			Name:      "import",
+			Usage:     "Import a private key into a new account",
+			ArgsUsage: "<keyfile>",
+			Flags:     append(keyStoreFlags, passwordFileFlag, jsonFlag),
+			Description: `
+Imports an unencrypted private key from <keyfile> and creates a new account.
+The keyfile holds the private key in hexadecimal form on a single line.`,
+			Action: accountImport,
+		},
+		{
+			Name:      "export",
+			Usage:     "Export an account as an encrypted key file",
+			ArgsUsage: "<address>",
+			Flags:     append(keyStoreFlags, passwordFileFlag, newPasswordFileFlag, exportOutFlag),
+			Description: `
+Exports the key of <address> as an encrypted JSON key file, re-encrypted with the
+password given by --newpassword (or prompted for).`,
+			Action: accountExport,
+		},
+		{
+			Name:      "update",
+			Usage:     "Change the password of existing accounts",
+			ArgsUsage: "<address> [<address>...]",
+			Flags:     append(keyStoreFlags, passwordFileFlag, newPasswordFileFlag),
+			Description: `
+Re-encrypts the keys of the given accounts with a new password, also migrating
+them to the current key file format.`,
+			Action: accountUpdate,
+		},
+		{
+			Name:      "inspect",
+			Usage:     "Inspect an account or key file",
+			ArgsUsage: "<address|keyfile>",
+			Flags:     append(keyStoreFlags, passwordFileFlag, jsonFlag, privateFlag),
+			Description: `
+Decrypts the key of a keystore account or key file and prints its address and
+public key. The private key is only printed with --private; make sure to use
+this feature with great caution!`,
+			Action: accountInspect,
 		},
 	},
-}
\ No newline at end of file
+}
+
+// accountInfo is the JSON representation of a keystore account.
+type accountInfo struct {
+	Address common.Address `json:"address"`
+	Path    string         `json:"path"`
+}
+
+// keyInfo is the JSON representation of an inspected key.
+type keyInfo struct {
+	Address    common.Address `json:"address"`
+	Path       string         `json:"path,omitempty"`
+	PublicKey  string         `json:"publicKey"`
+	PrivateKey string         `json:"privateKey,omitempty"`
+}
+
+// openKeyStore opens the keystore configured by the command line flags.
+func openKeyStore(ctx *cli.Context) (*keystore.KeyStore, error) {
+	config, err := makeConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	keydir, _, err := makeStackConfig(config).GetKeyStoreDir()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the keystore directory: %v", err)
+	}
+	return keystore.NewKeyStore(keydir, keystore.StandardScryptN, keystore.StandardScryptP), nil
+}
+
+// findAccount resolves a hex address argument to an account of the keystore.
+func findAccount(ks *keystore.KeyStore, addr string) (accounts.Account, error) {
+	if !common.IsHexAddress(addr) {
+		return accounts.Account{}, fmt.Errorf("address must be specified in hexadecimal form: %q", addr)
+	}
+	account, err := ks.Find(accounts.Account{Address: common.HexToAddress(addr)})
+	if err != nil {
+		return accounts.Account{}, fmt.Errorf("account %s: %v", addr, err)
+	}
+	return account, nil
+}
+
+// readPasswordFile reads the password from the first line of the given file.
+func readPasswordFile(path string) (string, error) {
+	text, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read password file: %v", err)
+	}
+	line, _, _ := strings.Cut(string(text), "\n")
+	return strings.TrimRight(line, "\r"), nil
+}
+
+// getPassword reads the password from the file of the given flag if set, or
+// prompts the user for it otherwise.
+func getPassword(ctx *cli.Context, flag *cli.StringFlag, text string, confirmation bool) (string, error) {
+	if path := ctx.String(flag.Name); path != "" {
+		return readPasswordFile(path)
+	}
+	if text != "" {
+		fmt.Println(text)
+	}
+	password, err := prompt.Stdin.PromptPassword("Password: ")
+	if err != nil {
+		return "", fmt.Errorf("failed to read password: %v", err)
+	}
+	if confirmation {
+		confirm, err := prompt.Stdin.PromptPassword("Repeat password: ")
+		if err != nil {
+			return "", fmt.Errorf("failed to read password confirmation: %v", err)
+		}
+		if password != confirm {
+			return "", errors.New("passwords do not match")
+		}
+	}
+	return password, nil
+}
+
+// printJSON writes the value to stdout as indented JSON.
+func printJSON(v any) error {
+	out, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(out))
+	return nil
+}
+
+// accountCreate creates a new account in the keystore.
+func accountCreate(ctx *cli.Context) error {
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	password, err := getPassword(ctx, passwordFileFlag, "Your new account is locked with a password. Please give a password. Do not forget this password.", true)
+	if err != nil {
+		return err
+	}
+	account, err := ks.NewAccount(password)
+	if err != nil {
+		return fmt.Errorf("failed to create account: %v", err)
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(accountInfo{Address: account.Address, Path: account.URL.Path})
+	}
+	fmt.Printf("\nYour new key was generated\n\n")
+	fmt.Printf("Public address of the key:   %s\n", account.Address.Hex())
+	fmt.Printf("Path of the secret key file: %s\n\n", account.URL.Path)
+	fmt.Printf("- You can share your public address with anyone. Others need it to interact with you.\n")
+	fmt.Printf("- You must NEVER share the secret key with anyone! The key controls access to your funds!\n")
+	fmt.Printf("- You must BACKUP your key file! Without the key, it's impossible to access account funds!\n")
+	fmt.Printf("- You must REMEMBER your password! Without the password, it's impossible to decrypt the key!\n\n")
+	return nil
+}
+
+// accountList prints the accounts of the keystore.
+func accountList(ctx *cli.Context) error {
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		list := make([]accountInfo, 0, len(ks.Accounts()))
+		for _, account := range ks.Accounts() {
+			list = append(list, accountInfo{Address: account.Address, Path: account.URL.Path})
+		}
+		return printJSON(list)
+	}
+	for i, account := range ks.Accounts() {
+		fmt.Printf("Account #%d: {%x} %s\n", i, account.Address, &account.URL)
+	}
+	return nil
+}
+
+// accountImport imports an unencrypted private key into a new account.
+func accountImport(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("keyfile must be given as the only argument")
+	}
+	key, err := crypto.LoadECDSA(ctx.Args().First())
+	if err != nil {
+		return fmt.Errorf("failed to load the private key: %v", err)
+	}
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	password, err := getPassword(ctx, passwordFileFlag, "Your new account is locked with a password. Please give a password. Do not forget this password.", true)
+	if err != nil {
+		return err
+	}
+	account, err := ks.ImportECDSA(key, password)
+	if err != nil {
+		return fmt.Errorf("could not create the account: %v", err)
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(accountInfo{Address: account.Address, Path: account.URL.Path})
+	}
+	fmt.Printf("Address: {%x}\n", account.Address)
+	return nil
+}
+
+// accountExport exports an account as an encrypted key file.
+func accountExport(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("address must be given as the only argument")
+	}
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	account, err := findAccount(ks, ctx.Args().First())
+	if err != nil {
+		return err
+	}
+	password, err := getPassword(ctx, passwordFileFlag, fmt.Sprintf("Please provide the password for account %s", account.Address.Hex()), false)
+	if err != nil {
+		return err
+	}
+	newPassword, err := getPassword(ctx, newPasswordFileFlag, "Please give a password for the exported key. Do not forget this password.", true)
+	if err != nil {
+		return err
+	}
+	keyJSON, err := ks.Export(account, password, newPassword)
+	if err != nil {
+		return fmt.Errorf("could not export account: %w", err)
+	}
+	if path := ctx.String(exportOutFlag.Name); path != "" {
+		if err := os.WriteFile(path, keyJSON, 0600); err != nil {
+			return fmt.Errorf("failed to write key file: %v", err)
+		}
+		fmt.Printf("Exported %s to %s\n", account.Address.Hex(), path)
+		return nil
+	}
+	fmt.Println(string(keyJSON))
+	return nil
+}
+
+// accountUpdate re-encrypts the keys of the given accounts with a new password,
+// migrating them to the current key file format.
+func accountUpdate(ctx *cli.Context) error {
+	if ctx.Args().Len() == 0 {
+		return errors.New("no accounts specified to update")
+	}
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return err
+	}
+	for _, addr := range ctx.Args().Slice() {
+		account, err := findAccount(ks, addr)
+		if err != nil {
+			return err
+		}
+		newPassword, err := getPassword(ctx, newPasswordFileFlag, "Please give a NEW password. Do not forget this password.", true)
+		if err != nil {
+			return err
+		}
+		updateFn := func(attempt int) error {
+			password, err := getPassword(ctx, passwordFileFlag, fmt.Sprintf("Please provide the OLD password for account %s | Attempt %d/%d", addr, attempt+1, 3), false)
+			if err != nil {
+				return err
+			}
+			return ks.Update(account, password, newPassword)
+		}
+		// Let the user attempt the unlock thrice, unless the password is read
+		// from a file, retrying which is pointless.
+		err = updateFn(0)
+		for attempts := 1; attempts < 3 && errors.Is(err, keystore.ErrDecrypt) && !ctx.IsSet(passwordFileFlag.Name); attempts++ {
+			err = updateFn(attempts)
+		}
+		if err != nil {
+			return fmt.Errorf("could not update account: %w", err)
+		}
+	}
+	return nil
+}
+
+// accountInspect decrypts a keystore account or key file and prints its keys.
+func accountInspect(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("address or keyfile must be given as the only argument")
+	}
+	path := ctx.Args().First()
+	if common.IsHexAddress(path) {
+		ks, err := openKeyStore(ctx)
+		if err != nil {
+			return err
+		}
+		account, err := findAccount(ks, path)
+		if err != nil {
+			return err
+		}
+		path = account.URL.Path
+	}
+	keyJSON, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read the keyfile at '%s': %v", path, err)
+	}
+	password, err := getPassword(ctx, passwordFileFlag, "", false)
+	if err != nil {
+		return err
+	}
+	key, err := keystore.DecryptKey(keyJSON, password)
+	if err != nil {
+		return fmt.Errorf("error decrypting key: %v", err)
+	}
+	info := keyInfo{
+		Address:   key.Address,
+		Path:      path,
+		PublicKey: hex.EncodeToString(crypto.FromECDSAPub(&key.PrivateKey.PublicKey)),
+	}
+	if ctx.Bool(privateFlag.Name) {
+		info.PrivateKey = hex.EncodeToString(crypto.FromECDSA(key.PrivateKey))
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(info)
+	}
+	fmt.Println("Address:       ", info.Address.Hex())
+	fmt.Println("Key file:      ", info.Path)
+	fmt.Println("Public key:    ", info.PublicKey)
+	if info.PrivateKey != "" {
+		fmt.Println("Private key:   ", info.PrivateKey)
+	}
+	return nil
+}
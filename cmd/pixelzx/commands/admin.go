@@ -1,9 +1,24 @@
 package commands
 
 import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli/v2"
 )
 
+var attachFlag = &cli.StringFlag{
+	Name:  "rpc",
+	Usage: "RPC endpoint of the node to attach to (default = IPC endpoint of the datadir)",
+}
+
+// attachFlags are the flags locating the node the admin commands attach to.
+var attachFlags = []cli.Flag{
+	attachFlag,
+	configFileFlag,
+	DataDirFlag,
+}
+
 // AdminCommand defines the admin command structure
 var AdminCommand = &cli.Command{
 	Name:  "admin",
@@ -17,4 +32,22 @@ var AdminCommand = &cli.Command{
 		adminResetCmd(),
 		adminPeerCmd(),
 	},
-}
\ No newline at end of file
+}
+
+// attachNode connects to the running node given by --rpc, or to the IPC
+// endpoint of the configured data directory.
+func attachNode(ctx *cli.Context) (*rpc.Client, error) {
+	endpoint := ctx.String(attachFlag.Name)
+	if endpoint == "" {
+		config, err := makeConfig(ctx)
+		if err != nil {
+			return nil, err
+		}
+		endpoint = makeStackConfig(config).IPCEndpoint()
+	}
+	client, err := rpc.DialContext(ctx.Context, endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to attach to the node at %s: %v", endpoint, err)
+	}
+	return client, nil
+}
@@ -0,0 +1,239 @@
+package commands
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"maps"
+	"math/big"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+)
+
+// testGenesis returns the genesis of the chains backed up in the tests: a devnet
+// funding the given key to send transactions.
+func testGenesis(key []byte) *core.Genesis {
+	genesis := developerGenesis([]common.Address{{0x01}})
+	genesis.Alloc[crypto.PubkeyToAddress(crypto.ToECDSAUnsafe(key).PublicKey)] = types.Account{Balance: big.NewInt(params.PZX)}
+	return genesis
+}
+
+// testKey is the key funded in the test genesis.
+var testKey = common.FromHex("b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291")
+
+// newTestDatadir creates a datadir holding a chain of n blocks generated on top
+// of the genesis, each transferring some wei. It returns the head block.
+func newTestDatadir(t *testing.T, dir string, genesis *core.Genesis, n int) *types.Block {
+	t.Helper()
+
+	stack, err := newNode(&node.Config{Name: clientIdentifier, DataDir: dir})
+	if err != nil {
+		t.Fatalf("failed to create node: %v", err)
+	}
+	defer stack.Close()
+
+	db, err := openChainDatabase(stack, false)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	var (
+		engine = beacon.New(ethash.NewFaker())
+		key    = crypto.ToECDSAUnsafe(testKey)
+		signer = types.LatestSigner(genesis.Config)
+		config = core.DefaultConfig().WithStateScheme(rawdb.PathScheme)
+	)
+	config.TrieJournalDirectory = stack.ResolvePath("triedb")
+
+	chain, err := core.NewBlockChain(db, genesis, engine, config)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+
+	_, blocks, _ := core.GenerateChainWithGenesis(genesis, engine, n, func(i int, gen *core.BlockGen) {
+		tx := types.MustSignNewTx(key, signer, &types.LegacyTx{
+			Nonce:    gen.TxNonce(crypto.PubkeyToAddress(key.PublicKey)),
+			To:       &common.Address{0xaa},
+			Value:    big.NewInt(1),
+			Gas:      params.TxGas,
+			GasPrice: gen.BaseFee(),
+		})
+		gen.AddTx(tx)
+	})
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	return chain.GetBlockByNumber(uint64(n))
+}
+
+// runCommand runs a command with the given arguments, as the pixelzx binary does.
+func runCommand(cmd *cli.Command, args ...string) error {
+	app := &cli.App{Name: "pixelzx", Commands: []*cli.Command{cmd}}
+	return app.Run(append([]string{"pixelzx", cmd.Name}, args...))
+}
+
+// readBackup reads the manifest of a backup archive, along with the checksums of
+// the files actually archived.
+func readBackup(t *testing.T, file string) (*backupManifest, map[string]backupFile) {
+	t.Helper()
+
+	in, err := os.Open(file)
+	if err != nil {
+		t.Fatalf("failed to open backup: %v", err)
+	}
+	defer in.Close()
+
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		t.Fatalf("failed to decompress backup: %v", err)
+	}
+	var (
+		tr       = tar.NewReader(gz)
+		files    = make(map[string]backupFile)
+		manifest *backupManifest
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			t.Fatalf("failed to read backup: %v", err)
+		}
+		switch {
+		case hdr.Name == manifestName:
+			manifest = new(backupManifest)
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				t.Fatalf("failed to decode manifest: %v", err)
+			}
+		case hdr.Typeflag == tar.TypeReg:
+			hasher := sha256.New()
+			size, err := io.Copy(hasher, tr)
+			if err != nil {
+				t.Fatalf("failed to read %s: %v", hdr.Name, err)
+			}
+			files[hdr.Name] = backupFile{Path: hdr.Name, Size: size, SHA256: hex.EncodeToString(hasher.Sum(nil))}
+		}
+	}
+	if manifest == nil {
+		t.Fatalf("backup without manifest")
+	}
+	return manifest, files
+}
+
+// backupDatadir backs up a datadir offline, returning the path of the archive.
+func backupDatadir(t *testing.T, datadir string, args ...string) string {
+	t.Helper()
+
+	output := t.TempDir()
+	if err := runCommand(adminBackupCmd(), append([]string{"--datadir", datadir, "--output", output}, args...)...); err != nil {
+		t.Fatalf("backup failed: %v", err)
+	}
+	archives, _ := filepath.Glob(filepath.Join(output, "backup_*.tar.gz"))
+	if len(archives) != 1 {
+		t.Fatalf("backup archive count mismatch: have %d, want 1", len(archives))
+	}
+	return archives[0]
+}
+
+// Tests that an offline backup archives the chain database and the state journal
+// of a datadir, with a manifest describing the head of the chain and the checksum
+// of every archived file.
+func TestBackup(t *testing.T) {
+	var (
+		datadir = t.TempDir()
+		genesis = testGenesis(testKey)
+		head    = newTestDatadir(t, datadir, genesis, 10)
+		archive = backupDatadir(t, datadir)
+	)
+	manifest, files := readBackup(t, archive)
+
+	if manifest.Version != manifestVersion || manifest.Online {
+		t.Errorf("manifest kind mismatch: version %d, online %t", manifest.Version, manifest.Online)
+	}
+	if manifest.ChainID.Cmp(genesis.Config.ChainID) != 0 {
+		t.Errorf("chain ID mismatch: have %v, want %v", manifest.ChainID, genesis.Config.ChainID)
+	}
+	if want := genesis.ToBlock().Hash(); manifest.Genesis != want {
+		t.Errorf("genesis mismatch: have %x, want %x", manifest.Genesis, want)
+	}
+	if manifest.HeadNumber != head.NumberU64() || manifest.HeadHash != head.Hash() {
+		t.Errorf("head mismatch: have %d (%x), want %d (%x)", manifest.HeadNumber, manifest.HeadHash, head.NumberU64(), head.Hash())
+	}
+	if manifest.StateScheme != rawdb.PathScheme {
+		t.Errorf("state scheme mismatch: have %q, want %q", manifest.StateScheme, rawdb.PathScheme)
+	}
+	// Every file of the archive is listed in the manifest, with its checksum
+	if len(manifest.Files) != len(files) {
+		t.Errorf("file count mismatch: manifest %d, archive %d", len(manifest.Files), len(files))
+	}
+	for _, want := range manifest.Files {
+		if have, ok := files[want.Path]; !ok || have != want {
+			t.Errorf("file %s mismatch: have %+v, want %+v", want.Path, have, want)
+		}
+	}
+	var chaindata, triedb bool
+	for name := range files {
+		chaindata = chaindata || strings.HasPrefix(name, "chaindata/")
+		triedb = triedb || strings.HasPrefix(name, "triedb/")
+		if strings.HasPrefix(name, "keystore/") {
+			t.Errorf("keystore archived without --with-keystore: %s", name)
+		}
+	}
+	if !chaindata || !triedb {
+		t.Errorf("database missing from the archive: chaindata %t, triedb %t", chaindata, triedb)
+	}
+	// The keystore is archived on demand
+	keyfile := filepath.Join(datadir, "keystore", "UTC--test")
+	if err := os.MkdirAll(filepath.Dir(keyfile), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyfile, []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	_, files = readBackup(t, backupDatadir(t, datadir, "--with-keystore"))
+	if _, ok := files["keystore/UTC--test"]; !ok {
+		t.Errorf("keystore missing from the archive: %v", slices.Sorted(maps.Keys(files)))
+	}
+}
+
+// Tests that an offline backup refuses to run while the datadir is locked by a
+// running node.
+func TestBackupLockedDatadir(t *testing.T) {
+	datadir := t.TempDir()
+	newTestDatadir(t, datadir, testGenesis(testKey), 1)
+
+	stack, err := newNode(&node.Config{Name: clientIdentifier, DataDir: datadir})
+	if err != nil {
+		t.Fatalf("failed to lock datadir: %v", err)
+	}
+	defer stack.Close()
+
+	output := t.TempDir()
+	err = runCommand(adminBackupCmd(), "--datadir", datadir, "--output", output)
+	if err == nil || !strings.Contains(err.Error(), "node is running") {
+		t.Fatalf("backup error mismatch: have %v, want node is running", err)
+	}
+	if archives, _ := filepath.Glob(filepath.Join(output, "*")); len(archives) != 0 {
+		t.Fatalf("backup of a locked datadir left files: %v", archives)
+	}
+}
@@ -1,11 +1,43 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/tabwriter"
+	"time"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/urfave/cli/v2"
 )
 
+var (
+	metricsWatchFlag = &cli.DurationFlag{
+		Name:  "watch",
+		Usage: "Refresh the metrics at the given interval (e.g. 5s) until interrupted",
+	}
+	metricsAllFlag = &cli.BoolFlag{
+		Name:  "all",
+		Usage: "Show all metrics instead of the system and p2p ones",
+	}
+)
+
+// defaultMetrics are the name prefixes of the metrics shown by default: the
+// CPU, memory and disk usage of the process and the p2p traffic.
+var defaultMetrics = []string{"system/", "p2p/ingress", "p2p/egress"}
+
+// metricColumns are the metric values shown in the metrics table, along with
+// their headers. Metrics only report the values of their type.
+var metricColumns = []struct{ key, header string }{
+	{"value", "VALUE"},
+	{"count", "COUNT"},
+	{"1m.rate", "RATE (1M)"},
+	{"mean", "MEAN"},
+	{"99%", "P99"},
+}
+
 // adminDebugCmd returns the debug subcommand for admin
 func adminDebugCmd() *cli.Command {
 	return &cli.Command{
@@ -13,29 +45,160 @@ func adminDebugCmd() *cli.Command {
 		Usage: "Debug node issues",
 		Subcommands: []*cli.Command{
 			{
-				Name:  "metrics",
-				Usage: "Show node metrics",
-				Action: func(c *cli.Context) error {
-					fmt.Println("Node Metrics:")
-					fmt.Println("  CPU Usage: 25%")
-					fmt.Println("  Memory Usage: 1.2GB")
-					fmt.Println("  Disk Usage: 15GB")
-					fmt.Println("  Network In: 1.5 MB/s")
-					fmt.Println("  Network Out: 0.8 MB/s")
-					return nil
-				},
+				Name:      "metrics",
+				Usage:     "Show node metrics",
+				ArgsUsage: "[<prefix>...]",
+				Flags:     append([]cli.Flag{metricsWatchFlag, metricsAllFlag, jsonFlag}, attachFlags...),
+				Description: `
+Shows the metrics collected by the running node, which must have been started
+with metrics enabled. By default the CPU, memory and disk usage and the p2p
+traffic are shown; the metrics may instead be selected by name prefix, or all
+shown with --all. With --watch the metrics are refreshed at the given interval.`,
+				Action: adminDebugMetrics,
 			},
 			{
-				Name:  "stack",
-				Usage: "Show stack trace",
-				Action: func(c *cli.Context) error {
-					fmt.Println("Stack trace information:")
-					fmt.Println("  goroutine 1 [running]:")
-					fmt.Println("  main.main()")
-					fmt.Println("  \t/usr/local/go/src/main.go:20 +0x45")
-					return nil
-				},
+				Name:      "stack",
+				Usage:     "Show stack trace",
+				ArgsUsage: "[<filter>]",
+				Flags:     attachFlags,
+				Description: `
+Prints the stacks of the goroutines of the running node. The filter, a boolean
+expression of package names like "(eth || snap) && !p2p", selects the
+goroutines shown.`,
+				Action: adminDebugStack,
 			},
 		},
 	}
-}
\ No newline at end of file
+}
+
+// adminDebugMetrics prints the metrics of the running node, refreshing them with
+// --watch.
+func adminDebugMetrics(ctx *cli.Context) error {
+	prefixes := defaultMetrics
+	switch {
+	case ctx.Bool(metricsAllFlag.Name):
+		prefixes = []string{""}
+	case ctx.Args().Present():
+		prefixes = ctx.Args().Slice()
+	}
+	client, err := attachNode(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	interval := ctx.Duration(metricsWatchFlag.Name)
+	for {
+		metrics, err := fetchMetrics(ctx, client, prefixes)
+		if err != nil {
+			return err
+		}
+		if ctx.Bool(jsonFlag.Name) {
+			if err := printJSON(metrics); err != nil {
+				return err
+			}
+		} else {
+			if interval > 0 {
+				fmt.Print("\x1b[H\x1b[2J") // clear the terminal
+				fmt.Printf("Every %v: %s\n\n", interval, time.Now().Format(time.DateTime))
+			}
+			if err := printMetrics(metrics); err != nil {
+				return err
+			}
+		}
+		if interval <= 0 {
+			return nil
+		}
+		select {
+		case <-time.After(interval):
+		case <-ctx.Context.Done():
+			return nil
+		}
+	}
+}
+
+// fetchMetrics retrieves the metrics of the node with the given name prefixes.
+func fetchMetrics(ctx *cli.Context, client *rpc.Client, prefixes []string) (map[string]map[string]interface{}, error) {
+	var all map[string]map[string]interface{}
+	if err := client.CallContext(ctx.Context, &all, "debug_metrics"); err != nil {
+		return nil, fmt.Errorf("failed to retrieve metrics: %v", err)
+	}
+	metrics := make(map[string]map[string]interface{})
+	for name, values := range all {
+		for _, prefix := range prefixes {
+			if strings.HasPrefix(name, prefix) {
+				metrics[name] = values
+				break
+			}
+		}
+	}
+	return metrics, nil
+}
+
+// printMetrics prints the metrics as a table, sorted by name.
+func printMetrics(metrics map[string]map[string]interface{}) error {
+	if len(metrics) == 0 {
+		fmt.Println("No metrics collected, start the node with metrics enabled (--metrics)")
+		return nil
+	}
+	names := make([]string, 0, len(metrics))
+	for name := range metrics {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprint(w, "METRIC")
+	for _, column := range metricColumns {
+		fmt.Fprintf(w, "\t%s", column.header)
+	}
+	fmt.Fprintln(w)
+	for _, name := range names {
+		fmt.Fprint(w, name)
+		for _, column := range metricColumns {
+			fmt.Fprintf(w, "\t%s", formatMetric(metrics[name][column.key]))
+		}
+		fmt.Fprintln(w)
+	}
+	return w.Flush()
+}
+
+// formatMetric formats a metric value decoded from JSON, whole numbers without
+// decimals.
+func formatMetric(value interface{}) string {
+	switch value := value.(type) {
+	case nil:
+		return "-"
+	case float64:
+		if value == float64(int64(value)) {
+			return fmt.Sprintf("%d", int64(value))
+		}
+		return fmt.Sprintf("%.2f", value)
+	default:
+		return fmt.Sprint(value)
+	}
+}
+
+// adminDebugStack prints the goroutine stacks of the running node.
+func adminDebugStack(ctx *cli.Context) error {
+	client, err := attachNode(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var filter *string
+	if ctx.Args().Present() {
+		arg := ctx.Args().First()
+		filter = &arg
+	}
+	var stacks string
+	if err := client.CallContext(ctx.Context, &stacks, "debug_stacks", filter); err != nil {
+		return fmt.Errorf("failed to retrieve stacks: %v", err)
+	}
+	if stacks == "" {
+		return errors.New("no goroutine matches the filter")
+	}
+	fmt.Print(stacks)
+	return nil
+}
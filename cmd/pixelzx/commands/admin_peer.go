@@ -1,12 +1,13 @@
 package commands
 
 import (
-	"encoding/hex"
+	"errors"
 	"fmt"
-	"net"
-	"strconv"
-	"strings"
+	"os"
+	"text/tabwriter"
 
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
 	"github.com/urfave/cli/v2"
 )
 
@@ -17,131 +18,139 @@ func adminPeerCmd() *cli.Command {
 		Usage: "Manage network peers",
 		Subcommands: []*cli.Command{
 			{
-				Name:  "list",
-				Usage: "List connected peers",
-				Action: func(c *cli.Context) error {
-					fmt.Println("Connected Peers:")
-					fmt.Println("  enode://a1b2c3d4e5f67890@192.168.1.100:30303")
-					fmt.Println("  enode://f6e5d4c3b2a10987@192.168.1.101:30303")
-					fmt.Println("  enode://7890abcdef12345@192.168.1.102:30303")
-					return nil
-				},
+				Name:   "list",
+				Usage:  "List connected peers",
+				Flags:  append([]cli.Flag{jsonFlag}, attachFlags...),
+				Action: adminPeerList,
 			},
 			{
 				Name:      "connect",
 				Usage:     "Connect to a peer",
-				UsageText: "pixelzx admin peer connect <enode-url>",
-				Action: func(c *cli.Context) error {
-					if c.NArg() == 0 {
-						return fmt.Errorf("missing enode URL")
-					}
-					
-					enodeURL := c.Args().First()
-					if err := validateEnodeURL(enodeURL); err != nil {
-						return fmt.Errorf("invalid enode URL: %v", err)
-					}
-					
-					fmt.Printf("Connecting to peer: %s\n", enodeURL)
-					fmt.Println("Peer connected successfully")
-					return nil
-				},
+				ArgsUsage: "<enode-url>",
+				Flags:     attachFlags,
+				Action:    adminPeerConnect,
 			},
 			{
 				Name:      "disconnect",
 				Usage:     "Disconnect from a peer",
-				UsageText: "pixelzx admin peer disconnect <enode-url>",
-				Action: func(c *cli.Context) error {
-					if c.NArg() == 0 {
-						return fmt.Errorf("missing enode URL")
-					}
-					
-					enodeURL := c.Args().First()
-					fmt.Printf("Disconnecting from peer: %s\n", enodeURL)
-					fmt.Println("Peer disconnected successfully")
-					return nil
-				},
+				ArgsUsage: "<enode-url>",
+				Flags:     attachFlags,
+				Action:    adminPeerDisconnect,
 			},
 			{
-				Name:  "self",
-				Usage: "Show local node enode information",
-				Flags: []cli.Flag{
-					&cli.BoolFlag{
-						Name:  "json",
-						Usage: "Output in JSON format",
-					},
-				},
-				Action: func(c *cli.Context) error {
-					jsonFormat := c.Bool("json")
-					
-					if jsonFormat {
-						fmt.Println(`{
-  "enode": "enode://abcd1234ef567890@192.168.1.50:30303",
-  "id": "abcd1234ef567890",
-  "ip": "192.168.1.50",
-  "tcp": 30303,
-  "udp": 30303
-}`)
-					} else {
-						fmt.Println("Local Node Information:")
-						fmt.Println("  Enode: enode://abcd1234ef567890@192.168.1.50:30303")
-						fmt.Println("  Node ID: abcd1234ef567890")
-						fmt.Println("  IP: 192.168.1.50")
-						fmt.Println("  TCP Port: 30303")
-						fmt.Println("  UDP Port: 30303")
-					}
-					return nil
-				},
+				Name:   "self",
+				Usage:  "Show local node enode information",
+				Flags:  append([]cli.Flag{jsonFlag}, attachFlags...),
+				Action: adminPeerSelf,
 			},
 		},
 	}
 }
 
-// validateEnodeURL validates the format of an enode URL
-func validateEnodeURL(enodeURL string) error {
-	// Basic format check
-	if !strings.HasPrefix(enodeURL, "enode://") {
-		return fmt.Errorf("invalid enode URL format, must start with 'enode://'")
-	}
-	
-	// Extract the part after "enode://"
-	parts := enodeURL[8:] // Skip "enode://"
-	
-	// Find the '@' separator
-	atIndex := strings.Index(parts, "@")
-	if atIndex == -1 {
-		return fmt.Errorf("invalid enode URL format, missing '@' separator")
-	}
-	
-	publicKey := parts[:atIndex]
-	if len(publicKey) != 128 {
-		return fmt.Errorf("invalid public key length, must be 128 characters, got %d", len(publicKey))
-	}
-	
-	// Validate public key is hex
-	if _, err := hex.DecodeString(publicKey); err != nil {
-		return fmt.Errorf("invalid public key format, must be hexadecimal")
-	}
-	
-	// Extract host and port
-	hostPort := parts[atIndex+1:]
-	colonIndex := strings.LastIndex(hostPort, ":")
-	
-	if colonIndex == -1 {
-		return fmt.Errorf("invalid enode URL format, missing port")
-	}
-	
-	host := hostPort[:colonIndex]
-	portStr := hostPort[colonIndex+1:]
-	
-	// Validate IP address
-	if ip := net.ParseIP(host); ip == nil {
-		return fmt.Errorf("invalid IP address: %s", host)
-	}
-	
-	// Validate port
-	if port, err := strconv.Atoi(portStr); err != nil || port < 1 || port > 65535 {
-		return fmt.Errorf("invalid port number: %s", portStr)
-	}
-	
+// adminPeerList prints the peers the node is connected to.
+func adminPeerList(ctx *cli.Context) error {
+	client, err := attachNode(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var peers []*p2p.PeerInfo
+	if err := client.CallContext(ctx.Context, &peers, "admin_peers"); err != nil {
+		return fmt.Errorf("failed to retrieve peers: %v", err)
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(peers)
+	}
+	if len(peers) == 0 {
+		fmt.Println("No connected peers")
+		return nil
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tNAME\tREMOTE ADDRESS\tDIRECTION")
+	for _, peer := range peers {
+		direction := "outbound"
+		if peer.Network.Inbound {
+			direction = "inbound"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", peer.ID, peer.Name, peer.Network.RemoteAddress, direction)
+	}
+	return w.Flush()
+}
+
+// peerArg parses the enode URL given as the only argument.
+func peerArg(ctx *cli.Context) (*enode.Node, error) {
+	if ctx.Args().Len() != 1 {
+		return nil, errors.New("enode URL must be given as the only argument")
+	}
+	node, err := enode.Parse(enode.ValidSchemes, ctx.Args().First())
+	if err != nil {
+		return nil, fmt.Errorf("invalid enode URL: %v", err)
+	}
+	return node, nil
+}
+
+// adminPeerConnect adds a static peer to the node.
+func adminPeerConnect(ctx *cli.Context) error {
+	node, err := peerArg(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := attachNode(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var ok bool
+	if err := client.CallContext(ctx.Context, &ok, "admin_addPeer", node.URLv4()); err != nil {
+		return fmt.Errorf("failed to add peer: %v", err)
+	}
+	fmt.Printf("Added peer %s, connecting\n", node.ID().TerminalString())
 	return nil
-}
\ No newline at end of file
+}
+
+// adminPeerDisconnect removes a peer from the node.
+func adminPeerDisconnect(ctx *cli.Context) error {
+	node, err := peerArg(ctx)
+	if err != nil {
+		return err
+	}
+	client, err := attachNode(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var ok bool
+	if err := client.CallContext(ctx.Context, &ok, "admin_removePeer", node.URLv4()); err != nil {
+		return fmt.Errorf("failed to remove peer: %v", err)
+	}
+	fmt.Printf("Removed peer %s\n", node.ID().TerminalString())
+	return nil
+}
+
+// adminPeerSelf prints the network identity of the node.
+func adminPeerSelf(ctx *cli.Context) error {
+	client, err := attachNode(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var info p2p.NodeInfo
+	if err := client.CallContext(ctx.Context, &info, "admin_nodeInfo"); err != nil {
+		return fmt.Errorf("failed to retrieve node info: %v", err)
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		info.Protocols = nil
+		return printJSON(info)
+	}
+	fmt.Println("Enode:         ", info.Enode)
+	fmt.Println("ENR:           ", info.ENR)
+	fmt.Println("Node ID:       ", info.ID)
+	fmt.Println("IP:            ", info.IP)
+	fmt.Println("TCP port:      ", info.Ports.Listener)
+	fmt.Println("UDP port:      ", info.Ports.Discovery)
+	return nil
+}
@@ -1,42 +1,295 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"text/tabwriter"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/console/prompt"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/node"
 	"github.com/urfave/cli/v2"
 )
 
+var (
+	resetSnapshotsFlag = &cli.BoolFlag{
+		Name:  "snapshots",
+		Usage: "Remove only the state snapshot and trie journal, keeping the chain",
+	}
+	resetYesFlag = &cli.BoolFlag{
+		Name:    "yes",
+		Aliases: []string{"y"},
+		Usage:   "Remove without asking for confirmation",
+	}
+	resetDryRunFlag = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "List what would be removed without removing it",
+	}
+)
+
+// resetItem is a piece of node data to be removed by a reset.
+type resetItem struct {
+	name     string // Description of the data
+	location string // Path or database entries holding the data
+	size     int64  // Size of the data in bytes
+}
+
 // adminResetCmd returns the reset subcommand for admin
 func adminResetCmd() *cli.Command {
 	return &cli.Command{
 		Name:  "reset",
 		Usage: "Reset node data",
-		Flags: []cli.Flag{
-			&cli.BoolFlag{
-				Name:  "chaindata",
-				Usage: "Reset chain data only",
-			},
-			&cli.BoolFlag{
-				Name:  "snapshots",
-				Usage: "Reset snapshots only",
-			},
-		},
-		Action: func(c *cli.Context) error {
-			chaindata := c.Bool("chaindata")
-			snapshots := c.Bool("snapshots")
-			
-			if chaindata {
-				fmt.Println("Resetting chain data...")
-				fmt.Println("Chain data reset completed")
-			} else if snapshots {
-				fmt.Println("Resetting snapshots...")
-				fmt.Println("Snapshots reset completed")
-			} else {
-				fmt.Println("Resetting all node data...")
-				fmt.Println("Full node reset completed")
-			}
-			
+		Flags: []cli.Flag{resetSnapshotsFlag, resetYesFlag, resetDryRunFlag, configFileFlag, DataDirFlag},
+		Description: `
+Removes the chain database of the datadir: the key-value store, the ancient
+chain and state history stores and the trie journal. The node must be stopped,
+and resyncs the chain from genesis on the next start. Keys and the node key are
+kept.
+
+With --snapshots only the state snapshot and the trie journal are removed from
+the database. On the next start the node rewinds to the state last persisted to
+disk, and regenerates the snapshot from the state trie.
+
+What would be removed is listed along with its size, and removed once confirmed,
+or without asking with --yes. With --dry-run nothing is removed.`,
+		Action: adminReset,
+	}
+}
+
+// adminReset removes the chain data, or the state snapshot, of the datadir.
+func adminReset(ctx *cli.Context) error {
+	config, err := makeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	stackConfig := makeStackConfig(config)
+	stack, err := newNode(stackConfig)
+	if errors.Is(err, node.ErrDatadirUsed) {
+		return errors.New("node is running, stop it before resetting")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open datadir: %v", err)
+	}
+	defer stack.Close() // releases the datadir lock once reset
+
+	if ctx.Bool(resetSnapshotsFlag.Name) {
+		return resetSnapshots(ctx, stack)
+	}
+	return resetChain(ctx, stack)
+}
+
+// resetChain removes the chain database, ancient stores and trie journal of the
+// node.
+func resetChain(ctx *cli.Context, stack *node.Node) error {
+	var (
+		chaindata = stack.ResolvePath("chaindata")
+		ancient   = filepath.Join(chaindata, "ancient")
+		items     []resetItem
+	)
+	for _, item := range []resetItem{
+		{name: "Ancient chain store", location: filepath.Join(ancient, rawdb.ChainFreezerName)},
+		{name: "Ancient state history", location: filepath.Join(ancient, rawdb.MerkleStateFreezerName)},
+		{name: "Ancient verkle state history", location: filepath.Join(ancient, rawdb.VerkleStateFreezerName)},
+		{name: "Trie journal", location: stack.ResolvePath("triedb")},
+		{name: "Key-value store", location: chaindata},
+	} {
+		if !common.FileExist(item.location) {
+			continue
+		}
+		size, err := diskUsage(item.location, ancient)
+		if err != nil {
+			return err
+		}
+		item.size = size
+		items = append(items, item)
+	}
+	if len(items) == 0 {
+		fmt.Println("No chain data in", stack.InstanceDir())
+		return nil
+	}
+	if ok, err := confirmReset(ctx, items, "chain data"); !ok || err != nil {
+		return err
+	}
+	for _, item := range items {
+		if err := os.RemoveAll(item.location); err != nil {
+			return fmt.Errorf("failed to remove %s: %v", item.location, err)
+		}
+	}
+	fmt.Println("Removed chain data from", stack.InstanceDir())
+	return nil
+}
+
+// resetSnapshots removes the state snapshot and the trie journal from the chain
+// database of the node.
+func resetSnapshots(ctx *cli.Context, stack *node.Node) error {
+	db, err := openChainDatabase(stack, ctx.Bool(resetDryRunFlag.Name))
+	if err != nil {
+		return fmt.Errorf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	var (
+		accounts, accountSize = countSnapshot(db, rawdb.SnapshotAccountPrefix, common.HashLength)
+		slots, slotSize       = countSnapshot(db, rawdb.SnapshotStoragePrefix, 2*common.HashLength)
+		items                 = []resetItem{
+			{name: "Snapshot accounts", location: fmt.Sprintf("%d entries", accounts), size: accountSize},
+			{name: "Snapshot storage", location: fmt.Sprintf("%d entries", slots), size: slotSize},
+		}
+	)
+	if journal := rawdb.ReadSnapshotJournal(db); len(journal) > 0 {
+		items = append(items, resetItem{name: "Snapshot journal", location: "1 entry", size: int64(len(journal))})
+	}
+	if journal := rawdb.ReadTrieJournal(db); len(journal) > 0 {
+		items = append(items, resetItem{name: "Trie journal", location: "1 entry", size: int64(len(journal))})
+	}
+	journals, _ := filepath.Glob(filepath.Join(stack.ResolvePath("triedb"), "*.journal"))
+	for _, journal := range journals {
+		size, err := diskUsage(journal)
+		if err != nil {
+			return err
+		}
+		items = append(items, resetItem{name: "Trie journal", location: journal, size: size})
+	}
+	if ok, err := confirmReset(ctx, items, "state snapshot"); !ok || err != nil {
+		return err
+	}
+	if err := deleteSnapshot(db); err != nil {
+		return err
+	}
+	for _, journal := range journals {
+		if err := os.Remove(journal); err != nil {
+			return fmt.Errorf("failed to remove %s: %v", journal, err)
+		}
+	}
+	fmt.Printf("Removed state snapshot of %d accounts and %d storage slots\n", accounts, slots)
+	return nil
+}
+
+// countSnapshot counts the snapshot entries under the given prefix, returning
+// their number and size.
+func countSnapshot(db ethdb.Iteratee, prefix []byte, keylen int) (int, int64) {
+	var (
+		count int
+		size  int64
+	)
+	it := db.NewIterator(prefix, nil)
+	defer it.Release()
+
+	for it.Next() {
+		if len(it.Key()) != len(prefix)+keylen {
+			continue
+		}
+		count++
+		size += int64(len(it.Key()) + len(it.Value()))
+	}
+	return count, size
+}
+
+// deleteSnapshot deletes the snapshot entries, along with the snapshot metadata
+// and the trie journal, from the database.
+func deleteSnapshot(db ethdb.Database) error {
+	batch := db.NewBatch()
+	flush := func() error {
+		if batch.ValueSize() < ethdb.IdealBatchSize {
 			return nil
-		},
+		}
+		if err := batch.Write(); err != nil {
+			return err
+		}
+		batch.Reset()
+		return nil
+	}
+	it := db.NewIterator(rawdb.SnapshotAccountPrefix, nil)
+	for it.Next() {
+		if key := it.Key(); len(key) == len(rawdb.SnapshotAccountPrefix)+common.HashLength {
+			rawdb.DeleteAccountSnapshot(batch, common.BytesToHash(key[len(rawdb.SnapshotAccountPrefix):]))
+			if err := flush(); err != nil {
+				it.Release()
+				return err
+			}
+		}
+	}
+	it.Release()
+
+	it = db.NewIterator(rawdb.SnapshotStoragePrefix, nil)
+	for it.Next() {
+		if key := it.Key(); len(key) == len(rawdb.SnapshotStoragePrefix)+2*common.HashLength {
+			key = key[len(rawdb.SnapshotStoragePrefix):]
+			rawdb.DeleteStorageSnapshot(batch, common.BytesToHash(key[:common.HashLength]), common.BytesToHash(key[common.HashLength:]))
+			if err := flush(); err != nil {
+				it.Release()
+				return err
+			}
+		}
+	}
+	it.Release()
+
+	rawdb.DeleteSnapshotRoot(batch)
+	rawdb.DeleteSnapshotJournal(batch)
+	rawdb.DeleteSnapshotGenerator(batch)
+	rawdb.DeleteSnapshotRecoveryNumber(batch)
+	rawdb.DeleteSnapshotDisabled(batch)
+	rawdb.DeleteTrieJournal(batch)
+	return batch.Write()
+}
+
+// confirmReset lists the data to be removed, and asks for confirmation unless
+// --yes is given. It reports false without asking on --dry-run.
+func confirmReset(ctx *cli.Context, items []resetItem, kind string) (bool, error) {
+	var total int64
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "DATA\tLOCATION\tSIZE")
+	for _, item := range items {
+		fmt.Fprintf(w, "%s\t%s\t%v\n", item.name, item.location, common.StorageSize(item.size))
+		total += item.size
+	}
+	fmt.Fprintf(w, "Total\t\t%v\n", common.StorageSize(total))
+	if err := w.Flush(); err != nil {
+		return false, err
 	}
-}
\ No newline at end of file
+	switch {
+	case ctx.Bool(resetDryRunFlag.Name):
+		fmt.Println("Dry run, nothing removed")
+		return false, nil
+	case ctx.Bool(resetYesFlag.Name):
+		return true, nil
+	}
+	ok, err := prompt.Stdin.PromptConfirm(fmt.Sprintf("Remove %s?", kind))
+	if err != nil {
+		return false, err
+	}
+	if !ok {
+		fmt.Println("Reset aborted")
+	}
+	return ok, nil
+}
+
+// diskUsage returns the size of the files in the tree at path, skipping the
+// given subtrees.
+func diskUsage(path string, skip ...string) (int64, error) {
+	var size int64
+	err := filepath.WalkDir(path, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		for _, dir := range skip {
+			if d.IsDir() && path == dir {
+				return filepath.SkipDir
+			}
+		}
+		if d.Type().IsRegular() {
+			info, err := d.Info()
+			if err != nil {
+				return err
+			}
+			size += info.Size()
+		}
+		return nil
+	})
+	return size, err
+}
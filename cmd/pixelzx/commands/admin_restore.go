@@ -1,32 +1,370 @@
 package commands
 
 import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb/pebble"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/urfave/cli/v2"
 )
 
+var (
+	restoreBackupFlag = &cli.StringFlag{
+		Name:     "backup",
+		Aliases:  []string{"b"},
+		Usage:    "Path to backup file",
+		Required: true,
+	}
+	restoreForceFlag = &cli.BoolFlag{
+		Name:  "force",
+		Usage: "Replace the chain data of a non-empty datadir",
+	}
+)
+
 // adminRestoreCmd returns the restore subcommand for admin
 func adminRestoreCmd() *cli.Command {
 	return &cli.Command{
 		Name:  "restore",
 		Usage: "Restore node data from backup",
-		Flags: []cli.Flag{
-			&cli.StringFlag{
-				Name:     "backup",
-				Aliases:  []string{"b"},
-				Usage:    "Path to backup file",
-				Required: true,
-			},
-		},
-		Action: func(c *cli.Context) error {
-			backupFile := c.String("backup")
-			
-			// In a real implementation, this would restore the node data from the backup
-			fmt.Printf("Restoring from backup: %s\n", backupFile)
-			fmt.Println("Restore completed successfully")
-			
-			return nil
-		},
-	}
-}
\ No newline at end of file
+		Flags: []cli.Flag{restoreBackupFlag, restoreForceFlag, configFileFlag, NetworkFlag, DataDirFlag, keyStoreDirFlag},
+		Description: `
+Restores a backup archive written by pixelzx admin backup into the datadir. The
+node must be stopped.
+
+The archive is extracted to a staging directory in the datadir, verifying every
+file against the checksums of the manifest. The chain ID and genesis of the
+backup must match the configured network (for devnets, the devnet genesis of
+the validators it lists and the devnet chain config), and the staged database
+must open with a consistent head block and ancient store, before it replaces
+the chain data of the datadir. Existing chain data is only replaced with --force. Keys of
+the backup are added to the keystore, never overwriting existing key files.`,
+		Action: adminRestore,
+	}
+}
+
+// adminRestore restores a backup archive into the datadir.
+func adminRestore(ctx *cli.Context) error {
+	config, err := makeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	stackConfig := makeStackConfig(config)
+	stack, err := newNode(stackConfig)
+	if errors.Is(err, node.ErrDatadirUsed) {
+		return errors.New("node is running, stop it before restoring")
+	}
+	if err != nil {
+		return fmt.Errorf("failed to open datadir: %v", err)
+	}
+	defer stack.Close() // releases the datadir lock once restored
+
+	target := stack.ResolvePath("chaindata")
+	if entries, _ := os.ReadDir(target); len(entries) > 0 && !ctx.Bool(restoreForceFlag.Name) {
+		return fmt.Errorf("datadir %s already holds chain data, restore with --force to replace it", stackConfig.DataDir)
+	}
+	staging, err := os.MkdirTemp(stack.InstanceDir(), ".restore-")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(staging)
+
+	manifest, err := extractBackup(ctx.String(restoreBackupFlag.Name), staging)
+	if err != nil {
+		return err
+	}
+	genesis, chainConfig, err := checkDatabase(filepath.Join(staging, "chaindata"), manifest)
+	if err != nil {
+		return err
+	}
+	if err := checkNetwork(config, manifest, genesis, chainConfig); err != nil {
+		return err
+	}
+	// Verified, swap the chain data of the backup into place
+	if err := swapInAll(staging, stack.ResolvePath, []string{"chaindata", "triedb", "nodekey"}); err != nil {
+		return err
+	}
+	restored, err := restoreKeys(filepath.Join(staging, "keystore"), stack.KeyStoreDir())
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Restored block %d (%s) of chain %v into %s\n", manifest.HeadNumber, manifest.HeadHash.TerminalString(), manifest.ChainID, stackConfig.DataDir)
+	if restored > 0 {
+		fmt.Printf("Restored %d keys into %s\n", restored, stack.KeyStoreDir())
+	}
+	return nil
+}
+
+// extractBackup extracts a backup archive into the given directory, verifying
+// the extracted files against the manifest of the archive.
+func extractBackup(file, dir string) (*backupManifest, error) {
+	in, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer in.Close()
+
+	gz, err := gzip.NewReader(in)
+	if err != nil {
+		return nil, fmt.Errorf("invalid backup archive: %v", err)
+	}
+	var (
+		tr       = tar.NewReader(gz)
+		files    = make(map[string]backupFile)
+		manifest *backupManifest
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid backup archive: %v", err)
+		}
+		name := path.Clean(hdr.Name)
+		if !filepath.IsLocal(name) {
+			return nil, fmt.Errorf("invalid backup archive: unsafe path %q", hdr.Name)
+		}
+		switch {
+		case name == manifestName:
+			manifest = new(backupManifest)
+			if err := json.NewDecoder(tr).Decode(manifest); err != nil {
+				return nil, fmt.Errorf("invalid backup manifest: %v", err)
+			}
+		case hdr.Typeflag == tar.TypeDir:
+			if err := os.MkdirAll(filepath.Join(dir, name), 0755); err != nil {
+				return nil, err
+			}
+		case hdr.Typeflag == tar.TypeReg:
+			file, err := extractFile(tr, filepath.Join(dir, name), hdr)
+			if err != nil {
+				return nil, err
+			}
+			file.Path = name
+			files[name] = file
+		default:
+			return nil, fmt.Errorf("invalid backup archive: unexpected entry %q", hdr.Name)
+		}
+	}
+	if manifest == nil {
+		return nil, errors.New("invalid backup archive: manifest missing")
+	}
+	if manifest.Version != manifestVersion {
+		return nil, fmt.Errorf("unsupported backup version %d", manifest.Version)
+	}
+	for _, want := range manifest.Files {
+		have, ok := files[want.Path]
+		if !ok {
+			return nil, fmt.Errorf("backup corrupted: %s missing", want.Path)
+		}
+		if have != want {
+			return nil, fmt.Errorf("backup corrupted: %s checksum mismatch", want.Path)
+		}
+		delete(files, want.Path)
+	}
+	for name := range files {
+		return nil, fmt.Errorf("backup corrupted: %s not in manifest", name)
+	}
+	return manifest, nil
+}
+
+// extractFile writes an archived file to the given path, returning its size and
+// checksum.
+func extractFile(r io.Reader, dest string, hdr *tar.Header) (backupFile, error) {
+	if err := os.MkdirAll(filepath.Dir(dest), 0755); err != nil {
+		return backupFile{}, err
+	}
+	out, err := os.OpenFile(dest, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode).Perm())
+	if err != nil {
+		return backupFile{}, err
+	}
+	defer out.Close()
+
+	hasher := sha256.New()
+	size, err := io.Copy(io.MultiWriter(out, hasher), r)
+	if err != nil {
+		return backupFile{}, fmt.Errorf("failed to extract %s: %v", hdr.Name, err)
+	}
+	if err := out.Close(); err != nil {
+		return backupFile{}, err
+	}
+	return backupFile{Size: size, SHA256: hex.EncodeToString(hasher.Sum(nil))}, nil
+}
+
+// checkNetwork checks that the backup, with the given genesis header and chain
+// config, is of the configured network. Devnets have no canonical genesis, it
+// depends on their validators: the devnet genesis of the validators listed in
+// the header of the backup is rebuilt instead, and the chain config, not part of
+// the genesis hash, must be the devnet one.
+func checkNetwork(config *Config, manifest *backupManifest, header *types.Header, chainConfig *params.ChainConfig) error {
+	var (
+		genesis *core.Genesis
+		err     error
+	)
+	if config.Network.Name == "devnet" {
+		validators, err := pixelzx.CheckpointValidators(header)
+		if err != nil {
+			return fmt.Errorf("backup is not of a devnet: %v", err)
+		}
+		addrs := make([]common.Address, len(validators))
+		for i, v := range validators {
+			addrs[i] = v.Address
+		}
+		genesis = developerGenesis(addrs)
+
+		have, _ := json.Marshal(chainConfig)
+		want, _ := json.Marshal(genesis.Config)
+		if !bytes.Equal(have, want) {
+			return errors.New("backup is of another network: chain config differs from the devnet one")
+		}
+	} else if genesis, err = makeGenesis(config.Network.Name, nil); err != nil {
+		return err
+	}
+	if hash := genesis.ToBlock().Hash(); hash != manifest.Genesis {
+		return fmt.Errorf("backup is of another network: genesis %x, want %x (%s)", manifest.Genesis, hash, config.Network.Name)
+	}
+	chainID := genesis.Config.ChainID.Uint64()
+	if config.Network.ChainID != 0 {
+		chainID = config.Network.ChainID
+	}
+	if manifest.ChainID == nil || manifest.ChainID.Uint64() != chainID {
+		return fmt.Errorf("backup is of another network: chain ID %v, want %d (%s)", manifest.ChainID, chainID, config.Network.Name)
+	}
+	return nil
+}
+
+// checkDatabase opens the staged chain database and checks that it holds the
+// chain described by the manifest, with a consistent ancient store. The genesis
+// header and the chain config of the chain are returned.
+func checkDatabase(dir string, manifest *backupManifest) (*types.Header, *params.ChainConfig, error) {
+	kvdb, err := pebble.New(dir, databaseCache, databaseHandles, "", true)
+	if err != nil {
+		return nil, nil, fmt.Errorf("backup database corrupted: %v", err)
+	}
+	db, err := rawdb.Open(kvdb, rawdb.OpenOptions{Ancient: filepath.Join(dir, "ancient"), ReadOnly: true})
+	if err != nil {
+		kvdb.Close()
+		return nil, nil, fmt.Errorf("backup database corrupted: %v", err)
+	}
+	defer db.Close()
+
+	if hash := rawdb.ReadCanonicalHash(db, 0); hash != manifest.Genesis {
+		return nil, nil, fmt.Errorf("backup database corrupted: genesis %x, manifest %x", hash, manifest.Genesis)
+	}
+	genesis := rawdb.ReadHeader(db, manifest.Genesis, 0)
+	if genesis == nil || genesis.Hash() != manifest.Genesis {
+		return nil, nil, errors.New("backup database corrupted: genesis header missing")
+	}
+	chainConfig := rawdb.ReadChainConfig(db, manifest.Genesis)
+	if chainConfig == nil {
+		return nil, nil, errors.New("backup database corrupted: chain config missing")
+	}
+	if hash := rawdb.ReadHeadBlockHash(db); hash != manifest.HeadHash {
+		return nil, nil, fmt.Errorf("backup database corrupted: head block %x, manifest %x", hash, manifest.HeadHash)
+	}
+	if rawdb.ReadHeader(db, manifest.HeadHash, manifest.HeadNumber) == nil {
+		return nil, nil, fmt.Errorf("backup database corrupted: head block %d missing", manifest.HeadNumber)
+	}
+	if scheme := rawdb.ReadStateScheme(db); scheme != manifest.StateScheme {
+		return nil, nil, fmt.Errorf("backup database corrupted: state scheme %q, manifest %q", scheme, manifest.StateScheme)
+	}
+	frozen, err := db.Ancients()
+	if err != nil {
+		return nil, nil, fmt.Errorf("backup database corrupted: %v", err)
+	}
+	if frozen > 0 {
+		if frozen > manifest.HeadNumber+1 {
+			return nil, nil, fmt.Errorf("backup database corrupted: %d ancient blocks beyond head %d", frozen, manifest.HeadNumber)
+		}
+		if rawdb.ReadCanonicalHash(db, frozen-1) == (common.Hash{}) {
+			return nil, nil, fmt.Errorf("backup database corrupted: ancient block %d missing", frozen-1)
+		}
+	}
+	return genesis, chainConfig, nil
+}
+
+// swapInAll moves the named entries of the staging directory to their resolved
+// paths. The entries replaced are moved into the staging directory, to be removed
+// along with it, and moved back if the swap fails.
+func swapInAll(staging string, resolve func(string) string, names []string) error {
+	var swapped []string
+	for _, name := range names {
+		src := filepath.Join(staging, name)
+		if _, err := os.Stat(src); err != nil {
+			continue
+		}
+		if err := swapIn(src, resolve(name), filepath.Join(staging, "old-"+name)); err != nil {
+			for _, name := range swapped {
+				os.Rename(resolve(name), filepath.Join(staging, name))
+				os.Rename(filepath.Join(staging, "old-"+name), resolve(name)) // fails if nothing was replaced
+			}
+			return fmt.Errorf("failed to restore %s: %v", name, err)
+		}
+		swapped = append(swapped, name)
+	}
+	return nil
+}
+
+// swapIn moves src to dest, moving any existing dest to old first.
+func swapIn(src, dest, old string) error {
+	if _, err := os.Stat(dest); err == nil {
+		if err := os.Rename(dest, old); err != nil {
+			return err
+		}
+	}
+	if err := os.Rename(src, dest); err != nil {
+		os.Rename(old, dest)
+		return err
+	}
+	return nil
+}
+
+// restoreKeys copies the key files of the backup keystore into the keystore,
+// skipping the ones already present.
+func restoreKeys(src, dest string) (int, error) {
+	entries, err := os.ReadDir(src)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if err := os.MkdirAll(dest, 0700); err != nil {
+		return 0, err
+	}
+	var restored int
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(dest, entry.Name())); err == nil {
+			continue
+		}
+		blob, err := os.ReadFile(filepath.Join(src, entry.Name()))
+		if err != nil {
+			return restored, err
+		}
+		if err := os.WriteFile(filepath.Join(dest, entry.Name()), blob, 0600); err != nil {
+			return restored, fmt.Errorf("failed to restore key %s: %v", entry.Name(), err)
+		}
+		restored++
+	}
+	return restored, nil
+}
@@ -0,0 +1,378 @@
+package commands
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io"
+	"math/big"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/beacon"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// writeGenesis writes a genesis to a JSON file, to restore backups of its network
+// into a datadir configured with the file as its network.
+func writeGenesis(t *testing.T, genesis *core.Genesis) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "genesis.json")
+	if err := writeJSONFile(path, genesis); err != nil {
+		t.Fatalf("failed to write genesis: %v", err)
+	}
+	return path
+}
+
+// restoreDatadir runs the restore command on a datadir.
+func restoreDatadir(datadir, network, archive string, args ...string) error {
+	return runCommand(adminRestoreCmd(), append([]string{"--datadir", datadir, "--network", network, "--backup", archive}, args...)...)
+}
+
+// checkHead opens the chain of a datadir, checking that its head is the given
+// block and that the state of the head is available.
+func checkHead(t *testing.T, datadir string, genesis *core.Genesis, head *types.Block) {
+	t.Helper()
+
+	stack, err := newNode(&node.Config{Name: clientIdentifier, DataDir: datadir})
+	if err != nil {
+		t.Fatalf("failed to open datadir: %v", err)
+	}
+	defer stack.Close()
+
+	db, err := openChainDatabase(stack, false)
+	if err != nil {
+		t.Fatalf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	config := core.DefaultConfig().WithStateScheme(rawdb.PathScheme)
+	config.TrieJournalDirectory = stack.ResolvePath("triedb")
+
+	chain, err := core.NewBlockChain(db, genesis, beacon.New(ethash.NewFaker()), config)
+	if err != nil {
+		t.Fatalf("failed to open chain: %v", err)
+	}
+	defer chain.Stop()
+
+	if have := chain.CurrentBlock(); have.Hash() != head.Hash() {
+		t.Fatalf("head mismatch: have %d (%x), want %d (%x)", have.Number, have.Hash(), head.NumberU64(), head.Hash())
+	}
+	statedb, err := chain.StateAt(head.Root())
+	if err != nil {
+		t.Fatalf("head state unavailable: %v", err)
+	}
+	if have := statedb.GetBalance(common.Address{0xaa}); have.Uint64() != head.NumberU64() {
+		t.Fatalf("head balance mismatch: have %v, want %d", have, head.NumberU64())
+	}
+}
+
+// tarEntry is an entry of a crafted backup archive.
+type tarEntry struct {
+	hdr  *tar.Header
+	data []byte
+}
+
+// readEntries reads all entries of a backup archive.
+func readEntries(t *testing.T, file string) []tarEntry {
+	t.Helper()
+
+	blob, err := os.ReadFile(file)
+	if err != nil {
+		t.Fatalf("failed to read backup: %v", err)
+	}
+	gz, err := gzip.NewReader(bytes.NewReader(blob))
+	if err != nil {
+		t.Fatalf("failed to decompress backup: %v", err)
+	}
+	var (
+		tr      = tar.NewReader(gz)
+		entries []tarEntry
+	)
+	for {
+		hdr, err := tr.Next()
+		if err == io.EOF {
+			return entries
+		}
+		if err != nil {
+			t.Fatalf("failed to read backup: %v", err)
+		}
+		data, err := io.ReadAll(tr)
+		if err != nil {
+			t.Fatalf("failed to read %s: %v", hdr.Name, err)
+		}
+		entries = append(entries, tarEntry{hdr: hdr, data: data})
+	}
+}
+
+// writeEntries writes the entries into a backup archive.
+func writeEntries(t *testing.T, file string, entries []tarEntry) {
+	t.Helper()
+
+	var (
+		buf bytes.Buffer
+		gz  = gzip.NewWriter(&buf)
+		tw  = tar.NewWriter(gz)
+	)
+	for _, entry := range entries {
+		entry.hdr.Size = int64(len(entry.data))
+		if err := tw.WriteHeader(entry.hdr); err != nil {
+			t.Fatalf("failed to write %s: %v", entry.hdr.Name, err)
+		}
+		if _, err := tw.Write(entry.data); err != nil {
+			t.Fatalf("failed to write %s: %v", entry.hdr.Name, err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(file, buf.Bytes(), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// Tests that a backup restores into an empty datadir, with the chain, the state
+// and the keys of the backed up one.
+func TestRestore(t *testing.T) {
+	var (
+		source  = t.TempDir()
+		genesis = testGenesis(testKey)
+		head    = newTestDatadir(t, source, genesis, 10)
+	)
+	keyfile := filepath.Join(source, "keystore", "UTC--test")
+	if err := os.MkdirAll(filepath.Dir(keyfile), 0700); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyfile, []byte("{}"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	archive := backupDatadir(t, source, "--with-keystore")
+
+	target := t.TempDir()
+	if err := restoreDatadir(target, writeGenesis(t, genesis), archive); err != nil {
+		t.Fatalf("restore failed: %v", err)
+	}
+	checkHead(t, target, genesis, head)
+
+	if _, err := os.Stat(filepath.Join(target, "keystore", "UTC--test")); err != nil {
+		t.Fatalf("key not restored: %v", err)
+	}
+	if staged, _ := filepath.Glob(filepath.Join(target, clientIdentifier, ".restore-*")); len(staged) != 0 {
+		t.Fatalf("staging directory left behind: %v", staged)
+	}
+}
+
+// Tests that the chain data of a datadir is only replaced with --force.
+func TestRestoreForce(t *testing.T) {
+	var (
+		source  = t.TempDir()
+		target  = t.TempDir()
+		genesis = testGenesis(testKey)
+		network = writeGenesis(t, genesis)
+		head    = newTestDatadir(t, source, genesis, 10)
+		current = newTestDatadir(t, target, genesis, 3)
+		archive = backupDatadir(t, source)
+	)
+	err := restoreDatadir(target, network, archive)
+	if err == nil || !strings.Contains(err.Error(), "--force") {
+		t.Fatalf("restore error mismatch: have %v, want --force required", err)
+	}
+	checkHead(t, target, genesis, current)
+
+	if err := restoreDatadir(target, network, archive, "--force"); err != nil {
+		t.Fatalf("forced restore failed: %v", err)
+	}
+	checkHead(t, target, genesis, head)
+}
+
+// Tests that archives with entries escaping the staging directory, symbolic
+// links or files not matching their checksum are rejected, leaving the datadir
+// untouched.
+func TestRestoreInvalidArchive(t *testing.T) {
+	var (
+		source  = t.TempDir()
+		genesis = testGenesis(testKey)
+		network = writeGenesis(t, genesis)
+	)
+	newTestDatadir(t, source, genesis, 10)
+	entries := readEntries(t, backupDatadir(t, source))
+
+	tamper := func(entries []tarEntry) []tarEntry {
+		for i, entry := range entries {
+			if entry.hdr.Typeflag == tar.TypeReg && strings.HasPrefix(entry.hdr.Name, "chaindata/") && len(entry.data) > 0 {
+				data := bytes.Clone(entry.data)
+				data[0] ^= 0xff
+				entries[i] = tarEntry{hdr: entry.hdr, data: data}
+				break
+			}
+		}
+		return entries
+	}
+	tests := []struct {
+		name    string
+		entries []tarEntry
+		err     string
+	}{
+		{
+			name:    "parent",
+			entries: append([]tarEntry{{hdr: &tar.Header{Name: "../evil", Typeflag: tar.TypeReg, Mode: 0644}, data: []byte("evil")}}, entries...),
+			err:     "unsafe path",
+		},
+		{
+			name:    "absolute",
+			entries: append([]tarEntry{{hdr: &tar.Header{Name: "/evil", Typeflag: tar.TypeReg, Mode: 0644}, data: []byte("evil")}}, entries...),
+			err:     "unsafe path",
+		},
+		{
+			name:    "symlink",
+			entries: append([]tarEntry{{hdr: &tar.Header{Name: "chaindata/evil", Typeflag: tar.TypeSymlink, Linkname: "../../evil"}}}, entries...),
+			err:     "unexpected entry",
+		},
+		{
+			name:    "tampered",
+			entries: tamper(readEntries(t, backupDatadir(t, source))),
+			err:     "checksum mismatch",
+		},
+		{
+			name:    "unlisted",
+			entries: append([]tarEntry{{hdr: &tar.Header{Name: "chaindata/extra", Typeflag: tar.TypeReg, Mode: 0644}, data: []byte("extra")}}, entries...),
+			err:     "not in manifest",
+		},
+	}
+	for _, tt := range tests {
+		archive := filepath.Join(t.TempDir(), "backup.tar.gz")
+		writeEntries(t, archive, tt.entries)
+
+		target := t.TempDir()
+		err := restoreDatadir(target, network, archive)
+		if err == nil || !strings.Contains(err.Error(), tt.err) {
+			t.Errorf("%s: restore error mismatch: have %v, want %s", tt.name, err, tt.err)
+		}
+		for _, path := range []string{filepath.Join(target, "evil"), filepath.Join(target, clientIdentifier, "evil"), filepath.Join(target, clientIdentifier, "chaindata")} {
+			if _, err := os.Lstat(path); err == nil {
+				t.Errorf("%s: %s written by rejected restore", tt.name, path)
+			}
+		}
+	}
+}
+
+// Tests that backups are only restored into datadirs of their network, and that
+// devnet backups must hold a devnet genesis, as generated for their validators.
+func TestRestoreNetwork(t *testing.T) {
+	var (
+		source  = t.TempDir()
+		genesis = testGenesis(testKey)
+	)
+	newTestDatadir(t, source, genesis, 10)
+	archive := backupDatadir(t, source)
+
+	other := testGenesis(common.FromHex("8a1f9a8f95be41cd7ccb6168179afb4504aefe388d1e14474d32c45c72ce7b7a"))
+	if err := restoreDatadir(t.TempDir(), writeGenesis(t, other), archive); err == nil || !strings.Contains(err.Error(), "another network") {
+		t.Fatalf("restore error mismatch: have %v, want another network", err)
+	}
+	if err := restoreDatadir(t.TempDir(), "devnet", archive); err == nil || !strings.Contains(err.Error(), "another network") {
+		t.Fatalf("devnet restore error mismatch: have %v, want another network", err)
+	}
+	// Devnets are checked against the genesis of their validators
+	var (
+		validators = []common.Address{{0x01}, {0x02}}
+		devnet     = t.TempDir()
+	)
+	newTestDatadir(t, devnet, developerGenesis(validators), 0)
+	if err := restoreDatadir(t.TempDir(), "devnet", backupDatadir(t, devnet)); err != nil {
+		t.Fatalf("devnet restore failed: %v", err)
+	}
+	for name, modify := range map[string]func(genesis *core.Genesis){
+		"config": func(genesis *core.Genesis) {
+			pixelzx := *genesis.Config.Pixelzx
+			pixelzx.DoubleSignSlash = 10_000
+			genesis.Config.Pixelzx = &pixelzx
+		},
+		"stake": func(genesis *core.Genesis) {
+			genesis.Alloc[params.PixelzxStakingAddress] = types.Account{
+				Code:    genesis.Alloc[params.PixelzxStakingAddress].Code,
+				Storage: genesis.Alloc[params.PixelzxStakingAddress].Storage,
+				Balance: new(big.Int).Add(genesis.Alloc[params.PixelzxStakingAddress].Balance, common.Big1),
+				Nonce:   genesis.Alloc[params.PixelzxStakingAddress].Nonce,
+			}
+		},
+	} {
+		forged := developerGenesis(validators)
+		config := *forged.Config
+		forged.Config = &config
+		modify(forged)
+
+		datadir := t.TempDir()
+		newTestDatadir(t, datadir, forged, 0)
+		if err := restoreDatadir(t.TempDir(), "devnet", backupDatadir(t, datadir)); err == nil || !strings.Contains(err.Error(), "another network") {
+			t.Errorf("%s: devnet restore error mismatch: have %v, want another network", name, err)
+		}
+	}
+}
+
+// Tests that a failed swap moves the chain data replaced so far back into place.
+func TestSwapInRollback(t *testing.T) {
+	var (
+		staging = t.TempDir()
+		datadir = t.TempDir()
+		resolve = func(name string) string { return filepath.Join(datadir, name) }
+	)
+	write := func(path, content string) {
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write(filepath.Join(datadir, "chaindata", "db"), "old chaindata")
+	write(filepath.Join(datadir, "triedb", "journal"), "old triedb")
+	write(filepath.Join(staging, "chaindata", "db"), "new chaindata")
+	write(filepath.Join(staging, "triedb", "journal"), "new triedb")
+	write(filepath.Join(staging, "nodekey"), "new nodekey")
+
+	// Block moving the old trie journal aside, failing the second swap
+	write(filepath.Join(staging, "old-triedb", "blocker"), "")
+
+	if err := swapInAll(staging, resolve, []string{"chaindata", "triedb", "nodekey"}); err == nil {
+		t.Fatalf("swap succeeded despite the blocked trie journal")
+	}
+	for path, want := range map[string]string{
+		filepath.Join(datadir, "chaindata", "db"):       "old chaindata",
+		filepath.Join(datadir, "triedb", "journal"):     "old triedb",
+		filepath.Join(staging, "chaindata", "db"):       "new chaindata",
+		filepath.Join(staging, "triedb", "journal"):     "new triedb",
+		filepath.Join(staging, "nodekey"):               "new nodekey",
+		filepath.Join(staging, "old-triedb", "blocker"): "",
+	} {
+		if have, err := os.ReadFile(path); err != nil || string(have) != want {
+			t.Errorf("%s mismatch: have %q (%v), want %q", path, have, err, want)
+		}
+	}
+	if _, err := os.Stat(filepath.Join(datadir, "nodekey")); err == nil {
+		t.Errorf("node key swapped in despite the failure")
+	}
+	// A swap into a datadir without the entries moves them in
+	if err := os.RemoveAll(filepath.Join(staging, "old-triedb")); err != nil {
+		t.Fatal(err)
+	}
+	empty := t.TempDir()
+	if err := swapInAll(staging, func(name string) string { return filepath.Join(empty, name) }, []string{"chaindata", "triedb", "nodekey"}); err != nil {
+		t.Fatalf("swap failed: %v", err)
+	}
+	if have, err := os.ReadFile(filepath.Join(empty, "nodekey")); err != nil || string(have) != "new nodekey" {
+		t.Fatalf("node key mismatch: have %q (%v)", have, err)
+	}
+}
@@ -2,22 +2,111 @@ package commands
 
 import (
 	"fmt"
+	"math/big"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/urfave/cli/v2"
 )
 
+// nodeInfo is the subset of admin_nodeInfo the status reports, with the eth
+// protocol metadata decoded.
+type nodeInfo struct {
+	p2p.NodeInfo
+	Protocols struct {
+		Eth *struct {
+			Network uint64      `json:"network"`
+			Genesis common.Hash `json:"genesis"`
+			Head    common.Hash `json:"head"`
+		} `json:"eth"`
+	} `json:"protocols"`
+}
+
+// nodeStatus is the JSON representation of the node status.
+type nodeStatus struct {
+	Name         string      `json:"name"`
+	Enode        string      `json:"enode"`
+	ChainID      *big.Int    `json:"chainId"`
+	NetworkID    uint64      `json:"networkId"`
+	Genesis      common.Hash `json:"genesis"`
+	Peers        int         `json:"peers"`
+	InboundPeers int         `json:"inboundPeers"`
+	BlockNumber  uint64      `json:"blockNumber"`
+	Syncing      bool        `json:"syncing"`
+	HighestBlock uint64      `json:"highestBlock,omitempty"`
+}
+
 // adminStatusCmd returns the status subcommand for admin
 func adminStatusCmd() *cli.Command {
 	return &cli.Command{
-		Name:  "status",
-		Usage: "Show node status",
-		Action: func(c *cli.Context) error {
-			fmt.Println("Node Status: Running")
-			fmt.Println("Network: pixelzx-mainnet")
-			fmt.Println("Peers: 12")
-			fmt.Println("Block Height: 12345")
-			fmt.Println("Sync Status: Synced")
-			return nil
-		},
-	}
-}
\ No newline at end of file
+		Name:   "status",
+		Usage:  "Show node status",
+		Flags:  append([]cli.Flag{jsonFlag}, attachFlags...),
+		Action: adminStatus,
+	}
+}
+
+// adminStatus prints the identity, peers and sync status of the running node.
+func adminStatus(ctx *cli.Context) error {
+	client, err := attachNode(ctx)
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+
+	var info nodeInfo
+	if err := client.CallContext(ctx.Context, &info, "admin_nodeInfo"); err != nil {
+		return fmt.Errorf("failed to retrieve node info: %v", err)
+	}
+	var peers []*p2p.PeerInfo
+	if err := client.CallContext(ctx.Context, &peers, "admin_peers"); err != nil {
+		return fmt.Errorf("failed to retrieve peers: %v", err)
+	}
+	eth := ethclient.NewClient(client)
+	chainID, err := eth.ChainID(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve chain ID: %v", err)
+	}
+	number, err := eth.BlockNumber(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve block number: %v", err)
+	}
+	progress, err := eth.SyncProgress(ctx.Context)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve sync status: %v", err)
+	}
+	status := nodeStatus{
+		Name:        info.Name,
+		Enode:       info.Enode,
+		ChainID:     chainID,
+		Peers:       len(peers),
+		BlockNumber: number,
+	}
+	if eth := info.Protocols.Eth; eth != nil {
+		status.NetworkID, status.Genesis = eth.Network, eth.Genesis
+	}
+	for _, peer := range peers {
+		if peer.Network.Inbound {
+			status.InboundPeers++
+		}
+	}
+	if progress != nil {
+		status.Syncing, status.HighestBlock = true, progress.HighestBlock
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(status)
+	}
+	fmt.Println("Node:          ", status.Name)
+	fmt.Println("Enode:         ", status.Enode)
+	fmt.Printf("Network:        chain ID %v, network ID %d\n", status.ChainID, status.NetworkID)
+	fmt.Println("Genesis:       ", status.Genesis.Hex())
+	fmt.Printf("Peers:          %d (%d inbound)\n", status.Peers, status.InboundPeers)
+	fmt.Println("Block height:  ", status.BlockNumber)
+	if status.Syncing {
+		fmt.Printf("Sync status:    syncing (block %d of %d)\n", progress.CurrentBlock, progress.HighestBlock)
+	} else {
+		fmt.Println("Sync status:    synced")
+	}
+	return nil
+}
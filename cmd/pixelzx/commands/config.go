@@ -0,0 +1,144 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the node configuration loaded from the YAML configuration files in
+// the configs directory. Every section maps onto the node, eth and p2p configs.
+type Config struct {
+	Network   NetworkConfig  `yaml:"network"`
+	Node      NodeConfig     `yaml:"node"`
+	RPC       EndpointConfig `yaml:"rpc"`
+	WebSocket EndpointConfig `yaml:"websocket"`
+	P2P       P2PConfig      `yaml:"p2p"`
+	Metrics   MetricsConfig  `yaml:"metrics"`
+}
+
+// NetworkConfig selects the network the node joins.
+type NetworkConfig struct {
+	Name        string   `yaml:"name"`         // mainnet, testnet, devnet or path to a genesis JSON file
+	ChainID     uint64   `yaml:"chain_id"`     // Expected chain ID of the network, 0 to accept any
+	Bootnodes   []string `yaml:"bootnodes"`    // Enode URLs of the bootstrap nodes
+	StaticNodes []string `yaml:"static_nodes"` // Enode URLs of the peers to always stay connected to
+}
+
+// NodeConfig configures the local storage of the node.
+type NodeConfig struct {
+	DataDir       string `yaml:"datadir"`        // Data directory for the databases
+	KeyStore      string `yaml:"keystore"`       // Keystore directory, defaults to <datadir>/keystore
+	ConsensusKeys string `yaml:"consensus_keys"` // Consensus key directory, defaults to <datadir>/consensus-keys
+}
+
+// EndpointConfig configures an RPC endpoint of the node.
+type EndpointConfig struct {
+	Enabled bool     `yaml:"enabled"` // Whether the endpoint is served
+	Addr    string   `yaml:"addr"`    // Listening interface
+	Port    int      `yaml:"port"`    // Listening port
+	Modules []string `yaml:"modules"` // API namespaces offered over the endpoint
+}
+
+// P2PConfig configures the peer-to-peer networking of the node.
+type P2PConfig struct {
+	Addr     string `yaml:"addr"`      // Listening interface
+	Port     int    `yaml:"port"`      // Listening port
+	MaxPeers int    `yaml:"max_peers"` // Maximum number of connected peers
+}
+
+// MetricsConfig configures the metrics collection of the node.
+type MetricsConfig struct {
+	Enabled bool `yaml:"enabled"` // Whether metrics are collected, served over debug_metrics
+}
+
+// defaultConfig returns the configuration used for the values not set in the
+// configuration file nor on the command line.
+func defaultConfig() *Config {
+	return &Config{
+		Network: NetworkConfig{Name: NetworkFlag.Value},
+		Node:    NodeConfig{DataDir: DataDirFlag.Value},
+		RPC: EndpointConfig{
+			Addr:    "127.0.0.1",
+			Port:    8545,
+			Modules: []string{"eth", "net", "web3", "txpool", "pixelzx"},
+		},
+		WebSocket: EndpointConfig{
+			Addr:    "127.0.0.1",
+			Port:    8546,
+			Modules: []string{"eth", "net", "web3", "pixelzx"},
+		},
+		P2P: P2PConfig{
+			Addr:     "0.0.0.0",
+			Port:     30303,
+			MaxPeers: 50,
+		},
+	}
+}
+
+// loadConfig reads a YAML configuration file on top of the defaults. Unknown
+// keys are rejected, so typos don't silently fall back to the defaults.
+func loadConfig(path string) (*Config, error) {
+	config := defaultConfig()
+	if path == "" {
+		return config, nil
+	}
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	dec := yaml.NewDecoder(file)
+	dec.KnownFields(true)
+	if err := dec.Decode(config); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid config file %s: %v", path, err)
+	}
+	return config, nil
+}
+
+// validate checks the sanity of the configured values.
+func (c *Config) validate() error {
+	if c.Network.Name == "" {
+		return errors.New("network.name not set")
+	}
+	if c.Node.DataDir == "" {
+		return errors.New("node.datadir not set")
+	}
+	for _, url := range c.Network.Bootnodes {
+		if _, err := enode.Parse(enode.ValidSchemes, url); err != nil {
+			return fmt.Errorf("invalid bootnode %q: %v", url, err)
+		}
+	}
+	for _, url := range c.Network.StaticNodes {
+		if _, err := enode.Parse(enode.ValidSchemes, url); err != nil {
+			return fmt.Errorf("invalid static node %q: %v", url, err)
+		}
+	}
+	for name, port := range map[string]int{"rpc.port": c.RPC.Port, "websocket.port": c.WebSocket.Port, "p2p.port": c.P2P.Port} {
+		if port < 0 || port > 65535 {
+			return fmt.Errorf("%s out of range: %d", name, port)
+		}
+	}
+	return nil
+}
+
+// consensusKeyDir returns the directory of the validator consensus keys.
+func (c *NodeConfig) consensusKeyDir() string {
+	if c.ConsensusKeys != "" {
+		return c.ConsensusKeys
+	}
+	return filepath.Join(c.DataDir, "consensus-keys")
+}
+
+// listenAddr returns the p2p listening address.
+func (c *P2PConfig) listenAddr() string {
+	return net.JoinHostPort(c.Addr, strconv.Itoa(c.Port))
+}
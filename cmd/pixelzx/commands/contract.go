@@ -0,0 +1,248 @@
+package commands
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/bindings"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+)
+
+// receiptTimeout is how long to wait for a sent transaction to be included.
+const receiptTimeout = 2 * time.Minute
+
+var (
+	rpcFlag = &cli.StringFlag{
+		Name:  "rpc",
+		Usage: "RPC endpoint of the node to talk to",
+		Value: "http://127.0.0.1:8545",
+	}
+	fromFlag = &cli.StringFlag{
+		Name:  "from",
+		Usage: "Keystore account to send the transaction from (default = first account)",
+	}
+)
+
+// transactFlags are the flags of the commands sending system contract transactions.
+var transactFlags = []cli.Flag{
+	rpcFlag,
+	configFileFlag,
+	DataDirFlag,
+	keyStoreDirFlag,
+	fromFlag,
+	passwordFileFlag,
+	jsonFlag,
+}
+
+// contractClient talks to a system contract through a node.
+type contractClient struct {
+	client   *ethclient.Client
+	address  common.Address
+	contract *bind.BoundContract
+}
+
+// dialContract connects to the node given by the --rpc flag, to talk to the
+// system contract at the given address through the instance of its bindings.
+func dialContract(ctx *cli.Context, address common.Address, instance func(bind.ContractBackend, common.Address) *bind.BoundContract) (*contractClient, error) {
+	client, err := ethclient.DialContext(ctx.Context, ctx.String(rpcFlag.Name))
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to %s: %v", ctx.String(rpcFlag.Name), err)
+	}
+	return &contractClient{
+		client:   client,
+		address:  address,
+		contract: instance(client, address),
+	}, nil
+}
+
+// stakingClient talks to the staking system contract through a node.
+type stakingClient struct {
+	*contractClient
+	abi *bindings.Staking
+}
+
+// dialStaking connects to the node given by the --rpc flag.
+func dialStaking(ctx *cli.Context) (*stakingClient, error) {
+	abi := bindings.NewStaking()
+	client, err := dialContract(ctx, params.PixelzxStakingAddress, abi.Instance)
+	if err != nil {
+		return nil, err
+	}
+	return &stakingClient{contractClient: client, abi: abi}, nil
+}
+
+// Close disconnects from the node.
+func (s *contractClient) Close() {
+	s.client.Close()
+}
+
+// callOpts returns the options to call the contract at the head of the chain.
+func (s *contractClient) callOpts(ctx *cli.Context) *bind.CallOpts {
+	return &bind.CallOpts{Context: ctx.Context}
+}
+
+// sender resolves the keystore account given by --from, or the first account of
+// the keystore if unset.
+func sender(ctx *cli.Context, ks *keystore.KeyStore) (accounts.Account, error) {
+	if addr := ctx.String(fromFlag.Name); addr != "" {
+		return findAccount(ks, addr)
+	}
+	if len(ks.Accounts()) == 0 {
+		return accounts.Account{}, errors.New("no account in the keystore, create one with pixelzx account new")
+	}
+	return ks.Accounts()[0], nil
+}
+
+// transactOpts unlocks the sending account in the keystore and returns the
+// options to sign transactions with it.
+func (s *contractClient) transactOpts(ctx *cli.Context) (*bind.TransactOpts, error) {
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := sender(ctx, ks)
+	if err != nil {
+		return nil, err
+	}
+	password, err := getPassword(ctx, passwordFileFlag, fmt.Sprintf("Please provide the password for account %s", account.Address.Hex()), false)
+	if err != nil {
+		return nil, err
+	}
+	if err := ks.Unlock(account, password); err != nil {
+		return nil, fmt.Errorf("failed to unlock account %s: %v", account.Address.Hex(), err)
+	}
+	chainID, err := s.client.ChainID(ctx.Context)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve chain ID: %v", err)
+	}
+	opts := bind.NewKeyStoreTransactor(ks, account, chainID)
+	opts.Context = ctx.Context
+	return opts, nil
+}
+
+// transact sends a transaction calling the contract and waits for it to be
+// included in a block. Calls the contract would revert are rejected before
+// sending, with the revert reason, as the gas estimation fails for them.
+func (s *contractClient) transact(ctx *cli.Context, opts *bind.TransactOpts, data []byte) (*types.Receipt, error) {
+	tx, err := bind.Transact(s.contract, opts, data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send transaction: %v", err)
+	}
+	if !ctx.Bool(jsonFlag.Name) {
+		fmt.Printf("Sent transaction %s, waiting for inclusion...\n", tx.Hash().Hex())
+	}
+	wait, cancel := context.WithTimeout(ctx.Context, receiptTimeout)
+	defer cancel()
+
+	receipt, err := bind.WaitMined(wait, s.client, tx.Hash())
+	if err != nil {
+		return nil, fmt.Errorf("transaction %s not included: %v", tx.Hash().Hex(), err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("transaction %s failed in block %d", tx.Hash().Hex(), receipt.BlockNumber)
+	}
+	return receipt, nil
+}
+
+// simulation is the JSON representation of a dry-run call.
+type simulation struct {
+	From   common.Address `json:"from"`
+	Gas    uint64         `json:"gas"`
+	Output hexutil.Bytes  `json:"output"`
+}
+
+// execute sends the transaction calling the contract with the given value, or
+// simulates it on --dry-run. The output of the simulation is returned for the
+// caller to decode, nil if the transaction is sent.
+func (s *contractClient) execute(ctx *cli.Context, value *big.Int, data []byte) ([]byte, error) {
+	if !ctx.Bool(dryRunFlag.Name) {
+		opts, err := s.transactOpts(ctx)
+		if err != nil {
+			return nil, err
+		}
+		opts.Value = value
+		receipt, err := s.transact(ctx, opts, data)
+		if err != nil {
+			return nil, err
+		}
+		return nil, printReceipt(ctx, receipt)
+	}
+	ks, err := openKeyStore(ctx)
+	if err != nil {
+		return nil, err
+	}
+	account, err := sender(ctx, ks)
+	if err != nil {
+		return nil, err
+	}
+	msg := ethereum.CallMsg{
+		From:  account.Address,
+		To:    &s.address,
+		Value: value,
+		Data:  data,
+	}
+	output, err := s.client.CallContract(ctx.Context, msg, nil)
+	if err != nil {
+		return nil, fmt.Errorf("call would fail: %v", err)
+	}
+	gas, err := s.client.EstimateGas(ctx.Context, msg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to estimate gas: %v", err)
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return output, printJSON(simulation{From: account.Address, Gas: gas, Output: output})
+	}
+	fmt.Printf("Dry run from %s succeeded, estimated gas: %d\n", account.Address.Hex(), gas)
+	return output, nil
+}
+
+// txResult is the JSON representation of an included transaction.
+type txResult struct {
+	Hash        common.Hash `json:"hash"`
+	BlockNumber *big.Int    `json:"blockNumber"`
+	GasUsed     uint64      `json:"gasUsed"`
+}
+
+// printReceipt prints the inclusion of a successful transaction.
+func printReceipt(ctx *cli.Context, receipt *types.Receipt) error {
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(txResult{Hash: receipt.TxHash, BlockNumber: receipt.BlockNumber, GasUsed: receipt.GasUsed})
+	}
+	fmt.Printf("Transaction included in block %d (gas used: %d)\n", receipt.BlockNumber, receipt.GasUsed)
+	return nil
+}
+
+// parsePZX parses a decimal amount of PZX into wei.
+func parsePZX(amount string) (*big.Int, error) {
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(amount))
+	if !ok || value.Sign() < 0 {
+		return nil, fmt.Errorf("invalid amount %q", amount)
+	}
+	value.Mul(value, new(big.Rat).SetInt64(params.PZX))
+	if !value.IsInt() {
+		return nil, fmt.Errorf("invalid amount %q: more precise than 1 wei", amount)
+	}
+	return value.Num(), nil
+}
+
+// formatPZX formats an amount of wei as a decimal amount of PZX.
+func formatPZX(wei *big.Int) string {
+	whole, frac := new(big.Int).QuoRem(wei, big.NewInt(params.PZX), new(big.Int))
+	if frac.Sign() == 0 {
+		return whole.String() + " PZX"
+	}
+	return fmt.Sprintf("%s.%s PZX", whole, strings.TrimRight(fmt.Sprintf("%018s", frac), "0"))
+}
@@ -0,0 +1,35 @@
+package commands
+
+import (
+	"os"
+	"path/filepath"
+
+	"github.com/urfave/cli/v2"
+)
+
+var (
+	// DataDirFlag is the data directory of the node, holding the databases and
+	// the keystore.
+	DataDirFlag = &cli.StringFlag{
+		Name:  "datadir",
+		Usage: "Data directory for the databases and keystore",
+		Value: defaultDataDir(),
+	}
+	// NetworkFlag selects the network to join: mainnet, testnet, devnet or the
+	// path of a genesis JSON file.
+	NetworkFlag = &cli.StringFlag{
+		Name:  "network",
+		Usage: "Network to join (mainnet, testnet, devnet or path to a genesis JSON file)",
+		Value: "mainnet",
+	}
+)
+
+// defaultDataDir returns the default data directory of the node, in the home
+// directory of the user.
+func defaultDataDir() string {
+	home, err := os.UserHomeDir()
+	if err != nil || home == "" {
+		return ".pixelzx"
+	}
+	return filepath.Join(home, ".pixelzx")
+}
@@ -0,0 +1,342 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/bindings"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/governance"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+)
+
+var descriptionFlag = &cli.StringFlag{
+	Name:  "description",
+	Usage: "Rationale of the proposal, shown to the voters",
+}
+
+// GovCommand defines the gov command structure
+var GovCommand = &cli.Command{
+	Name:  "gov",
+	Usage: "On-chain governance commands",
+	Description: `
+Propose changes to network parameters and vote on them through the governance
+system contract of a running node, given by --rpc. Governance must be enabled in
+the chain configuration, with a non-zero votingPeriod. Networks launched without
+it enable it from the block given as governanceBlock on.
+
+Stakers vote with the stake they bonded to validators. Once the voting period of
+a proposal ends, it is tallied at the next epoch boundary: it is approved if a
+third of the bonded stake voted and a majority of the votes are in favour. An
+approved proposal is applied at the first epoch boundary after its execution
+delay, from the next block on.
+
+The governed parameters and the units of their values are:
+
+   gasLimit        block gas limit producers move towards, in gas
+   minGasPrice     minimum gas tip nodes accept transactions with, in gwei
+   blockReward     block reward issued to proposers, in PZX
+   maxValidators   maximum number of active validators per epoch`,
+	Subcommands: []*cli.Command{
+		{
+			Name:      "propose",
+			Usage:     "Propose a change to a network parameter",
+			ArgsUsage: "<param> <value>",
+			Flags:     append([]cli.Flag{descriptionFlag, dryRunFlag}, transactFlags...),
+			Description: `
+Proposes to change the given parameter to the given value. The sending account
+must have stake bonded to a validator.`,
+			Action: govPropose,
+		},
+		{
+			Name:      "vote",
+			Usage:     "Vote on a proposal",
+			ArgsUsage: "<id> <yes|no>",
+			Flags:     append([]cli.Flag{dryRunFlag}, transactFlags...),
+			Description: `
+Votes in favour of or against a proposal with all the stake the sending account
+bonded to validators. Every account votes once per proposal, while its voting
+period lasts.`,
+			Action: govVote,
+		},
+		{
+			Name:   "list",
+			Usage:  "List the proposals and the governed parameters",
+			Flags:  []cli.Flag{rpcFlag, jsonFlag},
+			Action: govList,
+		},
+	},
+}
+
+// govClient talks to the governance system contract through a node.
+type govClient struct {
+	*contractClient
+	abi *bindings.Governance
+}
+
+// dialGovernance connects to the node given by the --rpc flag, failing if the
+// network is not governed on chain.
+func dialGovernance(ctx *cli.Context) (*govClient, error) {
+	abi := bindings.NewGovernance()
+	client, err := dialContract(ctx, params.PixelzxGovernanceAddress, abi.Instance)
+	if err != nil {
+		return nil, err
+	}
+	code, err := client.client.CodeAt(ctx.Context, params.PixelzxGovernanceAddress, nil)
+	if err != nil {
+		client.Close()
+		return nil, fmt.Errorf("failed to retrieve governance contract: %v", err)
+	}
+	if len(code) == 0 {
+		client.Close()
+		return nil, errors.New("governance is not enabled on this network")
+	}
+	return &govClient{contractClient: client, abi: abi}, nil
+}
+
+// parseParamValue parses the value of a governed parameter, given in its unit.
+func parseParamValue(param governance.Param, value string) (*big.Int, error) {
+	switch param {
+	case governance.BlockReward:
+		return parsePZX(value)
+	case governance.MinGasPrice:
+		gwei, ok := new(big.Rat).SetString(strings.TrimSpace(value))
+		if !ok || gwei.Sign() < 0 {
+			return nil, fmt.Errorf("invalid gas price %q", value)
+		}
+		wei := gwei.Mul(gwei, new(big.Rat).SetInt64(params.GWei))
+		if !wei.IsInt() {
+			return nil, fmt.Errorf("invalid gas price %q: more precise than 1 wei", value)
+		}
+		return wei.Num(), nil
+	default:
+		n, ok := new(big.Int).SetString(strings.TrimSpace(value), 10)
+		if !ok || n.Sign() < 0 {
+			return nil, fmt.Errorf("invalid %s %q", param, value)
+		}
+		return n, nil
+	}
+}
+
+// formatParamValue formats the value of a governed parameter in its unit.
+func formatParamValue(param governance.Param, value *big.Int) string {
+	switch param {
+	case governance.BlockReward:
+		return formatPZX(value)
+	case governance.MinGasPrice:
+		gwei := new(big.Rat).SetFrac(value, big.NewInt(params.GWei))
+		return strings.TrimRight(strings.TrimRight(gwei.FloatString(9), "0"), ".") + " gwei"
+	default:
+		return value.String()
+	}
+}
+
+// proposalRecord is the JSON representation of a proposal.
+type proposalRecord struct {
+	ID             uint64         `json:"id"`
+	Proposer       common.Address `json:"proposer"`
+	Param          string         `json:"param"`
+	Value          *hexutil.Big   `json:"value"`
+	Description    string         `json:"description"`
+	Status         string         `json:"status"`
+	VotingEnd      uint64         `json:"votingEnd"`
+	ExecutionBlock uint64         `json:"executionBlock,omitempty"`
+	YesVotes       *hexutil.Big   `json:"yesVotes"`
+	NoVotes        *hexutil.Big   `json:"noVotes"`
+}
+
+// paramRecord is the JSON representation of a governed parameter.
+type paramRecord struct {
+	Param string       `json:"param"`
+	Set   bool         `json:"set"`
+	Value *hexutil.Big `json:"value,omitempty"`
+}
+
+// proposalResult is the JSON representation of a sent proposal.
+type proposalResult struct {
+	txResult
+	ID        uint64 `json:"id"`
+	VotingEnd uint64 `json:"votingEnd"`
+}
+
+// govPropose proposes a change to a network parameter.
+func govPropose(ctx *cli.Context) error {
+	if ctx.Args().Len() != 2 {
+		return errors.New("parameter and value must be given as arguments")
+	}
+	param, err := governance.ParseParam(ctx.Args().Get(0))
+	if err != nil {
+		return err
+	}
+	value, err := parseParamValue(param, ctx.Args().Get(1))
+	if err != nil {
+		return err
+	}
+	g, err := dialGovernance(ctx)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	data := g.abi.PackPropose(uint8(param), value, ctx.String(descriptionFlag.Name))
+	if ctx.Bool(dryRunFlag.Name) {
+		output, err := g.execute(ctx, nil, data)
+		if err != nil || ctx.Bool(jsonFlag.Name) {
+			return err
+		}
+		id, err := g.abi.UnpackPropose(output)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Would create proposal #%d\n", id)
+		return nil
+	}
+	opts, err := g.transactOpts(ctx)
+	if err != nil {
+		return err
+	}
+	receipt, err := g.transact(ctx, opts, data)
+	if err != nil {
+		return err
+	}
+	var created *bindings.GovernanceProposalCreated
+	for _, log := range receipt.Logs {
+		if event, err := g.abi.UnpackProposalCreatedEvent(log); err == nil {
+			created = event
+		}
+	}
+	if created == nil {
+		return fmt.Errorf("transaction %s created no proposal", receipt.TxHash.Hex())
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(proposalResult{
+			txResult:  txResult{Hash: receipt.TxHash, BlockNumber: receipt.BlockNumber, GasUsed: receipt.GasUsed},
+			ID:        created.Id.Uint64(),
+			VotingEnd: created.VotingEnd.Uint64(),
+		})
+	}
+	fmt.Printf("Created proposal #%d to set %s to %s, voting ends at block %d\n",
+		created.Id, param, formatParamValue(param, value), created.VotingEnd)
+	return printReceipt(ctx, receipt)
+}
+
+// govVote votes on a proposal.
+func govVote(ctx *cli.Context) error {
+	if ctx.Args().Len() != 2 {
+		return errors.New("proposal id and vote (yes or no) must be given as arguments")
+	}
+	id, err := strconv.ParseUint(ctx.Args().Get(0), 10, 64)
+	if err != nil {
+		return fmt.Errorf("invalid proposal id: %q", ctx.Args().Get(0))
+	}
+	var support bool
+	switch strings.ToLower(ctx.Args().Get(1)) {
+	case "yes":
+		support = true
+	case "no":
+		support = false
+	default:
+		return fmt.Errorf("invalid vote %q, must be yes or no", ctx.Args().Get(1))
+	}
+	g, err := dialGovernance(ctx)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	if _, err := g.execute(ctx, nil, g.abi.PackVote(new(big.Int).SetUint64(id), support)); err != nil {
+		return err
+	}
+	if !ctx.Bool(jsonFlag.Name) && !ctx.Bool(dryRunFlag.Name) {
+		fmt.Printf("Voted %s on proposal #%d\n", ctx.Args().Get(1), id)
+	}
+	return nil
+}
+
+// govList prints the proposals made and the values of the governed parameters.
+func govList(ctx *cli.Context) error {
+	g, err := dialGovernance(ctx)
+	if err != nil {
+		return err
+	}
+	defer g.Close()
+
+	values := make([]paramRecord, 0, len(governance.Params))
+	for _, param := range governance.Params {
+		out, err := bind.Call(g.contract, g.callOpts(ctx), g.abi.PackGetParam(uint8(param)), g.abi.UnpackGetParam)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve %s: %v", param, err)
+		}
+		record := paramRecord{Param: param.String(), Set: out.Set}
+		if out.Set {
+			record.Value = (*hexutil.Big)(out.Value)
+		}
+		values = append(values, record)
+	}
+	count, err := bind.Call(g.contract, g.callOpts(ctx), g.abi.PackProposalCount(), g.abi.UnpackProposalCount)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve proposal count: %v", err)
+	}
+	proposals := make([]proposalRecord, 0, count.Uint64())
+	for id := uint64(1); id <= count.Uint64(); id++ {
+		p, err := bind.Call(g.contract, g.callOpts(ctx), g.abi.PackGetProposal(new(big.Int).SetUint64(id)), g.abi.UnpackGetProposal)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve proposal #%d: %v", id, err)
+		}
+		proposals = append(proposals, proposalRecord{
+			ID:             id,
+			Proposer:       p.Proposer,
+			Param:          governance.Param(p.Param).String(),
+			Value:          (*hexutil.Big)(p.Value),
+			Description:    p.Description,
+			Status:         governance.Status(p.Status).String(),
+			VotingEnd:      p.VotingEnd.Uint64(),
+			ExecutionBlock: p.ExecutionBlock.Uint64(),
+			YesVotes:       (*hexutil.Big)(p.YesVotes),
+			NoVotes:        (*hexutil.Big)(p.NoVotes),
+		})
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(struct {
+			Params    []paramRecord    `json:"params"`
+			Proposals []proposalRecord `json:"proposals"`
+		}{values, proposals})
+	}
+	fmt.Println("Governed parameters:")
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for i, p := range values {
+		value := "not governed, configured value applies"
+		if p.Set {
+			value = formatParamValue(governance.Params[i], p.Value.ToInt())
+		}
+		fmt.Fprintf(w, "  %s\t%s\n", p.Param, value)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if len(proposals) == 0 {
+		fmt.Println("\nNo proposals")
+		return nil
+	}
+	fmt.Println()
+	w = tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ID\tPARAM\tVALUE\tSTATUS\tYES\tNO\tVOTING END\tEXECUTION\tDESCRIPTION")
+	for _, p := range proposals {
+		param, _ := governance.ParseParam(p.Param)
+		execution := "-"
+		if p.ExecutionBlock != 0 {
+			execution = strconv.FormatUint(p.ExecutionBlock, 10)
+		}
+		fmt.Fprintf(w, "%d\t%s\t%s\t%s\t%s\t%s\t%d\t%s\t%s\n", p.ID, p.Param, formatParamValue(param, p.Value.ToInt()), p.Status,
+			formatPZX(p.YesVotes.ToInt()), formatPZX(p.NoVotes.ToInt()), p.VotingEnd, execution, p.Description)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+)
+
+// devnetValidatorFlag is the validator sealing the blocks of a devnet.
+var devnetValidatorFlag = &cli.StringSliceFlag{
+	Name:  "validator",
+	Usage: "Address of a devnet validator (may be repeated, required for devnet)",
+}
+
+// InitCommand defines the init command structure
+var InitCommand = &cli.Command{
+	Name:  "init",
+	Usage: "Initialize the blockchain network",
+	Flags: []cli.Flag{
+		NetworkFlag,
+		DataDirFlag,
+		devnetValidatorFlag,
+	},
+	Description: `
+The init command writes the genesis block of the selected network into the chain
+database of the data directory. The network is one of mainnet, testnet, devnet or
+the path of a genesis JSON file. Devnets are sealed by the validators given with
+--validator.
+
+Initializing an already initialized data directory is a no-op, unless the stored
+genesis belongs to a different network, which is an error.`,
+	Action: initNetwork,
+}
+
+// initNetwork commits the genesis block of the selected network into the chain
+// database, the same way geth init does.
+func initNetwork(ctx *cli.Context) error {
+	genesis, err := makeGenesis(ctx.String(NetworkFlag.Name), ctx.StringSlice(devnetValidatorFlag.Name))
+	if err != nil {
+		return err
+	}
+	stack, err := makeNode(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to create node: %v", err)
+	}
+	defer stack.Close()
+
+	chaindb, err := openChainDatabase(stack, false)
+	if err != nil {
+		return fmt.Errorf("failed to open database: %v", err)
+	}
+	defer chaindb.Close()
+
+	triedb, err := openTrieDatabase(chaindb, false)
+	if err != nil {
+		return fmt.Errorf("failed to open trie database: %v", err)
+	}
+	defer triedb.Close()
+
+	config, hash, compatErr, err := core.SetupGenesisBlockWithOverride(chaindb, triedb, genesis, nil)
+	if err != nil {
+		return fmt.Errorf("failed to write genesis block: %v", err)
+	}
+	if compatErr != nil {
+		return fmt.Errorf("failed to write chain config: %v", compatErr)
+	}
+	fmt.Printf("Initialized PIXELZX network %s (chain ID %v)\n", ctx.String(NetworkFlag.Name), config.ChainID)
+	fmt.Printf("  Genesis:   %s\n", hash.Hex())
+	fmt.Printf("  Data dir:  %s\n", stack.DataDir())
+	return nil
+}
+
+// makeGenesis resolves the genesis of the given network. Devnets are sealed by
+// the given validators.
+func makeGenesis(network string, devnetValidators []string) (*core.Genesis, error) {
+	switch network {
+	case "mainnet":
+		return core.DefaultPixelzxGenesisBlock(), nil
+	case "testnet":
+		return core.DefaultPixelzxTestnetGenesisBlock(), nil
+	case "devnet":
+		var validators []common.Address
+		for _, addr := range devnetValidators {
+			if !common.IsHexAddress(addr) {
+				return nil, fmt.Errorf("invalid validator address: %q", addr)
+			}
+			validators = append(validators, common.HexToAddress(addr))
+		}
+		if len(validators) == 0 {
+			return nil, errors.New("devnet requires at least one --validator")
+		}
+		return developerGenesis(validators), nil
+	}
+	return readGenesis(network)
+}
+
+// developerGenesis returns the genesis block of a local PIXELZX development
+// network, sealed by the given validators. Every validator bonds the minimum
+// validator stake and is pre-funded to pay for transactions. Governance is
+// enabled, with votes and execution delays lasting an epoch each.
+func developerGenesis(validators []common.Address) *core.Genesis {
+	pzx := func(amount int64) *big.Int {
+		return new(big.Int).Mul(big.NewInt(amount), big.NewInt(params.PZX))
+	}
+	config := *params.AllPixelzxProtocolChanges
+	config.Pixelzx = &params.PixelzxConfig{
+		Period:            2,
+		Epoch:             100,
+		MinValidatorStake: pzx(1000),
+		MaxValidators:     21,
+		UnbondingPeriod:   100,
+		DoubleSignSlash:   500,
+		LivenessWindow:    100,
+		MaxMissedSlots:    50,
+		VotingPeriod:      100,
+		ExecutionDelay:    100,
+		RewardSchedule: []params.PixelzxReward{
+			{Block: big.NewInt(0), Reward: pzx(2)},
+		},
+	}
+	stakes := make([]staking.GenesisValidator, len(validators))
+	for i, addr := range validators {
+		stakes[i] = staking.GenesisValidator{Address: addr, Stake: config.Pixelzx.MinValidatorStake, Moniker: "devnet"}
+	}
+	alloc := pixelzx.GenesisAlloc(config.Pixelzx, stakes)
+	for _, addr := range validators {
+		alloc[addr] = types.Account{Balance: pzx(1_000_000)}
+	}
+	return &core.Genesis{
+		Config:     &config,
+		ExtraData:  pixelzx.GenesisExtraData("PIXELZX devnet", stakes),
+		GasLimit:   60_000_000,
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+		Difficulty: big.NewInt(0),
+		Alloc:      alloc,
+	}
+}
+
+// readGenesis loads a custom genesis from a JSON file.
+func readGenesis(path string) (*core.Genesis, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("unknown network %q and no such genesis file: %v", path, err)
+	}
+	defer file.Close()
+
+	genesis := new(core.Genesis)
+	if err := json.NewDecoder(file).Decode(genesis); err != nil {
+		return nil, fmt.Errorf("invalid genesis file: %v", err)
+	}
+	if genesis.Config == nil || genesis.Config.Pixelzx == nil {
+		return nil, errors.New("genesis file is not a PIXELZX network")
+	}
+	return genesis, nil
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/triedb"
+	"github.com/ethereum/go-ethereum/triedb/hashdb"
+	"github.com/ethereum/go-ethereum/triedb/pathdb"
+	"github.com/urfave/cli/v2"
+)
+
+const (
+	clientIdentifier = "pixelzx" // Client identifier to advertise over the network
+	databaseCache    = 512       // Megabytes of memory allocated to the database caches
+	databaseHandles  = 512       // Number of files the database may keep open
+)
+
+// makeNodeConfig assembles the node configuration for the selected data directory.
+func makeNodeConfig(ctx *cli.Context) node.Config {
+	config := node.DefaultConfig
+	config.Name = clientIdentifier
+	config.DataDir = ctx.String(DataDirFlag.Name)
+	return config
+}
+
+// makeNode creates a node in the selected data directory, without starting it.
+func makeNode(ctx *cli.Context) (*node.Node, error) {
+	config := makeNodeConfig(ctx)
+	return newNode(&config)
+}
+
+// newNode creates a node with the given configuration, backed by the keystore
+// of its data directory, without starting it.
+func newNode(config *node.Config) (*node.Node, error) {
+	stack, err := node.New(config)
+	if err != nil {
+		return nil, err
+	}
+	stack.AccountManager().AddBackend(keystore.NewKeyStore(stack.KeyStoreDir(), keystore.StandardScryptN, keystore.StandardScryptP))
+	return stack, nil
+}
+
+// openChainDatabase opens the chain database of the node.
+func openChainDatabase(stack *node.Node, readonly bool) (ethdb.Database, error) {
+	return stack.OpenDatabaseWithOptions("chaindata", node.DatabaseOptions{
+		MetricsNamespace: "eth/db/chaindata/",
+		Cache:            databaseCache,
+		Handles:          databaseHandles,
+		ReadOnly:         readonly,
+	})
+}
+
+// openTrieDatabase opens the trie database on top of the chain database, in the
+// state scheme the database was initialized with.
+func openTrieDatabase(db ethdb.Database, readonly bool) (*triedb.Database, error) {
+	scheme, err := rawdb.ParseStateScheme("", db)
+	if err != nil {
+		return nil, err
+	}
+	config := new(triedb.Config)
+	if scheme == rawdb.HashScheme {
+		config.HashDB = hashdb.Defaults
+	} else if readonly {
+		config.PathDB = pathdb.ReadOnly
+	} else {
+		config.PathDB = pathdb.Defaults
+	}
+	return triedb.NewDatabase(db, config), nil
+}
@@ -1,33 +1,257 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
+	"math/big"
+	"os"
+	"text/tabwriter"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/urfave/cli/v2"
 )
 
+var (
+	dryRunFlag = &cli.BoolFlag{
+		Name:  "dry-run",
+		Usage: "Simulate the call with eth_call and estimate its gas, without sending a transaction",
+	}
+	delegatorFlag = &cli.StringFlag{
+		Name:  "delegator",
+		Usage: "Delegator to query (default = the --from account)",
+	}
+)
+
 // StakingCommand defines the staking command structure
 var StakingCommand = &cli.Command{
 	Name:  "staking",
 	Usage: "Staking management commands",
+	Description: `
+Delegate stake to validators and manage the delegations of a keystore account
+through the staking system contract of a running node, given by --rpc.
+
+Amounts are given in PZX, with up to 18 decimals. Transactions are sent from
+the --from account and waited for to be included; with --dry-run they are only
+simulated against the head of the chain, printing the gas they would use.`,
 	Subcommands: []*cli.Command{
 		{
-			Name:  "delegate",
-			Usage: "Delegate tokens to a validator",
-			Action: func(c *cli.Context) error {
-				fmt.Println("Delegating tokens to validator...")
-				fmt.Println("Delegation successful")
-				return nil
-			},
+			Name:      "delegate",
+			Usage:     "Delegate tokens to a validator",
+			ArgsUsage: "<validator> <amount>",
+			Flags:     append([]cli.Flag{dryRunFlag}, transactFlags...),
+			Action:    stakingDelegate,
 		},
 		{
-			Name:  "undelegate",
-			Usage: "Undelegate tokens from a validator",
-			Action: func(c *cli.Context) error {
-				fmt.Println("Undelegating tokens from validator...")
-				fmt.Println("Undelegation successful")
-				return nil
-			},
+			Name:      "undelegate",
+			Usage:     "Undelegate tokens from a validator",
+			ArgsUsage: "<validator> <amount>",
+			Flags:     append([]cli.Flag{dryRunFlag}, transactFlags...),
+			Description: `
+Undelegates stake from a validator. The stake stays bonded, and slashable, for
+the unbonding period, after which it is released at the next epoch boundary or
+may be withdrawn.`,
+			Action: stakingUndelegate,
+		},
+		{
+			Name:   "withdraw",
+			Usage:  "Withdraw undelegated tokens past the unbonding period",
+			Flags:  append([]cli.Flag{dryRunFlag}, transactFlags...),
+			Action: stakingWithdraw,
+		},
+		{
+			Name:      "rewards",
+			Usage:     "Show the pending rewards of a delegator",
+			ArgsUsage: "[<validator>...]",
+			Flags:     append([]cli.Flag{rpcFlag, delegatorFlag, fromFlag, jsonFlag}, keyStoreFlags...),
+			Description: `
+Shows the rewards pending to the delegator with the given validators, or with
+every validator it has rewards with if none are given.`,
+			Action: stakingRewards,
+		},
+		{
+			Name:      "claim",
+			Usage:     "Claim the pending rewards with a validator",
+			ArgsUsage: "<validator>",
+			Flags:     append([]cli.Flag{dryRunFlag}, transactFlags...),
+			Action:    stakingClaim,
 		},
 	},
-}
\ No newline at end of file
+}
+
+// delegationArgs parses the validator address and amount arguments.
+func delegationArgs(ctx *cli.Context) (common.Address, *big.Int, error) {
+	if ctx.Args().Len() != 2 {
+		return common.Address{}, nil, errors.New("validator address and amount must be given as arguments")
+	}
+	if !common.IsHexAddress(ctx.Args().Get(0)) {
+		return common.Address{}, nil, fmt.Errorf("invalid validator address: %q", ctx.Args().Get(0))
+	}
+	amount, err := parsePZX(ctx.Args().Get(1))
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	if amount.Sign() == 0 {
+		return common.Address{}, nil, errors.New("amount must be positive")
+	}
+	return common.HexToAddress(ctx.Args().Get(0)), amount, nil
+}
+
+// stakingDelegate delegates stake to a validator.
+func stakingDelegate(ctx *cli.Context) error {
+	validator, amount, err := delegationArgs(ctx)
+	if err != nil {
+		return err
+	}
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	if _, err := s.execute(ctx, amount, s.abi.PackDelegate(validator)); err != nil {
+		return err
+	}
+	if !ctx.Bool(jsonFlag.Name) && !ctx.Bool(dryRunFlag.Name) {
+		fmt.Printf("Delegated %s to %s\n", formatPZX(amount), validator.Hex())
+	}
+	return nil
+}
+
+// stakingUndelegate undelegates stake from a validator.
+func stakingUndelegate(ctx *cli.Context) error {
+	validator, amount, err := delegationArgs(ctx)
+	if err != nil {
+		return err
+	}
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	if _, err := s.execute(ctx, nil, s.abi.PackUndelegate(validator, amount)); err != nil {
+		return err
+	}
+	if !ctx.Bool(jsonFlag.Name) && !ctx.Bool(dryRunFlag.Name) {
+		fmt.Printf("Undelegated %s from %s, unbonding\n", formatPZX(amount), validator.Hex())
+	}
+	return nil
+}
+
+// stakingWithdraw withdraws the matured unbondings of the account.
+func stakingWithdraw(ctx *cli.Context) error {
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	output, err := s.execute(ctx, nil, s.abi.PackWithdraw())
+	if err != nil || output == nil || ctx.Bool(jsonFlag.Name) {
+		return err
+	}
+	amount, err := s.abi.UnpackWithdraw(output)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Would withdraw %s\n", formatPZX(amount))
+	return nil
+}
+
+// stakingClaim claims the pending rewards with a validator.
+func stakingClaim(ctx *cli.Context) error {
+	validator, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	output, err := s.execute(ctx, nil, s.abi.PackClaimRewards(validator))
+	if err != nil || output == nil || ctx.Bool(jsonFlag.Name) {
+		return err
+	}
+	amount, err := s.abi.UnpackClaimRewards(output)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("Would claim %s\n", formatPZX(amount))
+	return nil
+}
+
+// pendingReward is the JSON representation of the rewards pending with a validator.
+type pendingReward struct {
+	Validator common.Address `json:"validator"`
+	Amount    *hexutil.Big   `json:"amount"`
+}
+
+// stakingRewards prints the rewards pending to a delegator.
+func stakingRewards(ctx *cli.Context) error {
+	var delegator common.Address
+	if addr := ctx.String(delegatorFlag.Name); addr != "" {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid delegator address: %q", addr)
+		}
+		delegator = common.HexToAddress(addr)
+	} else {
+		ks, err := openKeyStore(ctx)
+		if err != nil {
+			return err
+		}
+		account, err := sender(ctx, ks)
+		if err != nil {
+			return err
+		}
+		delegator = account.Address
+	}
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	var validators []common.Address
+	for _, arg := range ctx.Args().Slice() {
+		if !common.IsHexAddress(arg) {
+			return fmt.Errorf("invalid validator address: %q", arg)
+		}
+		validators = append(validators, common.HexToAddress(arg))
+	}
+	all := len(validators) == 0
+	if all {
+		validators, err = bind.Call(s.contract, s.callOpts(ctx), s.abi.PackGetValidators(), s.abi.UnpackGetValidators)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve validators: %v", err)
+		}
+	}
+	var (
+		rewards = make([]pendingReward, 0, len(validators))
+		total   = new(big.Int)
+	)
+	for _, validator := range validators {
+		amount, err := bind.Call(s.contract, s.callOpts(ctx), s.abi.PackPendingRewards(delegator, validator), s.abi.UnpackPendingRewards)
+		if err != nil {
+			return fmt.Errorf("failed to retrieve rewards with %s: %v", validator.Hex(), err)
+		}
+		if all && amount.Sign() == 0 {
+			continue
+		}
+		rewards = append(rewards, pendingReward{Validator: validator, Amount: (*hexutil.Big)(amount)})
+		total.Add(total, amount)
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(rewards)
+	}
+	fmt.Printf("Pending rewards of %s:\n", delegator.Hex())
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, reward := range rewards {
+		fmt.Fprintf(w, "  %s\t%s\n", reward.Validator.Hex(), formatPZX(reward.Amount.ToInt()))
+	}
+	fmt.Fprintf(w, "  Total\t%s\n", formatPZX(total))
+	return w.Flush()
+}
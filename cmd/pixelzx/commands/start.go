@@ -0,0 +1,482 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/keys"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/eth"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/eth/filters"
+	"github.com/ethereum/go-ethereum/eth/tracers"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/ethdb/pebble"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/urfave/cli/v2"
+
+	// Force-load the tracer engines to trigger registration
+	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
+	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
+)
+
+var (
+	configFileFlag = &cli.StringFlag{
+		Name:  "config",
+		Usage: "YAML configuration file (e.g. configs/production.yaml)",
+	}
+	keyStoreDirFlag = &cli.StringFlag{
+		Name:  "keystore",
+		Usage: "Directory for the keystore (default = inside the datadir)",
+	}
+	httpFlag = &cli.BoolFlag{
+		Name:  "http",
+		Usage: "Enable the HTTP-RPC server",
+	}
+	httpAddrFlag = &cli.StringFlag{
+		Name:  "http.addr",
+		Usage: "HTTP-RPC server listening interface",
+	}
+	httpPortFlag = &cli.IntFlag{
+		Name:  "http.port",
+		Usage: "HTTP-RPC server listening port",
+	}
+	httpAPIFlag = &cli.StringFlag{
+		Name:  "http.api",
+		Usage: "Comma separated API namespaces offered over the HTTP-RPC interface",
+	}
+	wsFlag = &cli.BoolFlag{
+		Name:  "ws",
+		Usage: "Enable the WS-RPC server",
+	}
+	wsAddrFlag = &cli.StringFlag{
+		Name:  "ws.addr",
+		Usage: "WS-RPC server listening interface",
+	}
+	wsPortFlag = &cli.IntFlag{
+		Name:  "ws.port",
+		Usage: "WS-RPC server listening port",
+	}
+	wsAPIFlag = &cli.StringFlag{
+		Name:  "ws.api",
+		Usage: "Comma separated API namespaces offered over the WS-RPC interface",
+	}
+	listenPortFlag = &cli.IntFlag{
+		Name:  "port",
+		Usage: "Network listening port",
+	}
+	maxPeersFlag = &cli.IntFlag{
+		Name:  "maxpeers",
+		Usage: "Maximum number of network peers",
+	}
+	bootnodesFlag = &cli.StringFlag{
+		Name:  "bootnodes",
+		Usage: "Comma separated enode URLs of the bootstrap nodes",
+	}
+	validatorFlag = &cli.BoolFlag{
+		Name:  "validator",
+		Usage: "Run as validator node, sealing blocks in-process",
+	}
+	validatorAddressFlag = &cli.StringFlag{
+		Name:  "validator.address",
+		Usage: "Consensus key address of the validator (default = only key of the consensus key store)",
+	}
+	validatorKeysFlag = &cli.StringFlag{
+		Name:  "validator.keys",
+		Usage: "Directory of the validator consensus keys (default = inside the datadir)",
+	}
+	validatorSignerFlag = &cli.StringFlag{
+		Name:  "validator.signer",
+		Usage: "External signer (e.g. clef) holding the consensus key, instead of the consensus key store",
+	}
+	evidenceAccountFlag = &cli.StringFlag{
+		Name:  "evidence.account",
+		Usage: "Keystore account submitting double sign evidence, unlocked with --password",
+	}
+	passwordFileFlag = &cli.StringFlag{
+		Name:  "password",
+		Usage: "Password file to unlock the key with",
+	}
+	metricsFlag = &cli.BoolFlag{
+		Name:  "metrics",
+		Usage: "Enable metrics collection",
+	}
+)
+
+// StartCommand defines the start command structure
+var StartCommand = &cli.Command{
+	Name:  "start",
+	Usage: "Start the PIXELZX node",
+	Flags: []cli.Flag{
+		configFileFlag,
+		NetworkFlag,
+		DataDirFlag,
+		keyStoreDirFlag,
+		httpFlag,
+		httpAddrFlag,
+		httpPortFlag,
+		httpAPIFlag,
+		wsFlag,
+		wsAddrFlag,
+		wsPortFlag,
+		wsAPIFlag,
+		listenPortFlag,
+		maxPeersFlag,
+		bootnodesFlag,
+		validatorFlag,
+		validatorAddressFlag,
+		validatorKeysFlag,
+		validatorSignerFlag,
+		evidenceAccountFlag,
+		passwordFileFlag,
+		metricsFlag,
+	},
+	Description: `
+The start command boots a full node and runs it until interrupted. The node is
+configured by the YAML file given with --config (see the configs directory), any
+value of which may be overridden with the command line flags.
+
+Validator nodes (--validator) seal the blocks of their slots and attest blocks
+in-process. They sign with their consensus key, unlocked from the consensus key
+store (see pixelzx validator keys), or with --validator.signer by an external
+signer like clef. Every seal and vote is first checked against the local
+slashing protection records, refusing to sign twice at a height.
+
+Every node watches for double signing validators. Consensus keys never sign
+transactions: to report the ones caught by the node, validator or not, the
+evidence is submitted from the keystore account given by --evidence.account.`,
+	Action: startNode,
+}
+
+// startNode boots the node with the eth backend and runs it until signalled, or
+// until the node stops by itself.
+func startNode(ctx *cli.Context) error {
+	log.SetDefault(log.NewLogger(log.NewTerminalHandlerWithLevel(os.Stderr, log.LevelInfo, true)))
+
+	config, err := makeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if config.Metrics.Enabled {
+		log.Info("Enabling metrics collection")
+		metrics.Enable()
+		go metrics.CollectProcessMetrics(3 * time.Second)
+	}
+	// The slashing protection database must outlive the node, which keeps
+	// signing until it is fully stopped.
+	var protection *keys.Protection
+	if ctx.Bool(validatorFlag.Name) {
+		db, err := openProtectionDB(config)
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+		protection = keys.NewProtection(db)
+	}
+	stack, err := newNode(makeStackConfig(config))
+	if err != nil {
+		return fmt.Errorf("failed to create node: %v", err)
+	}
+	defer stack.Close()
+
+	backend, err := registerEthService(stack, config)
+	if err != nil {
+		return err
+	}
+	if err := stack.Start(); err != nil {
+		return fmt.Errorf("failed to start node: %v", err)
+	}
+	if ctx.Bool(validatorFlag.Name) {
+		if err := startValidator(ctx, config, backend, protection); err != nil {
+			return err
+		}
+	}
+	if addr := ctx.String(evidenceAccountFlag.Name); addr != "" {
+		if err := startEvidenceAccount(ctx, backend, addr); err != nil {
+			return err
+		}
+	}
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+
+	// The node may also be stopped from within, e.g. by a fatal error
+	stopped := make(chan struct{})
+	go func() {
+		stack.Wait()
+		close(stopped)
+	}()
+	select {
+	case <-sigc:
+		log.Info("Got interrupt, shutting down...")
+	case <-stopped:
+		log.Info("Node stopped, exiting")
+	}
+	return nil
+}
+
+// makeConfig loads the configuration file given by --config, if any, and
+// overrides it with the flags set on the command line.
+func makeConfig(ctx *cli.Context) (*Config, error) {
+	config, err := loadConfig(ctx.String(configFileFlag.Name))
+	if err != nil {
+		return nil, err
+	}
+	applyFlags(ctx, config)
+	if err := config.validate(); err != nil {
+		return nil, err
+	}
+	return config, nil
+}
+
+// applyFlags overrides the configuration with the flags set on the command line.
+func applyFlags(ctx *cli.Context, config *Config) {
+	if ctx.IsSet(NetworkFlag.Name) {
+		config.Network.Name = ctx.String(NetworkFlag.Name)
+	}
+	if ctx.IsSet(bootnodesFlag.Name) {
+		config.Network.Bootnodes = splitList(ctx.String(bootnodesFlag.Name))
+	}
+	if ctx.IsSet(DataDirFlag.Name) {
+		config.Node.DataDir = ctx.String(DataDirFlag.Name)
+	}
+	if ctx.IsSet(keyStoreDirFlag.Name) {
+		config.Node.KeyStore = ctx.String(keyStoreDirFlag.Name)
+	}
+	if ctx.IsSet(validatorKeysFlag.Name) {
+		config.Node.ConsensusKeys = ctx.String(validatorKeysFlag.Name)
+	}
+	if ctx.IsSet(httpFlag.Name) {
+		config.RPC.Enabled = ctx.Bool(httpFlag.Name)
+	}
+	if ctx.IsSet(httpAddrFlag.Name) {
+		config.RPC.Addr = ctx.String(httpAddrFlag.Name)
+	}
+	if ctx.IsSet(httpPortFlag.Name) {
+		config.RPC.Port = ctx.Int(httpPortFlag.Name)
+	}
+	if ctx.IsSet(httpAPIFlag.Name) {
+		config.RPC.Modules = splitList(ctx.String(httpAPIFlag.Name))
+	}
+	if ctx.IsSet(wsFlag.Name) {
+		config.WebSocket.Enabled = ctx.Bool(wsFlag.Name)
+	}
+	if ctx.IsSet(wsAddrFlag.Name) {
+		config.WebSocket.Addr = ctx.String(wsAddrFlag.Name)
+	}
+	if ctx.IsSet(wsPortFlag.Name) {
+		config.WebSocket.Port = ctx.Int(wsPortFlag.Name)
+	}
+	if ctx.IsSet(wsAPIFlag.Name) {
+		config.WebSocket.Modules = splitList(ctx.String(wsAPIFlag.Name))
+	}
+	if ctx.IsSet(listenPortFlag.Name) {
+		config.P2P.Port = ctx.Int(listenPortFlag.Name)
+	}
+	if ctx.IsSet(maxPeersFlag.Name) {
+		config.P2P.MaxPeers = ctx.Int(maxPeersFlag.Name)
+	}
+	if ctx.IsSet(metricsFlag.Name) {
+		config.Metrics.Enabled = ctx.Bool(metricsFlag.Name)
+	}
+}
+
+// splitList splits a comma separated flag value, dropping empty entries.
+func splitList(value string) []string {
+	var list []string
+	for _, entry := range strings.Split(value, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			list = append(list, entry)
+		}
+	}
+	return list
+}
+
+// makeStackConfig maps the configuration onto the node and p2p configs.
+func makeStackConfig(config *Config) *node.Config {
+	stack := node.DefaultConfig
+	stack.Name = clientIdentifier
+	stack.DataDir = config.Node.DataDir
+	stack.KeyStoreDir = config.Node.KeyStore
+	stack.IPCPath = clientIdentifier + ".ipc"
+
+	if config.RPC.Enabled {
+		stack.HTTPHost = config.RPC.Addr
+		stack.HTTPPort = config.RPC.Port
+		stack.HTTPModules = config.RPC.Modules
+	}
+	if config.WebSocket.Enabled {
+		stack.WSHost = config.WebSocket.Addr
+		stack.WSPort = config.WebSocket.Port
+		stack.WSModules = config.WebSocket.Modules
+	}
+	stack.P2P.ListenAddr = config.P2P.listenAddr()
+	stack.P2P.MaxPeers = config.P2P.MaxPeers
+	stack.P2P.BootstrapNodes = make([]*enode.Node, 0, len(config.Network.Bootnodes))
+	for _, url := range config.Network.Bootnodes {
+		stack.P2P.BootstrapNodes = append(stack.P2P.BootstrapNodes, enode.MustParse(url)) // validated already
+	}
+	stack.P2P.StaticNodes = make([]*enode.Node, 0, len(config.Network.StaticNodes))
+	for _, url := range config.Network.StaticNodes {
+		stack.P2P.StaticNodes = append(stack.P2P.StaticNodes, enode.MustParse(url)) // validated already
+	}
+	return &stack
+}
+
+// registerEthService creates the eth backend of the configured network and
+// registers its APIs on the node.
+func registerEthService(stack *node.Node, config *Config) (*eth.Ethereum, error) {
+	genesis, err := stackGenesis(stack, config.Network.Name)
+	if err != nil {
+		return nil, err
+	}
+	if genesis.Config == nil || genesis.Config.ChainID == nil || genesis.Config.ChainID.Sign() <= 0 || !genesis.Config.ChainID.IsUint64() {
+		return nil, fmt.Errorf("network %s has no valid chain ID", config.Network.Name)
+	}
+	if id := config.Network.ChainID; id != 0 && genesis.Config.ChainID.Uint64() != id {
+		return nil, fmt.Errorf("network %s has chain ID %v, configured %d", config.Network.Name, genesis.Config.ChainID, id)
+	}
+	ethcfg := ethconfig.Defaults
+	ethcfg.Genesis = genesis
+	ethcfg.NetworkId = genesis.Config.ChainID.Uint64()
+
+	backend, err := eth.New(stack, &ethcfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to register the eth service: %v", err)
+	}
+	stack.RegisterAPIs(tracers.APIs(backend.APIBackend))
+
+	filterSystem := filters.NewFilterSystem(backend.APIBackend, filters.Config{
+		LogCacheSize: ethcfg.FilterLogCacheSize,
+	})
+	stack.RegisterAPIs([]rpc.API{{
+		Namespace: "eth",
+		Service:   filters.NewFilterAPI(filterSystem),
+	}})
+	return backend, nil
+}
+
+// stackGenesis resolves the genesis of the network the node runs. Devnets are
+// sealed by validators picked at initialization, so their genesis is read from
+// the database written by pixelzx init.
+func stackGenesis(stack *node.Node, network string) (*core.Genesis, error) {
+	if network != "devnet" {
+		return makeGenesis(network, nil)
+	}
+	db, err := openChainDatabase(stack, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open database: %v", err)
+	}
+	defer db.Close()
+
+	genesis, err := core.ReadGenesis(db)
+	if err != nil {
+		return nil, fmt.Errorf("devnet not initialized, run pixelzx init --network devnet first: %v", err)
+	}
+	return genesis, nil
+}
+
+// startValidator unlocks the consensus key of the validator, or connects to the
+// external signer holding it, and starts sealing blocks.
+func startValidator(ctx *cli.Context, config *Config, backend *eth.Ethereum, protection *keys.Protection) error {
+	store := keys.NewStore(config.Node.consensusKeyDir(), keystore.StandardScryptN, keystore.StandardScryptP)
+
+	var validator common.Address
+	if addr := ctx.String(validatorAddressFlag.Name); addr != "" {
+		if !common.IsHexAddress(addr) {
+			return fmt.Errorf("invalid validator address: %q", addr)
+		}
+		validator = common.HexToAddress(addr)
+	} else if ctx.IsSet(validatorSignerFlag.Name) {
+		return errors.New("--validator.address must be set to sign with an external signer")
+	} else {
+		addrs, err := store.Keys()
+		if err != nil {
+			return fmt.Errorf("failed to read the consensus key store: %v", err)
+		}
+		if len(addrs) != 1 {
+			return fmt.Errorf("%d consensus keys in %s, select one with --validator.address", len(addrs), store.Dir())
+		}
+		validator = addrs[0]
+	}
+	var signFn pixelzx.SignerFn
+	if endpoint := ctx.String(validatorSignerFlag.Name); endpoint != "" {
+		var err error
+		if signFn, err = keys.RemoteSigner(endpoint, validator); err != nil {
+			return err
+		}
+	} else {
+		var password string
+		if path := ctx.String(passwordFileFlag.Name); path != "" {
+			var err error
+			if password, err = readPasswordFile(path); err != nil {
+				return err
+			}
+		}
+		key, err := store.Unlock(validator, password)
+		if errors.Is(err, keys.ErrNoKey) {
+			return fmt.Errorf("%v, import it with pixelzx validator keys import", err)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to unlock consensus key %s: %v", validator.Hex(), err)
+		}
+		signFn = keys.LocalSigner(key)
+	}
+	if err := backend.StartSealing(validator, protection.Protect(signFn)); err != nil {
+		return fmt.Errorf("failed to start sealing: %v", err)
+	}
+	return nil
+}
+
+// startEvidenceAccount unlocks the keystore account double sign evidence is
+// submitted with.
+func startEvidenceAccount(ctx *cli.Context, backend *eth.Ethereum, addr string) error {
+	if !common.IsHexAddress(addr) {
+		return fmt.Errorf("invalid evidence account: %q", addr)
+	}
+	account := accounts.Account{Address: common.HexToAddress(addr)}
+
+	var password string
+	if path := ctx.String(passwordFileFlag.Name); path != "" {
+		var err error
+		if password, err = readPasswordFile(path); err != nil {
+			return err
+		}
+	}
+	backends := backend.AccountManager().Backends(keystore.KeyStoreType)
+	if len(backends) == 0 {
+		return errors.New("keystore is not available to unlock the evidence account")
+	}
+	ks, ok := backends[0].(*keystore.KeyStore)
+	if !ok {
+		return errors.New("keystore is not available to unlock the evidence account")
+	}
+	if err := ks.Unlock(account, password); err != nil {
+		return fmt.Errorf("failed to unlock evidence account %s: %v", account.Address.Hex(), err)
+	}
+	backend.SetEvidenceAccount(account.Address)
+	return nil
+}
+
+// openProtectionDB opens the slashing protection database of the datadir.
+func openProtectionDB(config *Config) (ethdb.KeyValueStore, error) {
+	db, err := pebble.New(filepath.Join(config.Node.DataDir, clientIdentifier, "slashing-protection"), 16, 16, "", false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open slashing protection database: %v", err)
+	}
+	return db, nil
+}
@@ -0,0 +1,390 @@
+package commands
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math/big"
+	"net"
+	"os"
+	"os/exec"
+	"os/signal"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/keys"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/urfave/cli/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// testnetManifestFile is the file describing a generated testnet in its directory.
+const testnetManifestFile = "testnet.json"
+
+var (
+	testnetValidatorsFlag = &cli.IntFlag{
+		Name:  "validators",
+		Usage: "Number of validator nodes to generate",
+		Value: 4,
+	}
+	testnetDirFlag = &cli.StringFlag{
+		Name:  "dir",
+		Usage: "Directory of the testnet, holding its genesis and the node data directories",
+		Value: "./testnet",
+	}
+	testnetPortFlag = &cli.IntFlag{
+		Name:  "port",
+		Usage: "Network listening port of the first node, incremented for every other node",
+		Value: 30303,
+	}
+	testnetHTTPPortFlag = &cli.IntFlag{
+		Name:  "http.port",
+		Usage: "HTTP-RPC server listening port of the first node, incremented for every other node",
+		Value: 8545,
+	}
+)
+
+// TestnetCommand defines the testnet command structure
+var TestnetCommand = &cli.Command{
+	Name:  "testnet",
+	Usage: "Run a local multi-validator network",
+	Flags: []cli.Flag{
+		testnetValidatorsFlag,
+		testnetDirFlag,
+		testnetPortFlag,
+		testnetHTTPPortFlag,
+	},
+	Description: `
+The testnet command runs a network of validator nodes on the loopback interface,
+to exercise proposer rotation, finality and slashing locally.
+
+On first use, the directory given by --dir is populated with a devnet genesis
+staking the consensus keys of --validators new validators, and with a data
+directory and configuration file per node, peering statically with all the other
+nodes. Every node also gets a keystore account funded in genesis, which submits
+the double sign evidence the node catches. All keys are encrypted with the
+password stored in the password file of the directory, and the nodes are
+described in testnet.json.
+
+The nodes are then started as child processes until interrupted. Their logs are
+printed prefixed with the node name, and kept in the node.log file of their data
+directory. Running the command again on the same directory restarts the network
+generated there.`,
+	Action: runTestnet,
+}
+
+// testnetNode describes a node of a generated testnet.
+type testnetNode struct {
+	Name      string         `json:"name"`
+	Validator common.Address `json:"validator"` // Address of the consensus key
+	Account   common.Address `json:"account"`   // Funded keystore account submitting evidence
+	Enode     string         `json:"enode"`
+	RPC       string         `json:"rpc"`
+	DataDir   string         `json:"datadir"`
+	Config    string         `json:"config"`
+}
+
+// testnet describes a generated testnet, as stored in its manifest.
+type testnet struct {
+	ChainID  uint64        `json:"chainId"`
+	Genesis  string        `json:"genesis"`
+	Password string        `json:"password"` // Password file of all the keys
+	Nodes    []testnetNode `json:"nodes"`
+}
+
+// runTestnet generates a testnet in the selected directory, unless it holds one
+// already, and runs its nodes until interrupted.
+func runTestnet(ctx *cli.Context) error {
+	dir, err := filepath.Abs(ctx.String(testnetDirFlag.Name))
+	if err != nil {
+		return err
+	}
+	tn, err := loadTestnet(dir)
+	if err != nil {
+		return err
+	}
+	if tn == nil {
+		validators := ctx.Int(testnetValidatorsFlag.Name)
+		if validators < 1 {
+			return errors.New("at least one validator required")
+		}
+		tn, err = generateTestnet(dir, validators, ctx.Int(testnetPortFlag.Name), ctx.Int(testnetHTTPPortFlag.Name))
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Generated testnet of %d validators in %s\n", len(tn.Nodes), dir)
+	} else {
+		if ctx.IsSet(testnetValidatorsFlag.Name) && ctx.Int(testnetValidatorsFlag.Name) != len(tn.Nodes) {
+			return fmt.Errorf("testnet in %s has %d validators, remove it to generate another one", dir, len(tn.Nodes))
+		}
+		fmt.Printf("Restarting testnet of %d validators in %s\n", len(tn.Nodes), dir)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "NODE\tVALIDATOR\tACCOUNT\tRPC")
+	for _, node := range tn.Nodes {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", node.Name, node.Validator.Hex(), node.Account.Hex(), node.RPC)
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	return launchTestnet(tn)
+}
+
+// loadTestnet reads the manifest of the testnet generated in the given directory,
+// returning nil if there is none. Directories holding anything else are refused.
+func loadTestnet(dir string) (*testnet, error) {
+	blob, err := os.ReadFile(filepath.Join(dir, testnetManifestFile))
+	if errors.Is(err, fs.ErrNotExist) {
+		entries, err := os.ReadDir(dir)
+		if err == nil && len(entries) > 0 {
+			return nil, fmt.Errorf("%s is not empty and holds no testnet", dir)
+		}
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read testnet: %v", err)
+	}
+	tn := new(testnet)
+	if err := json.Unmarshal(blob, tn); err != nil {
+		return nil, fmt.Errorf("invalid testnet manifest: %v", err)
+	}
+	return tn, nil
+}
+
+// generateTestnet creates the keys, genesis and node configurations of a testnet
+// of the given number of validators in the given directory.
+func generateTestnet(dir string, validators int, port int, httpPort int) (*testnet, error) {
+	if port < 1 || port+validators-1 > 65535 {
+		return nil, fmt.Errorf("network listening ports out of range: %d-%d", port, port+validators-1)
+	}
+	if httpPort < 1 || httpPort+validators-1 > 65535 {
+		return nil, fmt.Errorf("HTTP-RPC ports out of range: %d-%d", httpPort, httpPort+validators-1)
+	}
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, err
+	}
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	password := hex.EncodeToString(secret)
+
+	tn := &testnet{
+		Genesis:  filepath.Join(dir, "genesis.json"),
+		Password: filepath.Join(dir, "password"),
+	}
+	if err := os.WriteFile(tn.Password, []byte(password+"\n"), 0600); err != nil {
+		return nil, err
+	}
+	// Generate the keys of every node, with light encryption to start quickly
+	stakes := make([]common.Address, validators)
+	for i := range stakes {
+		node := testnetNode{
+			Name:    fmt.Sprintf("node%d", i+1),
+			DataDir: filepath.Join(dir, fmt.Sprintf("node%d", i+1)),
+			RPC:     fmt.Sprintf("http://127.0.0.1:%d", httpPort+i),
+		}
+		node.Config = filepath.Join(node.DataDir, "config.yaml")
+
+		store := keys.NewStore(filepath.Join(node.DataDir, "consensus-keys"), keystore.LightScryptN, keystore.LightScryptP)
+		validator, err := store.Generate(password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate consensus key: %v", err)
+		}
+		ks := keystore.NewKeyStore(filepath.Join(node.DataDir, "keystore"), keystore.LightScryptN, keystore.LightScryptP)
+		account, err := ks.NewAccount(password)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create account: %v", err)
+		}
+		nodeKey, err := crypto.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+		keyfile := filepath.Join(node.DataDir, clientIdentifier, "nodekey")
+		if err := os.MkdirAll(filepath.Dir(keyfile), 0700); err != nil {
+			return nil, err
+		}
+		if err := crypto.SaveECDSA(keyfile, nodeKey); err != nil {
+			return nil, fmt.Errorf("failed to save node key: %v", err)
+		}
+		node.Validator = validator
+		node.Account = account.Address
+		node.Enode = enode.NewV4(&nodeKey.PublicKey, net.IPv4(127, 0, 0, 1), port+i, port+i).URLv4()
+
+		stakes[i] = validator
+		tn.Nodes = append(tn.Nodes, node)
+	}
+	// Stake the validators in a devnet genesis, funding the node accounts
+	genesis := developerGenesis(stakes)
+	for _, node := range tn.Nodes {
+		genesis.Alloc[node.Account] = types.Account{Balance: new(big.Int).Mul(big.NewInt(1_000_000), big.NewInt(params.PZX))}
+	}
+	tn.ChainID = genesis.Config.ChainID.Uint64()
+	if err := writeJSONFile(tn.Genesis, genesis); err != nil {
+		return nil, err
+	}
+	// Configure every node to listen on loopback only, peering with all others
+	for i, node := range tn.Nodes {
+		config := defaultConfig()
+		config.Network.Name = tn.Genesis
+		config.Network.ChainID = tn.ChainID
+		for j, peer := range tn.Nodes {
+			if j != i {
+				config.Network.StaticNodes = append(config.Network.StaticNodes, peer.Enode)
+			}
+		}
+		config.Node.DataDir = node.DataDir
+		config.RPC.Enabled = true
+		config.RPC.Port = httpPort + i
+		config.P2P.Addr = "127.0.0.1"
+		config.P2P.Port = port + i
+
+		blob, err := yaml.Marshal(config)
+		if err != nil {
+			return nil, err
+		}
+		if err := os.WriteFile(node.Config, blob, 0600); err != nil {
+			return nil, err
+		}
+	}
+	if err := writeJSONFile(filepath.Join(dir, testnetManifestFile), tn); err != nil {
+		return nil, err
+	}
+	return tn, nil
+}
+
+// writeJSONFile writes a value as indented JSON into the given file.
+func writeJSONFile(path string, v any) error {
+	blob, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(blob, '\n'), 0600)
+}
+
+// testnetExit reports the exit of a testnet node process.
+type testnetExit struct {
+	name string
+	err  error
+}
+
+// launchTestnet runs every node of the testnet as a child process, printing
+// their logs, until interrupted or until any of them exits.
+func launchTestnet(tn *testnet) error {
+	exe, err := os.Executable()
+	if err != nil {
+		return fmt.Errorf("failed to locate the pixelzx executable: %v", err)
+	}
+	width := 0
+	for _, node := range tn.Nodes {
+		width = max(width, len(node.Name))
+	}
+	sigc := make(chan os.Signal, 1)
+	signal.Notify(sigc, syscall.SIGINT, syscall.SIGTERM)
+	defer signal.Stop(sigc)
+
+	var (
+		lock    sync.Mutex
+		procs   []*os.Process
+		exits   = make(chan testnetExit, len(tn.Nodes))
+		failure error
+	)
+	stop := func() {
+		for _, proc := range procs {
+			interruptProcess(proc) // fails for the exited processes only
+		}
+	}
+	for _, node := range tn.Nodes {
+		proc, err := startTestnetNode(exe, tn, node, exits, func(line string) {
+			lock.Lock()
+			defer lock.Unlock()
+			fmt.Printf("%-*s | %s\n", width, node.Name, line)
+		})
+		if err != nil {
+			failure = fmt.Errorf("failed to start %s: %v", node.Name, err)
+			break
+		}
+		procs = append(procs, proc)
+	}
+	stopping := failure != nil
+	if stopping {
+		stop()
+	}
+	for running := len(procs); running > 0; {
+		select {
+		case <-sigc:
+			if !stopping {
+				fmt.Println("Got interrupt, stopping the testnet...")
+				stopping = true
+				stop()
+			}
+		case exit := <-exits:
+			running--
+			if !stopping {
+				if exit.err == nil {
+					exit.err = errors.New("no error")
+				}
+				failure = fmt.Errorf("%s exited unexpectedly: %v", exit.name, exit.err)
+				stopping = true
+				stop()
+			}
+		}
+	}
+	return failure
+}
+
+// startTestnetNode starts the process of a testnet node, passing every line it
+// logs to the given callback and keeping it in the log file of the node. The exit
+// of the process is reported on the given channel once its output is drained.
+func startTestnetNode(exe string, tn *testnet, node testnetNode, exits chan<- testnetExit, print func(string)) (*os.Process, error) {
+	logfile, err := os.OpenFile(filepath.Join(node.DataDir, "node.log"), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return nil, err
+	}
+	reader, writer, err := os.Pipe()
+	if err != nil {
+		logfile.Close()
+		return nil, err
+	}
+	cmd := exec.Command(exe, "start",
+		"--"+configFileFlag.Name, node.Config,
+		"--"+validatorFlag.Name,
+		"--"+passwordFileFlag.Name, tn.Password,
+		"--"+evidenceAccountFlag.Name, node.Account.Hex(),
+	)
+	cmd.Stdout = writer
+	cmd.Stderr = writer
+	detachProcess(cmd)
+
+	err = cmd.Start()
+	writer.Close()
+	if err != nil {
+		reader.Close()
+		logfile.Close()
+		return nil, err
+	}
+	go func() {
+		defer logfile.Close()
+		defer reader.Close()
+
+		scanner := bufio.NewScanner(reader)
+		scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+		for scanner.Scan() {
+			fmt.Fprintln(logfile, scanner.Text())
+			print(scanner.Text())
+		}
+		exits <- testnetExit{name: node.Name, err: cmd.Wait()}
+	}()
+	return cmd.Process, nil
+}
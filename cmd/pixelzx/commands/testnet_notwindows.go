@@ -0,0 +1,21 @@
+//go:build !windows
+
+package commands
+
+import (
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+// detachProcess starts the node process in a process group of its own, so the
+// interrupts of the terminal reach the testnet command only, which forwards them
+// to the nodes once.
+func detachProcess(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// interruptProcess asks a node process to shut down gracefully.
+func interruptProcess(p *os.Process) error {
+	return p.Signal(os.Interrupt)
+}
@@ -0,0 +1,18 @@
+//go:build windows
+
+package commands
+
+import (
+	"os"
+	"os/exec"
+)
+
+// detachProcess is a no-op on Windows, where the node processes share the
+// console of the testnet command.
+func detachProcess(cmd *exec.Cmd) {}
+
+// interruptProcess kills a node process, as interrupts can't be sent to other
+// processes on Windows.
+func interruptProcess(p *os.Process) error {
+	return p.Kill()
+}
@@ -1,34 +1,347 @@
 package commands
 
 import (
+	"encoding/hex"
+	"errors"
 	"fmt"
+	"math/big"
+	"os"
+	"strings"
+	"text/tabwriter"
 
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/urfave/cli/v2"
 )
 
+var (
+	stakeFlag = &cli.StringFlag{
+		Name:     "stake",
+		Usage:    "Self stake to bond, in PZX",
+		Required: true,
+	}
+	commissionFlag = &cli.UintFlag{
+		Name:  "commission",
+		Usage: "Commission charged to delegators, in basis points (100 = 1%)",
+	}
+	monikerFlag = &cli.StringFlag{
+		Name:  "moniker",
+		Usage: "Human readable name of the validator",
+	}
+	consensusKeyFlag = &cli.StringFlag{
+		Name:  "consensus-key",
+		Usage: "Hex encoded uncompressed public key the validator seals blocks with",
+	}
+)
+
 // ValidatorCommand defines the validator command structure
 var ValidatorCommand = &cli.Command{
 	Name:  "validator",
 	Usage: "Validator management commands",
+	Description: `
+Register validators with the staking system contract, query the validator set
+of a running node, given by --rpc, and manage the consensus keys validators seal
+blocks with and their slashing protection records.`,
 	Subcommands: []*cli.Command{
 		{
 			Name:  "register",
 			Usage: "Register as a validator",
-			Action: func(c *cli.Context) error {
-				fmt.Println("Registering as validator...")
-				fmt.Println("Validator registered successfully")
-				return nil
-			},
+			Flags: append([]cli.Flag{stakeFlag, commissionFlag, monikerFlag, consensusKeyFlag}, transactFlags...),
+			Description: `
+Registers the sending keystore account as a validator, bonding the given self
+stake. The validator joins the active set at the next epoch if its stake ranks
+among the top validators.
+
+The validator seals blocks with the consensus key given by --consensus-key, as
+printed by pixelzx validator keys export, while its stake and rewards stay with
+the registering account. Without a consensus key, the validator seals with the
+key of the registering account itself.`,
+			Action: validatorRegister,
+		},
+		{
+			Name:   "list",
+			Usage:  "List all validators",
+			Flags:  []cli.Flag{rpcFlag, jsonFlag},
+			Action: validatorList,
+		},
+		{
+			Name:      "info",
+			Usage:     "Show the registration and stake of a validator",
+			ArgsUsage: "<address>",
+			Flags:     []cli.Flag{rpcFlag, jsonFlag},
+			Action:    validatorInfo,
 		},
 		{
-			Name:  "list",
-			Usage: "List all validators",
-			Action: func(c *cli.Context) error {
-				fmt.Println("Validators:")
-				fmt.Println("  0x742d35Cc6634C0532925a3b844Bc454e4438f44e (Stake: 1000 PXZ)")
-				fmt.Println("  0x8ba1f109551bD432803012645Hac136c22C43137 (Stake: 500 PXZ)")
-				return nil
-			},
+			Name:      "status",
+			Usage:     "Show the consensus status of a validator",
+			ArgsUsage: "<address>",
+			Flags:     []cli.Flag{rpcFlag, jsonFlag},
+			Action:    validatorStatus,
 		},
+		validatorKeysCmd(),
+		validatorProtectionCmd(),
 	},
-}
\ No newline at end of file
+}
+
+// validatorRecord is the state of a registered validator.
+type validatorRecord struct {
+	Address      common.Address `json:"address"`
+	Moniker      string         `json:"moniker"`
+	Active       bool           `json:"active"`
+	Power        uint64         `json:"power"`
+	SelfStake    *hexutil.Big   `json:"selfStake"`
+	Delegated    *hexutil.Big   `json:"delegated"`
+	TotalStake   *hexutil.Big   `json:"totalStake"`
+	Commission   uint64         `json:"commission"`
+	ConsensusKey hexutil.Bytes  `json:"consensusKey"`
+	Signer       common.Address `json:"signer"`
+	Jailed       bool           `json:"jailed"`
+	Tombstoned   bool           `json:"tombstoned"`
+	MissedSlots  uint64         `json:"missedSlots"`
+}
+
+// activeSet retrieves the voting power of the active validators at the head.
+func (s *stakingClient) activeSet(ctx *cli.Context) (map[common.Address]uint64, error) {
+	var validators []pixelzx.Validator
+	if err := s.client.Client().CallContext(ctx.Context, &validators, "pixelzx_getValidators"); err != nil {
+		return nil, fmt.Errorf("failed to retrieve the validator set: %v", err)
+	}
+	powers := make(map[common.Address]uint64, len(validators))
+	for _, v := range validators {
+		powers[v.Address] = v.Power
+	}
+	return powers, nil
+}
+
+// validator retrieves the state of a registered validator from the contract.
+func (s *stakingClient) validator(ctx *cli.Context, addr common.Address, powers map[common.Address]uint64) (*validatorRecord, error) {
+	info, err := bind.Call(s.contract, s.callOpts(ctx), s.abi.PackGetValidator(addr), s.abi.UnpackGetValidator)
+	if err != nil {
+		return nil, fmt.Errorf("validator %s: %v", addr.Hex(), err)
+	}
+	jail, err := bind.Call(s.contract, s.callOpts(ctx), s.abi.PackGetJailStatus(addr), s.abi.UnpackGetJailStatus)
+	if err != nil {
+		return nil, fmt.Errorf("validator %s: %v", addr.Hex(), err)
+	}
+	// The active set lists validators by the address they seal with
+	signer := addr
+	if len(info.ConsensusKey) > 0 {
+		pubkey, err := crypto.UnmarshalPubkey(info.ConsensusKey)
+		if err != nil {
+			return nil, fmt.Errorf("validator %s: invalid consensus key: %v", addr.Hex(), err)
+		}
+		signer = crypto.PubkeyToAddress(*pubkey)
+	}
+	power, active := powers[signer]
+	return &validatorRecord{
+		Address:      addr,
+		Moniker:      info.Moniker,
+		Active:       active,
+		Power:        power,
+		SelfStake:    (*hexutil.Big)(info.SelfStake),
+		Delegated:    (*hexutil.Big)(new(big.Int).Sub(info.TotalStake, info.SelfStake)),
+		TotalStake:   (*hexutil.Big)(info.TotalStake),
+		Commission:   info.Commission.Uint64(),
+		ConsensusKey: info.ConsensusKey,
+		Signer:       signer,
+		Jailed:       jail.Jailed,
+		Tombstoned:   jail.Tombstoned,
+		MissedSlots:  jail.MissedSlots.Uint64(),
+	}, nil
+}
+
+// validatorArg parses the validator address given as the only argument.
+func validatorArg(ctx *cli.Context) (common.Address, error) {
+	if ctx.Args().Len() != 1 {
+		return common.Address{}, errors.New("validator address must be given as the only argument")
+	}
+	if !common.IsHexAddress(ctx.Args().First()) {
+		return common.Address{}, fmt.Errorf("invalid validator address: %q", ctx.Args().First())
+	}
+	return common.HexToAddress(ctx.Args().First()), nil
+}
+
+// formatCommission formats a commission rate in basis points as a percentage.
+func formatCommission(bps uint64) string {
+	return fmt.Sprintf("%d.%02d%%", bps/100, bps%100)
+}
+
+// jailStatus describes the jail status of a validator.
+func (v *validatorRecord) jailStatus() string {
+	switch {
+	case v.Tombstoned:
+		return "tombstoned"
+	case v.Jailed:
+		return "jailed"
+	default:
+		return "no"
+	}
+}
+
+// validatorRegister registers the sending account as a validator.
+func validatorRegister(ctx *cli.Context) error {
+	stake, err := parsePZX(ctx.String(stakeFlag.Name))
+	if err != nil {
+		return err
+	}
+	var key []byte
+	if hexkey := ctx.String(consensusKeyFlag.Name); hexkey != "" {
+		if key, err = hex.DecodeString(strings.TrimPrefix(hexkey, "0x")); err != nil {
+			return fmt.Errorf("invalid consensus key: %v", err)
+		}
+		if _, err := crypto.UnmarshalPubkey(key); err != nil {
+			return fmt.Errorf("invalid consensus key: %v", err)
+		}
+	}
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	opts, err := s.transactOpts(ctx)
+	if err != nil {
+		return err
+	}
+	opts.Value = stake
+
+	commission := new(big.Int).SetUint64(uint64(ctx.Uint(commissionFlag.Name)))
+	receipt, err := s.transact(ctx, opts, s.abi.PackRegisterValidator(commission, ctx.String(monikerFlag.Name), key))
+	if err != nil {
+		return err
+	}
+	if !ctx.Bool(jsonFlag.Name) {
+		fmt.Printf("Registered validator %s with a self stake of %s\n", opts.From.Hex(), formatPZX(stake))
+	}
+	return printReceipt(ctx, receipt)
+}
+
+// validatorList prints all registered validators.
+func validatorList(ctx *cli.Context) error {
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	addrs, err := bind.Call(s.contract, s.callOpts(ctx), s.abi.PackGetValidators(), s.abi.UnpackGetValidators)
+	if err != nil {
+		return fmt.Errorf("failed to retrieve validators: %v", err)
+	}
+	powers, err := s.activeSet(ctx)
+	if err != nil {
+		return err
+	}
+	records := make([]*validatorRecord, 0, len(addrs))
+	for _, addr := range addrs {
+		record, err := s.validator(ctx, addr, powers)
+		if err != nil {
+			return err
+		}
+		records = append(records, record)
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(records)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "ADDRESS\tMONIKER\tACTIVE\tSELF STAKE\tDELEGATED\tCOMMISSION\tJAILED\tMISSED")
+	for _, v := range records {
+		fmt.Fprintf(w, "%s\t%s\t%t\t%s\t%s\t%s\t%s\t%d\n", v.Address.Hex(), v.Moniker, v.Active,
+			formatPZX(v.SelfStake.ToInt()), formatPZX(v.Delegated.ToInt()), formatCommission(v.Commission), v.jailStatus(), v.MissedSlots)
+	}
+	return w.Flush()
+}
+
+// validatorInfo prints the registration and stake of a validator.
+func validatorInfo(ctx *cli.Context) error {
+	addr, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	powers, err := s.activeSet(ctx)
+	if err != nil {
+		return err
+	}
+	v, err := s.validator(ctx, addr, powers)
+	if err != nil {
+		return err
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(v)
+	}
+	fmt.Println("Address:       ", v.Address.Hex())
+	fmt.Println("Moniker:       ", v.Moniker)
+	fmt.Println("Self stake:    ", formatPZX(v.SelfStake.ToInt()))
+	fmt.Println("Delegated:     ", formatPZX(v.Delegated.ToInt()))
+	fmt.Println("Total stake:   ", formatPZX(v.TotalStake.ToInt()))
+	fmt.Println("Commission:    ", formatCommission(v.Commission))
+	fmt.Println("Consensus key: ", v.ConsensusKey)
+	fmt.Println("Signer:        ", v.Signer.Hex())
+	return nil
+}
+
+// validatorStatusRecord is the consensus status of a validator at the head.
+type validatorStatusRecord struct {
+	*validatorRecord
+	Number         uint64 `json:"number"`
+	TotalPower     uint64 `json:"totalPower"`
+	Window         uint64 `json:"window"`
+	MaxMissedSlots uint64 `json:"maxMissedSlots"`
+}
+
+// validatorStatus prints the consensus status of a validator.
+func validatorStatus(ctx *cli.Context) error {
+	addr, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	powers, err := s.activeSet(ctx)
+	if err != nil {
+		return err
+	}
+	v, err := s.validator(ctx, addr, powers)
+	if err != nil {
+		return err
+	}
+	var liveness pixelzx.Liveness
+	if err := s.client.Client().CallContext(ctx.Context, &liveness, "pixelzx_getLiveness"); err != nil {
+		return fmt.Errorf("failed to retrieve liveness: %v", err)
+	}
+	status := &validatorStatusRecord{
+		validatorRecord: v,
+		Number:          liveness.Number,
+		Window:          liveness.Window,
+		MaxMissedSlots:  liveness.MaxMissedSlots,
+	}
+	for _, power := range powers {
+		status.TotalPower += power
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(status)
+	}
+	fmt.Println("Address:       ", v.Address.Hex())
+	fmt.Println("Block:         ", status.Number)
+	if v.Active {
+		fmt.Printf("Active:         yes (power %d of %d)\n", v.Power, status.TotalPower)
+	} else {
+		fmt.Println("Active:         no")
+	}
+	fmt.Println("Jailed:        ", v.jailStatus())
+	fmt.Printf("Missed slots:   %d of %d allowed in the last %d blocks\n", v.MissedSlots, status.MaxMissedSlots, status.Window)
+	return nil
+}
@@ -0,0 +1,294 @@
+package commands
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/keys"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+)
+
+var keyFilePasswordFlag = &cli.StringFlag{
+	Name:  "keyfile.password",
+	Usage: "Password file of the encrypted key file to import",
+}
+
+// consensusKeyFlags are the flags locating the consensus key store of the node.
+var consensusKeyFlags = []cli.Flag{
+	configFileFlag,
+	DataDirFlag,
+	validatorKeysFlag,
+}
+
+// consensusKeyInfo is the JSON representation of a consensus key.
+type consensusKeyInfo struct {
+	Address   common.Address `json:"address"`
+	PublicKey hexutil.Bytes  `json:"publicKey"`
+}
+
+// validatorKeysCmd returns the keys subcommand for validator
+func validatorKeysCmd() *cli.Command {
+	return &cli.Command{
+		Name:  "keys",
+		Usage: "Manage validator consensus keys",
+		Description: `
+Manage the consensus keys validators seal and attest blocks with. Consensus keys
+are kept encrypted in a store of their own, by default the consensus-keys
+directory of the datadir, apart from the account keystore: they only ever sign
+consensus messages, never transactions.
+
+Validators seal blocks with the consensus key registered for them in the staking
+contract by pixelzx validator register --consensus-key, and replaced by pixelzx
+validator keys rotate, while their stake and rewards stay with the registering
+account. Validators registered without one, like those staked in the genesis
+block, seal with the key of their own address, which then has to be kept in the
+store.`,
+		Subcommands: []*cli.Command{
+			{
+				Name:   "generate",
+				Usage:  "Generate a new consensus key",
+				Flags:  append([]cli.Flag{passwordFileFlag, jsonFlag}, consensusKeyFlags...),
+				Action: validatorKeysGenerate,
+			},
+			{
+				Name:   "list",
+				Usage:  "List the consensus keys",
+				Flags:  append([]cli.Flag{jsonFlag}, consensusKeyFlags...),
+				Action: validatorKeysList,
+			},
+			{
+				Name:      "import",
+				Usage:     "Import a consensus key",
+				ArgsUsage: "<keyfile>",
+				Flags:     append([]cli.Flag{passwordFileFlag, keyFilePasswordFlag, jsonFlag}, consensusKeyFlags...),
+				Description: `
+Imports a private key into the consensus key store. The keyfile either holds the
+private key in hexadecimal form on a single line, or is an encrypted key file of
+the account keystore, decrypted with the password given by --keyfile.password
+(or prompted for).`,
+				Action: validatorKeysImport,
+			},
+			{
+				Name:      "export",
+				Usage:     "Export the public key of a consensus key",
+				ArgsUsage: "<address>",
+				Flags:     append([]cli.Flag{jsonFlag}, consensusKeyFlags...),
+				Description: `
+Prints the public key of a consensus key, as registered with the staking contract
+by pixelzx validator register --consensus-key. The private key never leaves the
+store.`,
+				Action: validatorKeysExport,
+			},
+			{
+				Name:      "rotate",
+				Usage:     "Replace the consensus key of the validator",
+				ArgsUsage: "<address>",
+				Flags:     append([]cli.Flag{validatorKeysFlag, dryRunFlag}, transactFlags...),
+				Description: `
+Registers the consensus key of the given address with the staking contract, as
+the new key of the validator sending the transaction. The validator seals with
+it from the next epoch on: nodes seal with the key they were started with, so
+restart the validator node with the new key once the epoch begins. Blocks and
+double signs of the old key are still accounted to the validator.`,
+				Action: validatorKeysRotate,
+			},
+			{
+				Name:      "change-password",
+				Usage:     "Re-encrypt a consensus key with a new password",
+				ArgsUsage: "<address>",
+				Flags:     append([]cli.Flag{passwordFileFlag, newPasswordFileFlag}, consensusKeyFlags...),
+				Description: `
+Re-encrypts a consensus key with a new password and fresh encryption parameters,
+replacing its key file atomically. The key itself is unchanged.`,
+				Action: validatorKeysChangePassword,
+			},
+		},
+	}
+}
+
+// openConsensusKeys opens the consensus key store configured by the command line
+// flags.
+func openConsensusKeys(ctx *cli.Context) (*keys.Store, error) {
+	config, err := makeConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return keys.NewStore(config.Node.consensusKeyDir(), keystore.StandardScryptN, keystore.StandardScryptP), nil
+}
+
+// printConsensusKey prints the address and public key of a consensus key.
+func printConsensusKey(ctx *cli.Context, store *keys.Store, addr common.Address) error {
+	pubkey, err := store.PublicKey(addr)
+	if err != nil {
+		return err
+	}
+	info := consensusKeyInfo{Address: addr, PublicKey: crypto.FromECDSAPub(pubkey)}
+	if ctx.Bool(jsonFlag.Name) {
+		return printJSON(info)
+	}
+	fmt.Println("Address:       ", info.Address.Hex())
+	fmt.Println("Public key:    ", info.PublicKey)
+	return nil
+}
+
+// validatorKeysGenerate creates a new consensus key.
+func validatorKeysGenerate(ctx *cli.Context) error {
+	store, err := openConsensusKeys(ctx)
+	if err != nil {
+		return err
+	}
+	password, err := getPassword(ctx, passwordFileFlag, "Your new consensus key is locked with a password. Please give a password. Do not forget this password.", true)
+	if err != nil {
+		return err
+	}
+	addr, err := store.Generate(password)
+	if err != nil {
+		return fmt.Errorf("failed to generate consensus key: %v", err)
+	}
+	return printConsensusKey(ctx, store, addr)
+}
+
+// validatorKeysList prints the consensus keys of the store.
+func validatorKeysList(ctx *cli.Context) error {
+	store, err := openConsensusKeys(ctx)
+	if err != nil {
+		return err
+	}
+	addrs, err := store.Keys()
+	if err != nil {
+		return err
+	}
+	if ctx.Bool(jsonFlag.Name) {
+		list := make([]consensusKeyInfo, 0, len(addrs))
+		for _, addr := range addrs {
+			pubkey, err := store.PublicKey(addr)
+			if err != nil {
+				return err
+			}
+			list = append(list, consensusKeyInfo{Address: addr, PublicKey: crypto.FromECDSAPub(pubkey)})
+		}
+		return printJSON(list)
+	}
+	if len(addrs) == 0 {
+		fmt.Println("No consensus keys in", store.Dir())
+		return nil
+	}
+	for i, addr := range addrs {
+		fmt.Printf("Consensus key #%d: %s\n", i, addr.Hex())
+	}
+	return nil
+}
+
+// validatorKeysImport imports a plain or encrypted private key into the store.
+func validatorKeysImport(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("keyfile must be given as the only argument")
+	}
+	path := ctx.Args().First()
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read the keyfile at '%s': %v", path, err)
+	}
+	var key *ecdsa.PrivateKey
+	if json.Valid(blob) {
+		password, err := getPassword(ctx, keyFilePasswordFlag, "Please provide the password of the key file", false)
+		if err != nil {
+			return err
+		}
+		decrypted, err := keystore.DecryptKey(blob, password)
+		if err != nil {
+			return fmt.Errorf("error decrypting key: %v", err)
+		}
+		key = decrypted.PrivateKey
+	} else if key, err = crypto.LoadECDSA(path); err != nil {
+		return fmt.Errorf("failed to load the private key: %v", err)
+	}
+	store, err := openConsensusKeys(ctx)
+	if err != nil {
+		return err
+	}
+	password, err := getPassword(ctx, passwordFileFlag, "Your consensus key is locked with a password. Please give a password. Do not forget this password.", true)
+	if err != nil {
+		return err
+	}
+	addr, err := store.Import(key, password)
+	if err != nil {
+		return fmt.Errorf("could not import consensus key: %w", err)
+	}
+	return printConsensusKey(ctx, store, addr)
+}
+
+// validatorKeysExport prints the public key of a consensus key.
+func validatorKeysExport(ctx *cli.Context) error {
+	addr, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	store, err := openConsensusKeys(ctx)
+	if err != nil {
+		return err
+	}
+	return printConsensusKey(ctx, store, addr)
+}
+
+// validatorKeysRotate registers a consensus key of the store as the new key of
+// the sending validator.
+func validatorKeysRotate(ctx *cli.Context) error {
+	addr, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	store, err := openConsensusKeys(ctx)
+	if err != nil {
+		return err
+	}
+	pubkey, err := store.PublicKey(addr)
+	if err != nil {
+		return err
+	}
+	s, err := dialStaking(ctx)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	if _, err := s.execute(ctx, nil, s.abi.PackRotateConsensusKey(crypto.FromECDSAPub(pubkey))); err != nil {
+		return err
+	}
+	if !ctx.Bool(jsonFlag.Name) && !ctx.Bool(dryRunFlag.Name) {
+		fmt.Printf("Rotated to consensus key %s, sealing from the next epoch\n", addr.Hex())
+	}
+	return nil
+}
+
+// validatorKeysChangePassword re-encrypts a consensus key with a new password.
+func validatorKeysChangePassword(ctx *cli.Context) error {
+	addr, err := validatorArg(ctx)
+	if err != nil {
+		return err
+	}
+	store, err := openConsensusKeys(ctx)
+	if err != nil {
+		return err
+	}
+	password, err := getPassword(ctx, passwordFileFlag, fmt.Sprintf("Please provide the password of consensus key %s", addr.Hex()), false)
+	if err != nil {
+		return err
+	}
+	newPassword, err := getPassword(ctx, newPasswordFileFlag, "Please give a NEW password. Do not forget this password.", true)
+	if err != nil {
+		return err
+	}
+	if err := store.Update(addr, password, newPassword); err != nil {
+		return fmt.Errorf("could not re-encrypt consensus key: %w", err)
+	}
+	fmt.Println("Re-encrypted consensus key", addr.Hex())
+	return nil
+}
@@ -0,0 +1,152 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/keys"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/urfave/cli/v2"
+)
+
+var interchangeOutFlag = &cli.StringFlag{
+	Name:  "out",
+	Usage: "File to write the interchange to (default = stdout)",
+}
+
+// validatorProtectionCmd returns the slashing-protection subcommand for validator
+func validatorProtectionCmd() *cli.Command {
+	return &cli.Command{
+		Name:  "slashing-protection",
+		Usage: "Migrate validator slashing protection records",
+		Description: `
+A validator node records the highest block sealed and attested by its consensus
+keys, and refuses to sign anything conflicting with them. Running a key on a new
+host, the records must be moved along with it: export them on the old host once
+its node is stopped, and import them on the new one before starting its node.
+
+The records are exchanged in an interchange JSON file, bound to the genesis of
+the chain. The node must be stopped for both.`,
+		Subcommands: []*cli.Command{
+			{
+				Name:   "export",
+				Usage:  "Export the slashing protection records",
+				Flags:  []cli.Flag{interchangeOutFlag, configFileFlag, DataDirFlag},
+				Action: validatorProtectionExport,
+			},
+			{
+				Name:      "import",
+				Usage:     "Import slashing protection records",
+				ArgsUsage: "<file>",
+				Flags:     []cli.Flag{configFileFlag, DataDirFlag},
+				Description: `
+Merges the records of an interchange file into the local ones. The higher of the
+imported and the local record of a key is kept; records differing at the same
+height refuse any further signature at that height.`,
+				Action: validatorProtectionImport,
+			},
+		},
+	}
+}
+
+// openProtection opens the slashing protection database of the stopped node, and
+// returns it along with the genesis hash of its chain.
+func openProtection(ctx *cli.Context) (*keys.Protection, common.Hash, func(), error) {
+	config, err := makeConfig(ctx)
+	if err != nil {
+		return nil, common.Hash{}, nil, err
+	}
+	stack, err := newNode(makeStackConfig(config))
+	if errors.Is(err, node.ErrDatadirUsed) {
+		return nil, common.Hash{}, nil, errors.New("node is running, stop it first")
+	}
+	if err != nil {
+		return nil, common.Hash{}, nil, fmt.Errorf("failed to open datadir: %v", err)
+	}
+	if !common.FileExist(stack.ResolvePath("chaindata")) {
+		stack.Close()
+		return nil, common.Hash{}, nil, errors.New("chain not initialized, run pixelzx init first")
+	}
+	chaindb, err := openChainDatabase(stack, true)
+	if err != nil {
+		stack.Close()
+		return nil, common.Hash{}, nil, fmt.Errorf("failed to open database: %v", err)
+	}
+	genesis := rawdb.ReadCanonicalHash(chaindb, 0)
+	chaindb.Close()
+	if genesis == (common.Hash{}) {
+		stack.Close()
+		return nil, common.Hash{}, nil, errors.New("chain not initialized, run pixelzx init first")
+	}
+	db, err := openProtectionDB(config)
+	if err != nil {
+		stack.Close()
+		return nil, common.Hash{}, nil, err
+	}
+	closer := func() {
+		db.Close()
+		stack.Close() // releases the datadir lock
+	}
+	return keys.NewProtection(db), genesis, closer, nil
+}
+
+// validatorProtectionExport writes the slashing protection records as an
+// interchange file.
+func validatorProtectionExport(ctx *cli.Context) error {
+	protection, genesis, closer, err := openProtection(ctx)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	interchange, err := protection.Export(genesis)
+	if err != nil {
+		return fmt.Errorf("failed to export slashing protection: %v", err)
+	}
+	path := ctx.String(interchangeOutFlag.Name)
+	if path == "" {
+		return printJSON(interchange)
+	}
+	blob, err := json.MarshalIndent(interchange, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(path, append(blob, '\n'), 0600); err != nil {
+		return fmt.Errorf("failed to write interchange: %v", err)
+	}
+	fmt.Printf("Exported the records of %d keys to %s\n", len(interchange.Data), path)
+	return nil
+}
+
+// validatorProtectionImport merges an interchange file into the slashing
+// protection records.
+func validatorProtectionImport(ctx *cli.Context) error {
+	if ctx.Args().Len() != 1 {
+		return errors.New("interchange file must be given as the only argument")
+	}
+	path := ctx.Args().First()
+	blob, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read interchange: %v", err)
+	}
+	interchange := new(keys.Interchange)
+	if err := json.Unmarshal(blob, interchange); err != nil {
+		return fmt.Errorf("invalid interchange %s: %v", path, err)
+	}
+	protection, genesis, closer, err := openProtection(ctx)
+	if err != nil {
+		return err
+	}
+	defer closer()
+
+	changed, err := protection.Import(interchange, genesis)
+	if err != nil {
+		return fmt.Errorf("failed to import slashing protection: %v", err)
+	}
+	fmt.Printf("Imported the records of %d keys, %d records updated\n", len(interchange.Data), changed)
+	return nil
+}
@@ -111,3 +111,40 @@ type Engine interface {
 	// Close terminates any background threads maintained by the consensus engine.
 	Close() error
 }
+
+// StateVerifier is an optional interface for consensus engines whose headers
+// commit to values derived from the post-transaction state (e.g. a validator set
+// elected by an on-chain contract), which cannot be checked by VerifyHeader.
+type StateVerifier interface {
+	// VerifyState checks the header of a processed block against the state it
+	// produced, after finalization and before the state root is checked.
+	VerifyState(chain ChainHeaderReader, header *types.Header, state vm.StateDB) error
+}
+
+// FeeCollector is an optional interface for consensus engines which collect the
+// transaction tips of a block into a pool, to be distributed at finalization,
+// instead of paying them directly to the block's coinbase.
+type FeeCollector interface {
+	// FeeRecipient returns the account the tips of the given block are paid to.
+	// It is also the value returned by the COINBASE opcode during execution.
+	FeeRecipient(header *types.Header) common.Address
+}
+
+// GasLimiter is an optional interface for consensus engines which govern the gas
+// limit on chain, overriding the gas ceiling configured by the block producer.
+type GasLimiter interface {
+	// GasLimitTarget returns the gas limit the given block must move towards,
+	// read from the state of its parent or its own post state, or false if the
+	// target is left to the block producer.
+	GasLimitTarget(header *types.Header, state vm.StateDB) (uint64, bool)
+}
+
+// ForkChooser is an optional interface for consensus engines which choose the
+// canonical chain among competing forks themselves, instead of leaving it to an
+// external consensus client. Blocks losing the fork choice are imported without
+// becoming the chain head.
+type ForkChooser interface {
+	// ReorgNeeded returns whether the chain headed by extern is preferred over
+	// the one headed by current, which it doesn't extend.
+	ReorgNeeded(chain ChainHeaderReader, current, extern *types.Header) (bool, error)
+}
@@ -0,0 +1,54 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package misc
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// ApplyPixelzxHardForks modifies the state database according to the PIXELZX
+// hard-forks activated by the block with the given number, installing the code
+// of the system contracts they introduce or upgrade. It is applied before the
+// transactions of the block, which may call the contracts right away.
+func ApplyPixelzxHardForks(config *params.PixelzxConfig, number *big.Int, statedb vm.StateDB) {
+	if config == nil {
+		return
+	}
+	if config.GovernanceBlock != nil && config.GovernanceBlock.Cmp(number) == 0 {
+		installSystemContract(statedb, params.PixelzxGovernanceAddress, params.PixelzxGovernanceCode)
+	}
+}
+
+// installSystemContract sets the code of a system contract account, creating the
+// account if needed. The storage of an existing contract is retained, so its code
+// can be replaced without losing its state.
+func installSystemContract(statedb vm.StateDB, addr common.Address, code []byte) {
+	if !statedb.Exist(addr) {
+		statedb.CreateAccount(addr)
+	}
+	statedb.SetCode(addr, code)
+
+	// The nonce keeps the account from being pruned as empty
+	if statedb.GetNonce(addr) == 0 {
+		statedb.SetNonce(addr, 1, tracing.NonceChangeNewContract)
+	}
+}
@@ -0,0 +1,170 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// APIChain is the subset of the blockchain the RPC API reads from.
+type APIChain interface {
+	consensus.ChainHeaderReader
+
+	// StateAt returns the state database at the given root.
+	StateAt(root common.Hash) (*state.StateDB, error)
+}
+
+// API is a user facing RPC API to inspect the PIXELZX validator set and the
+// liveness of the validators, registered under the "pixelzx" namespace.
+type API struct {
+	chain  APIChain
+	engine *Pixelzx
+}
+
+// NewAPI creates the RPC API of the PIXELZX engine.
+func NewAPI(engine *Pixelzx, chain APIChain) *API {
+	return &API{chain: chain, engine: engine}
+}
+
+// ValidatorLiveness is the liveness record of a registered validator.
+type ValidatorLiveness struct {
+	Address     common.Address `json:"address"`
+	MissedSlots uint64         `json:"missedSlots"`
+	Jailed      bool           `json:"jailed"`
+	Tombstoned  bool           `json:"tombstoned"`
+}
+
+// Liveness is the liveness record of all registered validators at a block.
+type Liveness struct {
+	Number         uint64              `json:"number"`
+	Window         uint64              `json:"window"`
+	MaxMissedSlots uint64              `json:"maxMissedSlots"`
+	Validators     []ValidatorLiveness `json:"validators"`
+}
+
+// PendingUnbonding is a chunk of undelegated stake waiting for the unbonding
+// period to elapse.
+type PendingUnbonding struct {
+	Validator  common.Address `json:"validator"`
+	Amount     *hexutil.Big   `json:"amount"`
+	Creation   uint64         `json:"creation"`
+	Completion uint64         `json:"completion"`
+	Matured    bool           `json:"matured"`
+}
+
+// header retrieves the header of the requested block, defaulting to the head.
+func (api *API) header(number *rpc.BlockNumber) (*types.Header, error) {
+	var header *types.Header
+	if number == nil || *number == rpc.LatestBlockNumber {
+		header = api.chain.CurrentHeader()
+	} else {
+		header = api.chain.GetHeaderByNumber(uint64(number.Int64()))
+	}
+	if header == nil {
+		return nil, errUnknownBlock
+	}
+	return header, nil
+}
+
+// state retrieves the state of the requested block, defaulting to the head.
+func (api *API) state(number *rpc.BlockNumber) (*state.StateDB, *types.Header, error) {
+	header, err := api.header(number)
+	if err != nil {
+		return nil, nil, err
+	}
+	statedb, err := api.chain.StateAt(header.Root)
+	if err != nil {
+		return nil, nil, err
+	}
+	return statedb, header, nil
+}
+
+// GetValidators retrieves the active validator set and their voting power at
+// the specified block.
+func (api *API) GetValidators(number *rpc.BlockNumber) ([]Validator, error) {
+	header, err := api.header(number)
+	if err != nil {
+		return nil, err
+	}
+	snap, err := api.engine.snapshot(api.chain, header.Number.Uint64(), header.Hash(), nil)
+	if err != nil {
+		return nil, err
+	}
+	return snap.validators(), nil
+}
+
+// GetLiveness retrieves the missed proposal slots within the liveness window and
+// the jail status of all registered validators at the specified block.
+func (api *API) GetLiveness(number *rpc.BlockNumber) (*Liveness, error) {
+	statedb, header, err := api.state(number)
+	if err != nil {
+		return nil, err
+	}
+	liveness := &Liveness{
+		Number:         header.Number.Uint64(),
+		Window:         api.engine.config.LivenessWindow,
+		MaxMissedSlots: api.engine.config.MaxMissedSlots,
+		Validators:     []ValidatorLiveness{},
+	}
+	for _, addr := range staking.Validators(statedb) {
+		info := staking.Validator(statedb, addr)
+		liveness.Validators = append(liveness.Validators, ValidatorLiveness{
+			Address:     addr,
+			MissedSlots: info.MissedSlots,
+			Jailed:      info.Jailed,
+			Tombstoned:  info.Tombstoned,
+		})
+	}
+	return liveness, nil
+}
+
+// GetMissedSlots retrieves the number of proposal slots a validator missed within
+// the liveness window at the specified block.
+func (api *API) GetMissedSlots(validator common.Address, number *rpc.BlockNumber) (uint64, error) {
+	statedb, _, err := api.state(number)
+	if err != nil {
+		return 0, err
+	}
+	return staking.MissedSlots(statedb, validator), nil
+}
+
+// GetUnbondings retrieves the pending unbondings of a delegator at the specified
+// block. Matured unbondings may be withdrawn right away, or are paid back at the
+// next epoch block otherwise.
+func (api *API) GetUnbondings(delegator common.Address, number *rpc.BlockNumber) ([]PendingUnbonding, error) {
+	statedb, header, err := api.state(number)
+	if err != nil {
+		return nil, err
+	}
+	unbondings := []PendingUnbonding{}
+	for _, entry := range staking.Unbondings(statedb, delegator) {
+		unbondings = append(unbondings, PendingUnbonding{
+			Validator:  entry.Validator,
+			Amount:     (*hexutil.Big)(entry.Amount),
+			Creation:   entry.Creation,
+			Completion: entry.Completion,
+			Matured:    entry.Completion <= header.Number.Uint64(),
+		})
+	}
+	return unbondings, nil
+}
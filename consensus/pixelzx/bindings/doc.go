@@ -0,0 +1,24 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package bindings contains the Go bindings of the PIXELZX system contracts,
+// generated by abigen from their ABI definitions. They are kept apart from the
+// contract implementations so that the consensus code does not pull in the RPC
+// client machinery the bindings depend on.
+package bindings
+
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen -v2 --abi ../staking/staking.abi --pkg bindings --type Staking --out staking.go
+//go:generate go run github.com/ethereum/go-ethereum/cmd/abigen -v2 --abi ../governance/governance.abi --pkg bindings --type Governance --out governance.go
@@ -0,0 +1,404 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// GovernanceMetaData contains all meta data concerning the Governance contract.
+var GovernanceMetaData = bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"propose\",\"inputs\":[{\"name\":\"param\",\"type\":\"uint8\"},{\"name\":\"value\",\"type\":\"uint256\"},{\"name\":\"description\",\"type\":\"string\"}],\"outputs\":[{\"name\":\"id\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"vote\",\"inputs\":[{\"name\":\"id\",\"type\":\"uint256\"},{\"name\":\"support\",\"type\":\"bool\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"proposalCount\",\"inputs\":[],\"outputs\":[{\"name\":\"count\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getProposal\",\"inputs\":[{\"name\":\"id\",\"type\":\"uint256\"}],\"outputs\":[{\"name\":\"proposer\",\"type\":\"address\"},{\"name\":\"param\",\"type\":\"uint8\"},{\"name\":\"value\",\"type\":\"uint256\"},{\"name\":\"description\",\"type\":\"string\"},{\"name\":\"status\",\"type\":\"uint8\"},{\"name\":\"votingEnd\",\"type\":\"uint256\"},{\"name\":\"executionBlock\",\"type\":\"uint256\"},{\"name\":\"yesVotes\",\"type\":\"uint256\"},{\"name\":\"noVotes\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hasVoted\",\"inputs\":[{\"name\":\"id\",\"type\":\"uint256\"},{\"name\":\"voter\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"voted\",\"type\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"votingPower\",\"inputs\":[{\"name\":\"voter\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"power\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getParam\",\"inputs\":[{\"name\":\"param\",\"type\":\"uint8\"}],\"outputs\":[{\"name\":\"set\",\"type\":\"bool\"},{\"name\":\"value\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"ProposalCreated\",\"inputs\":[{\"name\":\"id\",\"type\":\"uint256\",\"indexed\":true},{\"name\":\"proposer\",\"type\":\"address\",\"indexed\":true},{\"name\":\"param\",\"type\":\"uint8\",\"indexed\":false},{\"name\":\"value\",\"type\":\"uint256\",\"indexed\":false},{\"name\":\"votingEnd\",\"type\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Voted\",\"inputs\":[{\"name\":\"id\",\"type\":\"uint256\",\"indexed\":true},{\"name\":\"voter\",\"type\":\"address\",\"indexed\":true},{\"name\":\"support\",\"type\":\"bool\",\"indexed\":false},{\"name\":\"weight\",\"type\":\"uint256\",\"indexed\":false}],\"anonymous\":false}]",
+	ID:  "Governance",
+}
+
+// Governance is an auto generated Go binding around an Ethereum contract.
+type Governance struct {
+	abi abi.ABI
+}
+
+// NewGovernance creates a new instance of Governance.
+func NewGovernance() *Governance {
+	parsed, err := GovernanceMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &Governance{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *Governance) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackGetParam is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x03a2216d.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getParam(uint8 param) view returns(bool set, uint256 value)
+func (governance *Governance) PackGetParam(param uint8) []byte {
+	enc, err := governance.abi.Pack("getParam", param)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetParam is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x03a2216d.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getParam(uint8 param) view returns(bool set, uint256 value)
+func (governance *Governance) TryPackGetParam(param uint8) ([]byte, error) {
+	return governance.abi.Pack("getParam", param)
+}
+
+// GetParamOutput serves as a container for the return parameters of contract
+// method GetParam.
+type GetParamOutput struct {
+	Set   bool
+	Value *big.Int
+}
+
+// UnpackGetParam is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x03a2216d.
+//
+// Solidity: function getParam(uint8 param) view returns(bool set, uint256 value)
+func (governance *Governance) UnpackGetParam(data []byte) (GetParamOutput, error) {
+	out, err := governance.abi.Unpack("getParam", data)
+	outstruct := new(GetParamOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.Set = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Value = abi.ConvertType(out[1], new(big.Int)).(*big.Int)
+	return *outstruct, nil
+}
+
+// PackGetProposal is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc7f758a8.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getProposal(uint256 id) view returns(address proposer, uint8 param, uint256 value, string description, uint8 status, uint256 votingEnd, uint256 executionBlock, uint256 yesVotes, uint256 noVotes)
+func (governance *Governance) PackGetProposal(id *big.Int) []byte {
+	enc, err := governance.abi.Pack("getProposal", id)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetProposal is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc7f758a8.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getProposal(uint256 id) view returns(address proposer, uint8 param, uint256 value, string description, uint8 status, uint256 votingEnd, uint256 executionBlock, uint256 yesVotes, uint256 noVotes)
+func (governance *Governance) TryPackGetProposal(id *big.Int) ([]byte, error) {
+	return governance.abi.Pack("getProposal", id)
+}
+
+// GetProposalOutput serves as a container for the return parameters of contract
+// method GetProposal.
+type GetProposalOutput struct {
+	Proposer       common.Address
+	Param          uint8
+	Value          *big.Int
+	Description    string
+	Status         uint8
+	VotingEnd      *big.Int
+	ExecutionBlock *big.Int
+	YesVotes       *big.Int
+	NoVotes        *big.Int
+}
+
+// UnpackGetProposal is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xc7f758a8.
+//
+// Solidity: function getProposal(uint256 id) view returns(address proposer, uint8 param, uint256 value, string description, uint8 status, uint256 votingEnd, uint256 executionBlock, uint256 yesVotes, uint256 noVotes)
+func (governance *Governance) UnpackGetProposal(data []byte) (GetProposalOutput, error) {
+	out, err := governance.abi.Unpack("getProposal", data)
+	outstruct := new(GetProposalOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.Proposer = *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+	outstruct.Param = *abi.ConvertType(out[1], new(uint8)).(*uint8)
+	outstruct.Value = abi.ConvertType(out[2], new(big.Int)).(*big.Int)
+	outstruct.Description = *abi.ConvertType(out[3], new(string)).(*string)
+	outstruct.Status = *abi.ConvertType(out[4], new(uint8)).(*uint8)
+	outstruct.VotingEnd = abi.ConvertType(out[5], new(big.Int)).(*big.Int)
+	outstruct.ExecutionBlock = abi.ConvertType(out[6], new(big.Int)).(*big.Int)
+	outstruct.YesVotes = abi.ConvertType(out[7], new(big.Int)).(*big.Int)
+	outstruct.NoVotes = abi.ConvertType(out[8], new(big.Int)).(*big.Int)
+	return *outstruct, nil
+}
+
+// PackHasVoted is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x43859632.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function hasVoted(uint256 id, address voter) view returns(bool voted)
+func (governance *Governance) PackHasVoted(id *big.Int, voter common.Address) []byte {
+	enc, err := governance.abi.Pack("hasVoted", id, voter)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackHasVoted is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x43859632.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function hasVoted(uint256 id, address voter) view returns(bool voted)
+func (governance *Governance) TryPackHasVoted(id *big.Int, voter common.Address) ([]byte, error) {
+	return governance.abi.Pack("hasVoted", id, voter)
+}
+
+// UnpackHasVoted is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x43859632.
+//
+// Solidity: function hasVoted(uint256 id, address voter) view returns(bool voted)
+func (governance *Governance) UnpackHasVoted(data []byte) (bool, error) {
+	out, err := governance.abi.Unpack("hasVoted", data)
+	if err != nil {
+		return *new(bool), err
+	}
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+	return out0, nil
+}
+
+// PackProposalCount is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xda35c664.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function proposalCount() view returns(uint256 count)
+func (governance *Governance) PackProposalCount() []byte {
+	enc, err := governance.abi.Pack("proposalCount")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackProposalCount is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xda35c664.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function proposalCount() view returns(uint256 count)
+func (governance *Governance) TryPackProposalCount() ([]byte, error) {
+	return governance.abi.Pack("proposalCount")
+}
+
+// UnpackProposalCount is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xda35c664.
+//
+// Solidity: function proposalCount() view returns(uint256 count)
+func (governance *Governance) UnpackProposalCount(data []byte) (*big.Int, error) {
+	out, err := governance.abi.Unpack("proposalCount", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackPropose is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc75119c1.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function propose(uint8 param, uint256 value, string description) returns(uint256 id)
+func (governance *Governance) PackPropose(param uint8, value *big.Int, description string) []byte {
+	enc, err := governance.abi.Pack("propose", param, value, description)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackPropose is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc75119c1.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function propose(uint8 param, uint256 value, string description) returns(uint256 id)
+func (governance *Governance) TryPackPropose(param uint8, value *big.Int, description string) ([]byte, error) {
+	return governance.abi.Pack("propose", param, value, description)
+}
+
+// UnpackPropose is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xc75119c1.
+//
+// Solidity: function propose(uint8 param, uint256 value, string description) returns(uint256 id)
+func (governance *Governance) UnpackPropose(data []byte) (*big.Int, error) {
+	out, err := governance.abi.Unpack("propose", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackVote is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc9d27afe.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function vote(uint256 id, bool support) returns()
+func (governance *Governance) PackVote(id *big.Int, support bool) []byte {
+	enc, err := governance.abi.Pack("vote", id, support)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackVote is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc9d27afe.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function vote(uint256 id, bool support) returns()
+func (governance *Governance) TryPackVote(id *big.Int, support bool) ([]byte, error) {
+	return governance.abi.Pack("vote", id, support)
+}
+
+// PackVotingPower is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc07473f6.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function votingPower(address voter) view returns(uint256 power)
+func (governance *Governance) PackVotingPower(voter common.Address) []byte {
+	enc, err := governance.abi.Pack("votingPower", voter)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackVotingPower is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc07473f6.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function votingPower(address voter) view returns(uint256 power)
+func (governance *Governance) TryPackVotingPower(voter common.Address) ([]byte, error) {
+	return governance.abi.Pack("votingPower", voter)
+}
+
+// UnpackVotingPower is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xc07473f6.
+//
+// Solidity: function votingPower(address voter) view returns(uint256 power)
+func (governance *Governance) UnpackVotingPower(data []byte) (*big.Int, error) {
+	out, err := governance.abi.Unpack("votingPower", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// GovernanceProposalCreated represents a ProposalCreated event raised by the Governance contract.
+type GovernanceProposalCreated struct {
+	Id        *big.Int
+	Proposer  common.Address
+	Param     uint8
+	Value     *big.Int
+	VotingEnd *big.Int
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const GovernanceProposalCreatedEventName = "ProposalCreated"
+
+// ContractEventName returns the user-defined event name.
+func (GovernanceProposalCreated) ContractEventName() string {
+	return GovernanceProposalCreatedEventName
+}
+
+// UnpackProposalCreatedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event ProposalCreated(uint256 indexed id, address indexed proposer, uint8 param, uint256 value, uint256 votingEnd)
+func (governance *Governance) UnpackProposalCreatedEvent(log *types.Log) (*GovernanceProposalCreated, error) {
+	event := "ProposalCreated"
+	if len(log.Topics) == 0 || log.Topics[0] != governance.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(GovernanceProposalCreated)
+	if len(log.Data) > 0 {
+		if err := governance.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range governance.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// GovernanceVoted represents a Voted event raised by the Governance contract.
+type GovernanceVoted struct {
+	Id      *big.Int
+	Voter   common.Address
+	Support bool
+	Weight  *big.Int
+	Raw     *types.Log // Blockchain specific contextual infos
+}
+
+const GovernanceVotedEventName = "Voted"
+
+// ContractEventName returns the user-defined event name.
+func (GovernanceVoted) ContractEventName() string {
+	return GovernanceVotedEventName
+}
+
+// UnpackVotedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Voted(uint256 indexed id, address indexed voter, bool support, uint256 weight)
+func (governance *Governance) UnpackVotedEvent(log *types.Log) (*GovernanceVoted, error) {
+	event := "Voted"
+	if len(log.Topics) == 0 || log.Topics[0] != governance.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(GovernanceVoted)
+	if len(log.Data) > 0 {
+		if err := governance.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range governance.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
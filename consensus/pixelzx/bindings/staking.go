@@ -0,0 +1,841 @@
+// Code generated via abigen V2 - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package bindings
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = bytes.Equal
+	_ = errors.New
+	_ = big.NewInt
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = abi.ConvertType
+)
+
+// StakingMetaData contains all meta data concerning the Staking contract.
+var StakingMetaData = bind.MetaData{
+	ABI: "[{\"type\":\"function\",\"name\":\"registerValidator\",\"inputs\":[{\"name\":\"commission\",\"type\":\"uint256\"},{\"name\":\"moniker\",\"type\":\"string\"},{\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"delegate\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"undelegate\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"withdraw\",\"inputs\":[],\"outputs\":[{\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setCommission\",\"inputs\":[{\"name\":\"commission\",\"type\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"claimRewards\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"unjail\",\"inputs\":[],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"rotateConsensusKey\",\"inputs\":[{\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getValidators\",\"inputs\":[],\"outputs\":[{\"name\":\"validators\",\"type\":\"address[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getValidator\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"selfStake\",\"type\":\"uint256\"},{\"name\":\"totalStake\",\"type\":\"uint256\"},{\"name\":\"commission\",\"type\":\"uint256\"},{\"name\":\"moniker\",\"type\":\"string\"},{\"name\":\"consensusKey\",\"type\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDelegation\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\"},{\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getUnbonding\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"validators\",\"type\":\"address[]\"},{\"name\":\"amounts\",\"type\":\"uint256[]\"},{\"name\":\"completions\",\"type\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pendingRewards\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\"},{\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"amount\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getJailStatus\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\"}],\"outputs\":[{\"name\":\"jailed\",\"type\":\"bool\"},{\"name\":\"tombstoned\",\"type\":\"bool\"},{\"name\":\"missedSlots\",\"type\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"ValidatorRegistered\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"stake\",\"type\":\"uint256\",\"indexed\":false},{\"name\":\"commission\",\"type\":\"uint256\",\"indexed\":false},{\"name\":\"moniker\",\"type\":\"string\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Delegated\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Undelegated\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false},{\"name\":\"completion\",\"type\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Withdrawn\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"CommissionUpdated\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"commission\",\"type\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"RewardsClaimed\",\"inputs\":[{\"name\":\"delegator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"Unjailed\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ConsensusKeyRotated\",\"inputs\":[{\"name\":\"validator\",\"type\":\"address\",\"indexed\":true},{\"name\":\"signer\",\"type\":\"address\",\"indexed\":false}],\"anonymous\":false}]",
+	ID:  "Staking",
+}
+
+// Staking is an auto generated Go binding around an Ethereum contract.
+type Staking struct {
+	abi abi.ABI
+}
+
+// NewStaking creates a new instance of Staking.
+func NewStaking() *Staking {
+	parsed, err := StakingMetaData.ParseABI()
+	if err != nil {
+		panic(errors.New("invalid ABI: " + err.Error()))
+	}
+	return &Staking{abi: *parsed}
+}
+
+// Instance creates a wrapper for a deployed contract instance at the given address.
+// Use this to create the instance object passed to abigen v2 library functions Call, Transact, etc.
+func (c *Staking) Instance(backend bind.ContractBackend, addr common.Address) *bind.BoundContract {
+	return bind.NewBoundContract(addr, c.abi, backend, backend, backend)
+}
+
+// PackClaimRewards is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xef5cfb8c.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function claimRewards(address validator) returns(uint256 amount)
+func (staking *Staking) PackClaimRewards(validator common.Address) []byte {
+	enc, err := staking.abi.Pack("claimRewards", validator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackClaimRewards is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xef5cfb8c.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function claimRewards(address validator) returns(uint256 amount)
+func (staking *Staking) TryPackClaimRewards(validator common.Address) ([]byte, error) {
+	return staking.abi.Pack("claimRewards", validator)
+}
+
+// UnpackClaimRewards is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xef5cfb8c.
+//
+// Solidity: function claimRewards(address validator) returns(uint256 amount)
+func (staking *Staking) UnpackClaimRewards(data []byte) (*big.Int, error) {
+	out, err := staking.abi.Unpack("claimRewards", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackDelegate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5c19a95c.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function delegate(address validator) payable returns()
+func (staking *Staking) PackDelegate(validator common.Address) []byte {
+	enc, err := staking.abi.Pack("delegate", validator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackDelegate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x5c19a95c.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function delegate(address validator) payable returns()
+func (staking *Staking) TryPackDelegate(validator common.Address) ([]byte, error) {
+	return staking.abi.Pack("delegate", validator)
+}
+
+// PackGetDelegation is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x15049a5a.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getDelegation(address delegator, address validator) view returns(uint256 amount)
+func (staking *Staking) PackGetDelegation(delegator common.Address, validator common.Address) []byte {
+	enc, err := staking.abi.Pack("getDelegation", delegator, validator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetDelegation is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x15049a5a.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getDelegation(address delegator, address validator) view returns(uint256 amount)
+func (staking *Staking) TryPackGetDelegation(delegator common.Address, validator common.Address) ([]byte, error) {
+	return staking.abi.Pack("getDelegation", delegator, validator)
+}
+
+// UnpackGetDelegation is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x15049a5a.
+//
+// Solidity: function getDelegation(address delegator, address validator) view returns(uint256 amount)
+func (staking *Staking) UnpackGetDelegation(data []byte) (*big.Int, error) {
+	out, err := staking.abi.Unpack("getDelegation", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackGetJailStatus is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x42de828f.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getJailStatus(address validator) view returns(bool jailed, bool tombstoned, uint256 missedSlots)
+func (staking *Staking) PackGetJailStatus(validator common.Address) []byte {
+	enc, err := staking.abi.Pack("getJailStatus", validator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetJailStatus is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x42de828f.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getJailStatus(address validator) view returns(bool jailed, bool tombstoned, uint256 missedSlots)
+func (staking *Staking) TryPackGetJailStatus(validator common.Address) ([]byte, error) {
+	return staking.abi.Pack("getJailStatus", validator)
+}
+
+// GetJailStatusOutput serves as a container for the return parameters of contract
+// method GetJailStatus.
+type GetJailStatusOutput struct {
+	Jailed      bool
+	Tombstoned  bool
+	MissedSlots *big.Int
+}
+
+// UnpackGetJailStatus is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x42de828f.
+//
+// Solidity: function getJailStatus(address validator) view returns(bool jailed, bool tombstoned, uint256 missedSlots)
+func (staking *Staking) UnpackGetJailStatus(data []byte) (GetJailStatusOutput, error) {
+	out, err := staking.abi.Unpack("getJailStatus", data)
+	outstruct := new(GetJailStatusOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.Jailed = *abi.ConvertType(out[0], new(bool)).(*bool)
+	outstruct.Tombstoned = *abi.ConvertType(out[1], new(bool)).(*bool)
+	outstruct.MissedSlots = abi.ConvertType(out[2], new(big.Int)).(*big.Int)
+	return *outstruct, nil
+}
+
+// PackGetUnbonding is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc25f6ded.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getUnbonding(address delegator) view returns(address[] validators, uint256[] amounts, uint256[] completions)
+func (staking *Staking) PackGetUnbonding(delegator common.Address) []byte {
+	enc, err := staking.abi.Pack("getUnbonding", delegator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetUnbonding is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xc25f6ded.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getUnbonding(address delegator) view returns(address[] validators, uint256[] amounts, uint256[] completions)
+func (staking *Staking) TryPackGetUnbonding(delegator common.Address) ([]byte, error) {
+	return staking.abi.Pack("getUnbonding", delegator)
+}
+
+// GetUnbondingOutput serves as a container for the return parameters of contract
+// method GetUnbonding.
+type GetUnbondingOutput struct {
+	Validators  []common.Address
+	Amounts     []*big.Int
+	Completions []*big.Int
+}
+
+// UnpackGetUnbonding is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xc25f6ded.
+//
+// Solidity: function getUnbonding(address delegator) view returns(address[] validators, uint256[] amounts, uint256[] completions)
+func (staking *Staking) UnpackGetUnbonding(data []byte) (GetUnbondingOutput, error) {
+	out, err := staking.abi.Unpack("getUnbonding", data)
+	outstruct := new(GetUnbondingOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.Validators = *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	outstruct.Amounts = *abi.ConvertType(out[1], new([]*big.Int)).(*[]*big.Int)
+	outstruct.Completions = *abi.ConvertType(out[2], new([]*big.Int)).(*[]*big.Int)
+	return *outstruct, nil
+}
+
+// PackGetValidator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x1904bb2e.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getValidator(address validator) view returns(uint256 selfStake, uint256 totalStake, uint256 commission, string moniker, bytes consensusKey)
+func (staking *Staking) PackGetValidator(validator common.Address) []byte {
+	enc, err := staking.abi.Pack("getValidator", validator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetValidator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x1904bb2e.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getValidator(address validator) view returns(uint256 selfStake, uint256 totalStake, uint256 commission, string moniker, bytes consensusKey)
+func (staking *Staking) TryPackGetValidator(validator common.Address) ([]byte, error) {
+	return staking.abi.Pack("getValidator", validator)
+}
+
+// GetValidatorOutput serves as a container for the return parameters of contract
+// method GetValidator.
+type GetValidatorOutput struct {
+	SelfStake    *big.Int
+	TotalStake   *big.Int
+	Commission   *big.Int
+	Moniker      string
+	ConsensusKey []byte
+}
+
+// UnpackGetValidator is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x1904bb2e.
+//
+// Solidity: function getValidator(address validator) view returns(uint256 selfStake, uint256 totalStake, uint256 commission, string moniker, bytes consensusKey)
+func (staking *Staking) UnpackGetValidator(data []byte) (GetValidatorOutput, error) {
+	out, err := staking.abi.Unpack("getValidator", data)
+	outstruct := new(GetValidatorOutput)
+	if err != nil {
+		return *outstruct, err
+	}
+	outstruct.SelfStake = abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	outstruct.TotalStake = abi.ConvertType(out[1], new(big.Int)).(*big.Int)
+	outstruct.Commission = abi.ConvertType(out[2], new(big.Int)).(*big.Int)
+	outstruct.Moniker = *abi.ConvertType(out[3], new(string)).(*string)
+	outstruct.ConsensusKey = *abi.ConvertType(out[4], new([]byte)).(*[]byte)
+	return *outstruct, nil
+}
+
+// PackGetValidators is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb7ab4db5.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function getValidators() view returns(address[] validators)
+func (staking *Staking) PackGetValidators() []byte {
+	enc, err := staking.abi.Pack("getValidators")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackGetValidators is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xb7ab4db5.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function getValidators() view returns(address[] validators)
+func (staking *Staking) TryPackGetValidators() ([]byte, error) {
+	return staking.abi.Pack("getValidators")
+}
+
+// UnpackGetValidators is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0xb7ab4db5.
+//
+// Solidity: function getValidators() view returns(address[] validators)
+func (staking *Staking) UnpackGetValidators(data []byte) ([]common.Address, error) {
+	out, err := staking.abi.Unpack("getValidators", data)
+	if err != nil {
+		return *new([]common.Address), err
+	}
+	out0 := *abi.ConvertType(out[0], new([]common.Address)).(*[]common.Address)
+	return out0, nil
+}
+
+// PackPendingRewards is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x80ac8228.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function pendingRewards(address delegator, address validator) view returns(uint256 amount)
+func (staking *Staking) PackPendingRewards(delegator common.Address, validator common.Address) []byte {
+	enc, err := staking.abi.Pack("pendingRewards", delegator, validator)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackPendingRewards is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x80ac8228.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function pendingRewards(address delegator, address validator) view returns(uint256 amount)
+func (staking *Staking) TryPackPendingRewards(delegator common.Address, validator common.Address) ([]byte, error) {
+	return staking.abi.Pack("pendingRewards", delegator, validator)
+}
+
+// UnpackPendingRewards is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x80ac8228.
+//
+// Solidity: function pendingRewards(address delegator, address validator) view returns(uint256 amount)
+func (staking *Staking) UnpackPendingRewards(data []byte) (*big.Int, error) {
+	out, err := staking.abi.Unpack("pendingRewards", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// PackRegisterValidator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x2d316002.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function registerValidator(uint256 commission, string moniker, bytes consensusKey) payable returns()
+func (staking *Staking) PackRegisterValidator(commission *big.Int, moniker string, consensusKey []byte) []byte {
+	enc, err := staking.abi.Pack("registerValidator", commission, moniker, consensusKey)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRegisterValidator is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x2d316002.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function registerValidator(uint256 commission, string moniker, bytes consensusKey) payable returns()
+func (staking *Staking) TryPackRegisterValidator(commission *big.Int, moniker string, consensusKey []byte) ([]byte, error) {
+	return staking.abi.Pack("registerValidator", commission, moniker, consensusKey)
+}
+
+// PackRotateConsensusKey is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd8177783.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function rotateConsensusKey(bytes consensusKey) returns()
+func (staking *Staking) PackRotateConsensusKey(consensusKey []byte) []byte {
+	enc, err := staking.abi.Pack("rotateConsensusKey", consensusKey)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackRotateConsensusKey is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xd8177783.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function rotateConsensusKey(bytes consensusKey) returns()
+func (staking *Staking) TryPackRotateConsensusKey(consensusKey []byte) ([]byte, error) {
+	return staking.abi.Pack("rotateConsensusKey", consensusKey)
+}
+
+// PackSetCommission is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x355e6b43.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function setCommission(uint256 commission) returns()
+func (staking *Staking) PackSetCommission(commission *big.Int) []byte {
+	enc, err := staking.abi.Pack("setCommission", commission)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackSetCommission is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x355e6b43.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function setCommission(uint256 commission) returns()
+func (staking *Staking) TryPackSetCommission(commission *big.Int) ([]byte, error) {
+	return staking.abi.Pack("setCommission", commission)
+}
+
+// PackUndelegate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x4d99dd16.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function undelegate(address validator, uint256 amount) returns()
+func (staking *Staking) PackUndelegate(validator common.Address, amount *big.Int) []byte {
+	enc, err := staking.abi.Pack("undelegate", validator, amount)
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackUndelegate is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x4d99dd16.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function undelegate(address validator, uint256 amount) returns()
+func (staking *Staking) TryPackUndelegate(validator common.Address, amount *big.Int) ([]byte, error) {
+	return staking.abi.Pack("undelegate", validator, amount)
+}
+
+// PackUnjail is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf679d305.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function unjail() returns()
+func (staking *Staking) PackUnjail() []byte {
+	enc, err := staking.abi.Pack("unjail")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackUnjail is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0xf679d305.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function unjail() returns()
+func (staking *Staking) TryPackUnjail() ([]byte, error) {
+	return staking.abi.Pack("unjail")
+}
+
+// PackWithdraw is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3ccfd60b.  This method will panic if any
+// invalid/nil inputs are passed.
+//
+// Solidity: function withdraw() returns(uint256 amount)
+func (staking *Staking) PackWithdraw() []byte {
+	enc, err := staking.abi.Pack("withdraw")
+	if err != nil {
+		panic(err)
+	}
+	return enc
+}
+
+// TryPackWithdraw is the Go binding used to pack the parameters required for calling
+// the contract method with ID 0x3ccfd60b.  This method will return an error
+// if any inputs are invalid/nil.
+//
+// Solidity: function withdraw() returns(uint256 amount)
+func (staking *Staking) TryPackWithdraw() ([]byte, error) {
+	return staking.abi.Pack("withdraw")
+}
+
+// UnpackWithdraw is the Go binding that unpacks the parameters returned
+// from invoking the contract method with ID 0x3ccfd60b.
+//
+// Solidity: function withdraw() returns(uint256 amount)
+func (staking *Staking) UnpackWithdraw(data []byte) (*big.Int, error) {
+	out, err := staking.abi.Unpack("withdraw", data)
+	if err != nil {
+		return new(big.Int), err
+	}
+	out0 := abi.ConvertType(out[0], new(big.Int)).(*big.Int)
+	return out0, nil
+}
+
+// StakingCommissionUpdated represents a CommissionUpdated event raised by the Staking contract.
+type StakingCommissionUpdated struct {
+	Validator  common.Address
+	Commission *big.Int
+	Raw        *types.Log // Blockchain specific contextual infos
+}
+
+const StakingCommissionUpdatedEventName = "CommissionUpdated"
+
+// ContractEventName returns the user-defined event name.
+func (StakingCommissionUpdated) ContractEventName() string {
+	return StakingCommissionUpdatedEventName
+}
+
+// UnpackCommissionUpdatedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event CommissionUpdated(address indexed validator, uint256 commission)
+func (staking *Staking) UnpackCommissionUpdatedEvent(log *types.Log) (*StakingCommissionUpdated, error) {
+	event := "CommissionUpdated"
+	if len(log.Topics) == 0 || log.Topics[0] != staking.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(StakingCommissionUpdated)
+	if len(log.Data) > 0 {
+		if err := staking.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range staking.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// StakingConsensusKeyRotated represents a ConsensusKeyRotated event raised by the Staking contract.
+type StakingConsensusKeyRotated struct {
+	Validator common.Address
+	Signer    common.Address
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const StakingConsensusKeyRotatedEventName = "ConsensusKeyRotated"
+
+// ContractEventName returns the user-defined event name.
+func (StakingConsensusKeyRotated) ContractEventName() string {
+	return StakingConsensusKeyRotatedEventName
+}
+
+// UnpackConsensusKeyRotatedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event ConsensusKeyRotated(address indexed validator, address signer)
+func (staking *Staking) UnpackConsensusKeyRotatedEvent(log *types.Log) (*StakingConsensusKeyRotated, error) {
+	event := "ConsensusKeyRotated"
+	if len(log.Topics) == 0 || log.Topics[0] != staking.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(StakingConsensusKeyRotated)
+	if len(log.Data) > 0 {
+		if err := staking.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range staking.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// StakingDelegated represents a Delegated event raised by the Staking contract.
+type StakingDelegated struct {
+	Delegator common.Address
+	Validator common.Address
+	Amount    *big.Int
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const StakingDelegatedEventName = "Delegated"
+
+// ContractEventName returns the user-defined event name.
+func (StakingDelegated) ContractEventName() string {
+	return StakingDelegatedEventName
+}
+
+// UnpackDelegatedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Delegated(address indexed delegator, address indexed validator, uint256 amount)
+func (staking *Staking) UnpackDelegatedEvent(log *types.Log) (*StakingDelegated, error) {
+	event := "Delegated"
+	if len(log.Topics) == 0 || log.Topics[0] != staking.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(StakingDelegated)
+	if len(log.Data) > 0 {
+		if err := staking.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range staking.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// StakingRewardsClaimed represents a RewardsClaimed event raised by the Staking contract.
+type StakingRewardsClaimed struct {
+	Delegator common.Address
+	Validator common.Address
+	Amount    *big.Int
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const StakingRewardsClaimedEventName = "RewardsClaimed"
+
+// ContractEventName returns the user-defined event name.
+func (StakingRewardsClaimed) ContractEventName() string {
+	return StakingRewardsClaimedEventName
+}
+
+// UnpackRewardsClaimedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event RewardsClaimed(address indexed delegator, address indexed validator, uint256 amount)
+func (staking *Staking) UnpackRewardsClaimedEvent(log *types.Log) (*StakingRewardsClaimed, error) {
+	event := "RewardsClaimed"
+	if len(log.Topics) == 0 || log.Topics[0] != staking.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(StakingRewardsClaimed)
+	if len(log.Data) > 0 {
+		if err := staking.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range staking.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// StakingUndelegated represents a Undelegated event raised by the Staking contract.
+type StakingUndelegated struct {
+	Delegator  common.Address
+	Validator  common.Address
+	Amount     *big.Int
+	Completion *big.Int
+	Raw        *types.Log // Blockchain specific contextual infos
+}
+
+const StakingUndelegatedEventName = "Undelegated"
+
+// ContractEventName returns the user-defined event name.
+func (StakingUndelegated) ContractEventName() string {
+	return StakingUndelegatedEventName
+}
+
+// UnpackUndelegatedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Undelegated(address indexed delegator, address indexed validator, uint256 amount, uint256 completion)
+func (staking *Staking) UnpackUndelegatedEvent(log *types.Log) (*StakingUndelegated, error) {
+	event := "Undelegated"
+	if len(log.Topics) == 0 || log.Topics[0] != staking.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(StakingUndelegated)
+	if len(log.Data) > 0 {
+		if err := staking.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range staking.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// StakingUnjailed represents a Unjailed event raised by the Staking contract.
+type StakingUnjailed struct {
+	Validator common.Address
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const StakingUnjailedEventName = "Unjailed"
+
+// ContractEventName returns the user-defined event name.
+func (StakingUnjailed) ContractEventName() string {
+	return StakingUnjailedEventName
+}
+
+// UnpackUnjailedEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Unjailed(address indexed validator)
+func (staking *Staking) UnpackUnjailedEvent(log *types.Log) (*StakingUnjailed, error) {
+	event := "Unjailed"
+	if len(log.Topics) == 0 || log.Topics[0] != staking.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(StakingUnjailed)
+	if len(log.Data) > 0 {
+		if err := staking.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range staking.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// StakingValidatorRegistered represents a ValidatorRegistered event raised by the Staking contract.
+type StakingValidatorRegistered struct {
+	Validator  common.Address
+	Stake      *big.Int
+	Commission *big.Int
+	Moniker    string
+	Raw        *types.Log // Blockchain specific contextual infos
+}
+
+const StakingValidatorRegisteredEventName = "ValidatorRegistered"
+
+// ContractEventName returns the user-defined event name.
+func (StakingValidatorRegistered) ContractEventName() string {
+	return StakingValidatorRegisteredEventName
+}
+
+// UnpackValidatorRegisteredEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event ValidatorRegistered(address indexed validator, uint256 stake, uint256 commission, string moniker)
+func (staking *Staking) UnpackValidatorRegisteredEvent(log *types.Log) (*StakingValidatorRegistered, error) {
+	event := "ValidatorRegistered"
+	if len(log.Topics) == 0 || log.Topics[0] != staking.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(StakingValidatorRegistered)
+	if len(log.Data) > 0 {
+		if err := staking.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range staking.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
+
+// StakingWithdrawn represents a Withdrawn event raised by the Staking contract.
+type StakingWithdrawn struct {
+	Delegator common.Address
+	Amount    *big.Int
+	Raw       *types.Log // Blockchain specific contextual infos
+}
+
+const StakingWithdrawnEventName = "Withdrawn"
+
+// ContractEventName returns the user-defined event name.
+func (StakingWithdrawn) ContractEventName() string {
+	return StakingWithdrawnEventName
+}
+
+// UnpackWithdrawnEvent is the Go binding that unpacks the event data emitted
+// by contract.
+//
+// Solidity: event Withdrawn(address indexed delegator, uint256 amount)
+func (staking *Staking) UnpackWithdrawnEvent(log *types.Log) (*StakingWithdrawn, error) {
+	event := "Withdrawn"
+	if len(log.Topics) == 0 || log.Topics[0] != staking.abi.Events[event].ID {
+		return nil, errors.New("event signature mismatch")
+	}
+	out := new(StakingWithdrawn)
+	if len(log.Data) > 0 {
+		if err := staking.abi.UnpackIntoInterface(out, event, log.Data); err != nil {
+			return nil, err
+		}
+	}
+	var indexed abi.Arguments
+	for _, arg := range staking.abi.Events[event].Inputs {
+		if arg.Indexed {
+			indexed = append(indexed, arg)
+		}
+	}
+	if err := abi.ParseTopics(out, indexed, log.Topics[1:]); err != nil {
+		return nil, err
+	}
+	out.Raw = log
+	return out, nil
+}
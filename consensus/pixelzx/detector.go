@@ -0,0 +1,154 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+const (
+	sideChanSize     = 64   // Size of the channel queueing side chain headers to check
+	evidenceChanSize = 16   // Size of the channel listening to evidence detected by the engine
+	inmemoryReports  = 1024 // Number of recently reported evidence to keep in memory
+)
+
+// DetectorChain is the subset of the blockchain the double sign detector needs
+// to look up conflicting blocks.
+type DetectorChain interface {
+	consensus.ChainHeaderReader
+}
+
+// Detector watches the blocks imported by the node for validators sealing more
+// than one block at the same height on the same parent, submitting the evidence
+// of such double signing to the chain for the validators to be slashed.
+//
+// Conflicting seals are caught from two sources: the headers verified by the
+// engine, and the side chain blocks stored by the blockchain, which the node
+// passes on to CheckSide to be compared against all other blocks known at their
+// height.
+type Detector struct {
+	engine *Pixelzx
+	chain  DetectorChain
+	submit func(evidence *DoubleSignEvidence) error
+
+	reported *lru.Cache[common.Hash, struct{}] // Evidence already submitted, to avoid duplicates
+	sideCh   chan *types.Header                // Side chain headers waiting to be checked
+
+	quit chan struct{}
+	wg   sync.WaitGroup
+}
+
+// NewDetector creates a double sign detector, submitting the evidence it finds
+// through the given callback.
+func NewDetector(engine *Pixelzx, chain DetectorChain, submit func(evidence *DoubleSignEvidence) error) *Detector {
+	return &Detector{
+		engine:   engine,
+		chain:    chain,
+		submit:   submit,
+		reported: lru.NewCache[common.Hash, struct{}](inmemoryReports),
+		sideCh:   make(chan *types.Header, sideChanSize),
+		quit:     make(chan struct{}),
+	}
+}
+
+// Start launches the detector's event loop.
+func (d *Detector) Start() {
+	var (
+		evidenceCh = make(chan *DoubleSignEvidence, evidenceChanSize)
+		engineSub  = d.engine.SubscribeEvidence(evidenceCh)
+	)
+	d.wg.Add(1)
+	go func() {
+		defer d.wg.Done()
+		defer engineSub.Unsubscribe()
+
+		for {
+			select {
+			case header := <-d.sideCh:
+				d.checkSide(header)
+			case evidence := <-evidenceCh:
+				d.report(evidence)
+			case <-engineSub.Err():
+				return
+			case <-d.quit:
+				return
+			}
+		}
+	}()
+}
+
+// Stop terminates the detector's event loop.
+func (d *Detector) Stop() {
+	close(d.quit)
+	d.wg.Wait()
+}
+
+// CheckSide queues a block dropped from, or not making it into, the canonical
+// chain to be compared with the other blocks at its height.
+func (d *Detector) CheckSide(header *types.Header) {
+	select {
+	case d.sideCh <- header:
+	case <-d.quit:
+	}
+}
+
+// checkSide compares a side chain header with all other blocks stored at its
+// height, reporting any of them sealed by the same validator on the same parent.
+func (d *Detector) checkSide(header *types.Header) {
+	signer, err := d.engine.Author(header)
+	if err != nil {
+		return
+	}
+	number := header.Number.Uint64()
+	for _, hash := range rawdb.ReadAllHashes(d.engine.db, number) {
+		if hash == header.Hash() {
+			continue
+		}
+		other := d.chain.GetHeader(hash, number)
+		if other == nil || other.ParentHash != header.ParentHash {
+			continue
+		}
+		if author, err := d.engine.Author(other); err != nil || author != signer {
+			continue
+		}
+		if SealHash(other) != SealHash(header) {
+			d.report(NewDoubleSignEvidence(header, other))
+		}
+	}
+}
+
+// report submits a double sign evidence, unless it was submitted already.
+func (d *Detector) report(evidence *DoubleSignEvidence) {
+	hash := evidence.Hash()
+	if d.reported.Contains(hash) {
+		return
+	}
+	d.reported.Add(hash, struct{}{})
+
+	if err := d.submit(evidence); err != nil {
+		log.Warn("Failed to submit double sign evidence", "number", evidence.Number(), "hash", hash, "err", err)
+		return
+	}
+	log.Info("Submitted double sign evidence", "number", evidence.Number(), "hash", hash)
+}
@@ -0,0 +1,49 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// processEpoch runs the state transitions due at the end of every epoch, right
+// before the validator set of the next epoch is elected from the staking
+// contract. Any change done here is reflected in the elected set.
+func (p *Pixelzx) processEpoch(header *types.Header, state vm.StateDB) {
+	log.Debug("Processing epoch transition", "number", header.Number, "epoch", header.Number.Uint64()/p.config.Epoch)
+
+	p.jailOffline(state)
+	p.releaseUnbondings(header, state)
+	p.processGovernance(header, state)
+}
+
+// releaseUnbondings pays the unbonding stake matured by the epoch block back to
+// the delegators. Until then the stake stays in the staking contract, where it
+// may still be slashed for double signing committed before it was undelegated.
+func (p *Pixelzx) releaseUnbondings(header *types.Header, state vm.StateDB) {
+	for _, release := range staking.ReleaseUnbondings(state, header.Number.Uint64()) {
+		state.SubBalance(params.PixelzxStakingAddress, release.Amount, tracing.BalanceDecreasePixelzxUnbonding)
+		state.AddBalance(release.Delegator, release.Amount, tracing.BalanceIncreasePixelzxUnbonding)
+
+		log.Debug("Released matured unbonding", "delegator", release.Delegator, "amount", release.Amount)
+	}
+}
@@ -0,0 +1,102 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Tests that undelegated stake stays locked in the staking contract during the
+// unbonding period, and is paid back at the first epoch block after maturity.
+func TestUnbondingRelease(t *testing.T) {
+	b := newTestBackend(t, 1, 1)
+
+	var (
+		delegator = crypto.PubkeyToAddress(b.keys[0].PublicKey)
+		validator = crypto.PubkeyToAddress(b.keys[1].PublicKey)
+		api       = NewAPI(b.engine, b.chain)
+		parent    = b.chain.Genesis()
+		period    = b.genesis.Config.Pixelzx.UnbondingPeriod
+		epoch     = b.genesis.Config.Pixelzx.Epoch
+	)
+	advance := func(txs ...*types.Transaction) {
+		block := b.makeBlockWithTxs(parent, b.proposer(parent), 0, txs, nil)
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+		for i, receipt := range b.chain.GetReceiptsByHash(block.Hash()) {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				t.Fatalf("block %d, tx %d: staking call failed", block.NumberU64(), i)
+			}
+		}
+		parent = block
+	}
+	balances := func() (*big.Int, *big.Int) {
+		statedb, err := b.chain.StateAt(parent.Root())
+		if err != nil {
+			t.Fatalf("failed to retrieve state: %v", err)
+		}
+		return statedb.GetBalance(delegator).ToBig(), statedb.GetBalance(params.PixelzxStakingAddress).ToBig()
+	}
+	advance(b.stakingTx(b.keys[0], 0, pzx(5), "delegate", validator))
+	advance(b.stakingTx(b.keys[0], 1, new(big.Int), "undelegate", validator, pzx(5)))
+
+	completion := parent.NumberU64() + period
+	release := (completion + epoch - 1) / epoch * epoch
+
+	for parent.NumberU64() < release-1 {
+		advance()
+
+		number := rpc.BlockNumber(parent.NumberU64())
+		unbondings, err := api.GetUnbondings(delegator, &number)
+		if err != nil {
+			t.Fatalf("block %d: failed to retrieve unbondings: %v", parent.NumberU64(), err)
+		}
+		if len(unbondings) != 1 {
+			t.Fatalf("block %d: unbonding count mismatch: have %d, want 1", parent.NumberU64(), len(unbondings))
+		}
+		if unbondings[0].Validator != validator || unbondings[0].Amount.ToInt().Cmp(pzx(5)) != 0 || unbondings[0].Completion != completion {
+			t.Fatalf("block %d: unbonding mismatch: have %+v", parent.NumberU64(), unbondings[0])
+		}
+		if matured := parent.NumberU64() >= completion; unbondings[0].Matured != matured {
+			t.Fatalf("block %d: maturity mismatch: have %v, want %v", parent.NumberU64(), unbondings[0].Matured, matured)
+		}
+	}
+	delegatorBefore, contractBefore := balances()
+	advance()
+	delegatorAfter, contractAfter := balances()
+
+	if diff := new(big.Int).Sub(delegatorAfter, delegatorBefore); diff.Cmp(pzx(5)) != 0 {
+		t.Fatalf("released amount mismatch: have %v, want %v", diff, pzx(5))
+	}
+	if diff := new(big.Int).Sub(contractBefore, contractAfter); diff.Cmp(pzx(5)) != 0 {
+		t.Fatalf("contract balance decrease mismatch: have %v, want %v", diff, pzx(5))
+	}
+	unbondings, err := api.GetUnbondings(delegator, nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve unbondings: %v", err)
+	}
+	if len(unbondings) != 0 {
+		t.Fatalf("released unbonding still pending: %+v", unbondings)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/holiman/uint256"
+)
+
+var (
+	// errEvidenceHeight is returned if the headers of a double sign evidence are
+	// not at the same height.
+	errEvidenceHeight = errors.New("evidence headers at different heights")
+
+	// errEvidenceParents is returned if the headers of a double sign evidence
+	// extend different parents, which validators may honestly seal after a reorg.
+	errEvidenceParents = errors.New("evidence headers on different parents")
+
+	// errEvidenceNotConflicting is returned if the headers of a double sign
+	// evidence seal the same content.
+	errEvidenceNotConflicting = errors.New("evidence headers not conflicting")
+
+	// errEvidenceSigners is returned if the headers of a double sign evidence are
+	// sealed by different validators.
+	errEvidenceSigners = errors.New("evidence headers sealed by different validators")
+
+	// errEvidenceFuture is returned if a double sign evidence is included into a
+	// block not above the evidence headers.
+	errEvidenceFuture = errors.New("evidence from the future")
+
+	// errEvidenceFork is returned if the headers of a double sign evidence don't
+	// extend the chain of the block including it. Whether another chain is known
+	// differs between nodes, so such evidence can't be punished consistently.
+	errEvidenceFork = errors.New("evidence headers not on the chain of the block")
+
+	// errEvidenceExpired is returned if a double sign evidence is older than the
+	// unbonding period, the stake it could slash may have been withdrawn since.
+	errEvidenceExpired = errors.New("evidence outside the slashable window")
+)
+
+// DoubleSignEvidence proves that a validator misbehaved by sealing two different
+// headers at the same height on the same parent, forking the chain. Sealing at a
+// height again on another parent is not double signing: validators do so when a
+// reorg moves the chain onto a branch they have a turn on. Any node may submit
+// the evidence to the chain in a system
+// transaction sent to params.PixelzxSlashingAddress, with the RLP encoding of the
+// evidence as payload, upon which the validator is slashed and jailed.
+type DoubleSignEvidence struct {
+	First  *types.Header // Conflicting header with the lower seal hash
+	Second *types.Header // Conflicting header with the higher seal hash
+}
+
+// NewDoubleSignEvidence creates the evidence of two conflicting headers. They are
+// ordered by seal hash, so the same pair always results in the same evidence.
+func NewDoubleSignEvidence(a, b *types.Header) *DoubleSignEvidence {
+	if SealHash(a).Cmp(SealHash(b)) > 0 {
+		a, b = b, a
+	}
+	return &DoubleSignEvidence{First: types.CopyHeader(a), Second: types.CopyHeader(b)}
+}
+
+// Number returns the height the validator double signed at.
+func (e *DoubleSignEvidence) Number() uint64 {
+	return e.First.Number.Uint64()
+}
+
+// Hash returns the unique identifier of the evidence.
+func (e *DoubleSignEvidence) Hash() common.Hash {
+	return crypto.Keccak256Hash(e.First.Hash().Bytes(), e.Second.Hash().Bytes())
+}
+
+// conflicting checks that the headers of the evidence were sealed by the same
+// validator at the same height on the same parent, but with different content,
+// returning the offending validator.
+func (e *DoubleSignEvidence) conflicting(sigcache *sigLRU) (common.Address, error) {
+	if e.First == nil || e.Second == nil || e.First.Number == nil || e.Second.Number == nil {
+		return common.Address{}, errUnknownBlock
+	}
+	if e.First.Number.Cmp(e.Second.Number) != 0 || !e.First.Number.IsUint64() || e.First.Number.Sign() == 0 {
+		return common.Address{}, errEvidenceHeight
+	}
+	if e.First.ParentHash != e.Second.ParentHash {
+		return common.Address{}, errEvidenceParents
+	}
+	if len(e.First.Extra) < extraVanity+extraSeal || len(e.Second.Extra) < extraVanity+extraSeal {
+		return common.Address{}, errMissingSignature
+	}
+	if SealHash(e.First) == SealHash(e.Second) {
+		return common.Address{}, errEvidenceNotConflicting
+	}
+	first, err := ecrecover(e.First, sigcache)
+	if err != nil {
+		return common.Address{}, err
+	}
+	second, err := ecrecover(e.Second, sigcache)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if first != second {
+		return common.Address{}, errEvidenceSigners
+	}
+	return first, nil
+}
+
+// VerifyEvidence checks whether a double sign evidence may be punished in the
+// block with the given header, returning the offending validator. The headers
+// must be in conflict, within the slashable window, extend the chain of the
+// block and be signed by a validator that was active at their height on it. The
+// outcome only depends on the chain of the block, the same on all nodes.
+func (p *Pixelzx) VerifyEvidence(chain consensus.ChainHeaderReader, header *types.Header, evidence *DoubleSignEvidence) (common.Address, error) {
+	signer, err := evidence.conflicting(p.signatures)
+	if err != nil {
+		return common.Address{}, err
+	}
+	number, height := header.Number.Uint64(), evidence.Number()
+	if height >= number {
+		return common.Address{}, errEvidenceFuture
+	}
+	if p.config.UnbondingPeriod > 0 && number-height > p.config.UnbondingPeriod {
+		return common.Address{}, errEvidenceExpired
+	}
+	parent := ancestor(chain, header, height-1)
+	if parent == nil {
+		return common.Address{}, consensus.ErrUnknownAncestor
+	}
+	if parent.Hash() != evidence.First.ParentHash {
+		return common.Address{}, errEvidenceFork
+	}
+	snap, err := p.snapshot(chain, height-1, parent.Hash(), nil)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if _, ok := snap.Validators[signer]; !ok {
+		return common.Address{}, errUnauthorizedValidator
+	}
+	return signer, nil
+}
+
+// ancestor returns the header at the given height on the chain of the given one,
+// which may not be stored yet, or nil if the chain isn't known.
+func ancestor(chain consensus.ChainHeaderReader, header *types.Header, number uint64) *types.Header {
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return nil
+	}
+	// The canonical chain is indexed by number, avoid walking it
+	if canon := chain.GetHeaderByNumber(parent.Number.Uint64()); canon != nil && canon.Hash() == parent.Hash() {
+		return chain.GetHeaderByNumber(number)
+	}
+	for parent != nil && parent.Number.Uint64() > number {
+		parent = chain.GetHeader(parent.ParentHash, parent.Number.Uint64()-1)
+	}
+	return parent
+}
+
+// processEvidence punishes the double signing evidence carried by the system
+// transactions of a block. Invalid evidence is skipped: anybody may submit it,
+// so it can't render the block invalid.
+func (p *Pixelzx) processEvidence(chain consensus.ChainHeaderReader, header *types.Header, state vm.StateDB, txs []*types.Transaction) {
+	for _, tx := range txs {
+		if to := tx.To(); to == nil || *to != params.PixelzxSlashingAddress {
+			continue
+		}
+		evidence := new(DoubleSignEvidence)
+		if err := rlp.DecodeBytes(tx.Data(), evidence); err != nil {
+			log.Debug("Skipping undecodable double sign evidence", "tx", tx.Hash(), "err", err)
+			continue
+		}
+		signer, err := p.VerifyEvidence(chain, header, evidence)
+		if err != nil {
+			log.Debug("Skipping invalid double sign evidence", "tx", tx.Hash(), "err", err)
+			continue
+		}
+		validator := staking.ValidatorOf(state, signer)
+		if staking.DoubleSigned(state, validator, evidence.Number()) {
+			continue
+		}
+		slashed := staking.SlashDoubleSign(state, validator, evidence.Number(), p.config.DoubleSignSlash)
+		p.moveSlashedStake(state, slashed)
+
+		log.Warn("Slashed validator for double signing", "validator", validator, "signer", signer, "height", evidence.Number(), "slashed", slashed)
+	}
+}
+
+// moveSlashedStake takes slashed stake out of the staking contract, paying it
+// to the community treasury if one is configured, or burning it otherwise.
+func (p *Pixelzx) moveSlashedStake(state vm.StateDB, amount *uint256.Int) {
+	if amount.IsZero() {
+		return
+	}
+	if p.config.Treasury == nil {
+		state.SubBalance(params.PixelzxStakingAddress, amount, tracing.BalanceDecreasePixelzxSlashBurn)
+		return
+	}
+	state.SubBalance(params.PixelzxStakingAddress, amount, tracing.BalanceDecreasePixelzxSlash)
+	state.AddBalance(*p.config.Treasury, amount, tracing.BalanceIncreasePixelzxSlashedStake)
+}
+
+// sealKey identifies the seal of a validator at a given height on a parent.
+type sealKey struct {
+	number uint64
+	parent common.Hash
+	signer common.Address
+}
+
+// recordSeal remembers the header a validator sealed at its height on its parent,
+// reporting double sign evidence to the subscribers if it sealed a different one
+// there before. The evidence is delivered in the background, headers are verified
+// during block import which slow subscribers must never hold up.
+func (p *Pixelzx) recordSeal(header *types.Header, signer common.Address) {
+	key := sealKey{number: header.Number.Uint64(), parent: header.ParentHash, signer: signer}
+	prev, ok := p.seals.Get(key)
+	if !ok {
+		p.seals.Add(key, header)
+		return
+	}
+	if prev.Hash() == header.Hash() || SealHash(prev) == SealHash(header) {
+		return
+	}
+	log.Warn("Detected double signing validator", "validator", signer, "number", key.number, "first", prev.Hash(), "second", header.Hash())
+	go p.evidenceFeed.Send(NewDoubleSignEvidence(prev, header))
+}
+
+// SubscribeEvidence registers a subscription for the double sign evidence the
+// engine detects while verifying headers.
+func (p *Pixelzx) SubscribeEvidence(ch chan<- *DoubleSignEvidence) event.Subscription {
+	return p.scope.Track(p.evidenceFeed.Subscribe(ch))
+}
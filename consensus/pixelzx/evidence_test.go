@@ -0,0 +1,285 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// evidenceTx creates a system transaction submitting double sign evidence.
+func (b *testBackend) evidenceTx(key *ecdsa.PrivateKey, nonce uint64, evidence *DoubleSignEvidence) *types.Transaction {
+	data, err := rlp.EncodeToBytes(evidence)
+	if err != nil {
+		b.t.Fatalf("failed to encode evidence: %v", err)
+	}
+	return types.MustSignNewTx(key, types.LatestSigner(b.genesis.Config), &types.DynamicFeeTx{
+		ChainID:   b.genesis.Config.ChainID,
+		Nonce:     nonce,
+		Gas:       200_000,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+		To:        &params.PixelzxSlashingAddress,
+		Data:      data,
+	})
+}
+
+// makeConflict creates two different blocks on top of parent, both sealed by
+// the given key.
+func (b *testBackend) makeConflict(parent *types.Block, key *ecdsa.PrivateKey) (*types.Block, *types.Block) {
+	first := b.makeBlock(parent, key, 0, nil)
+	second := b.makeBlock(parent, key, 0, func(header *types.Header) {
+		header.Extra[0] = 0x01
+	})
+	return first, second
+}
+
+// Tests that evidence included in a block slashes and jails the double signing
+// validator exactly once, and that the validator is dropped at the next epoch.
+func TestDoubleSignSlashing(t *testing.T) {
+	b := newCustomTestBackend(t, func(config *params.PixelzxConfig) {
+		config.DoubleSignSlash = 1000
+	}, 1, 1, 1)
+
+	var (
+		genesis       = b.chain.Genesis()
+		offenderKey   = b.proposer(genesis)
+		offender      = crypto.PubkeyToAddress(offenderKey.PublicKey)
+		first, second = b.makeConflict(genesis, offenderKey)
+		evidence      = NewDoubleSignEvidence(first.Header(), second.Header())
+		reporter      = b.keys[0]
+	)
+	if reporter == offenderKey {
+		reporter = b.keys[1]
+	}
+	insert := func(block *types.Block) {
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+	}
+	insert(first)
+
+	// Submit the evidence twice, only the first one may slash
+	parent := first
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		block := b.makeBlockWithTxs(parent, b.proposer(parent), 0, []*types.Transaction{b.evidenceTx(reporter, nonce, evidence)}, nil)
+		insert(block)
+
+		statedb, err := b.chain.StateAt(block.Root())
+		if err != nil {
+			t.Fatalf("failed to retrieve state: %v", err)
+		}
+		info := staking.Validator(statedb, offender)
+		if !info.Jailed {
+			t.Fatalf("submission %d: validator not jailed", nonce)
+		}
+		if want := new(big.Int).Div(pzx(9), big.NewInt(10)); info.SelfStake.Cmp(want) != 0 {
+			t.Fatalf("submission %d: self stake mismatch: have %v, want %v", nonce, info.SelfStake, want)
+		}
+		if want := new(big.Int).Div(pzx(29), big.NewInt(10)); statedb.GetBalance(params.PixelzxStakingAddress).ToBig().Cmp(want) != 0 {
+			t.Fatalf("submission %d: slashed stake not burnt: have %v, want %v", nonce, statedb.GetBalance(params.PixelzxStakingAddress), want)
+		}
+		parent = block
+	}
+	// Advance to the next checkpoint, which must not include the offender
+	for parent.NumberU64() < b.genesis.Config.Pixelzx.Epoch {
+		block := b.makeBlock(parent, b.proposer(parent), 0, nil)
+		insert(block)
+		parent = block
+	}
+	validators, err := parseValidators(parent.Header())
+	if err != nil {
+		t.Fatalf("failed to parse checkpoint validators: %v", err)
+	}
+	if len(validators) != 2 {
+		t.Fatalf("validator count mismatch: have %d, want 2", len(validators))
+	}
+	for _, v := range validators {
+		if v.Address == offender {
+			t.Fatalf("jailed validator elected")
+		}
+	}
+}
+
+// Tests the rejection of invalid double sign evidence.
+func TestVerifyEvidence(t *testing.T) {
+	b := newTestBackend(t, 1, 1)
+
+	var (
+		genesis       = b.chain.Genesis()
+		first, second = b.makeConflict(genesis, b.keys[0])
+		middle        = b.makeBlock(first, b.keys[1], 0, nil)
+		other         = b.makeBlock(genesis, b.keys[1], 20, nil)
+		later         = b.makeBlock(first, b.keys[0], 0, nil)
+		reproposed    = b.makeBlock(other, b.keys[0], 0, nil)
+		outsider, _   = crypto.GenerateKey()
+		forged        = b.makeBlock(genesis, outsider, 0, nil)
+		forgedAlt     = b.makeBlock(genesis, outsider, 0, func(header *types.Header) { header.Extra[0] = 0x01 })
+		head          = &types.Header{Number: big.NewInt(2), ParentHash: first.Hash()}
+		next          = &types.Header{Number: big.NewInt(3), ParentHash: middle.Hash()}
+	)
+	if _, err := b.chain.InsertChain(types.Blocks{first, middle}); err != nil {
+		t.Fatalf("failed to insert blocks: %v", err)
+	}
+	// A conflict sealed on a fork the chain never imported, by a backup proposer
+	var (
+		forkFirst  = b.makeBlock(other, b.keys[0], 20, nil)
+		forkSecond = b.makeBlock(other, b.keys[0], 20, func(header *types.Header) { header.Extra[0] = 0x01 })
+	)
+
+	tests := []struct {
+		name     string
+		head     *types.Header
+		evidence *DoubleSignEvidence
+		err      error
+	}{
+		{"valid", head, NewDoubleSignEvidence(first.Header(), second.Header()), nil},
+		{"heights", head, &DoubleSignEvidence{First: first.Header(), Second: later.Header()}, errEvidenceHeight},
+		{"parents", next, NewDoubleSignEvidence(later.Header(), reproposed.Header()), errEvidenceParents},
+		{"identical", head, &DoubleSignEvidence{First: first.Header(), Second: first.Header()}, errEvidenceNotConflicting},
+		{"signers", head, NewDoubleSignEvidence(first.Header(), other.Header()), errEvidenceSigners},
+		{"future", &types.Header{Number: big.NewInt(1)}, NewDoubleSignEvidence(first.Header(), second.Header()), errEvidenceFuture},
+		{"expired", &types.Header{Number: big.NewInt(100)}, NewDoubleSignEvidence(first.Header(), second.Header()), errEvidenceExpired},
+		{"outsider", head, NewDoubleSignEvidence(forged.Header(), forgedAlt.Header()), errUnauthorizedValidator},
+		{"fork", next, NewDoubleSignEvidence(forkFirst.Header(), forkSecond.Header()), errEvidenceFork},
+		{"unknown", &types.Header{Number: big.NewInt(3), ParentHash: common.Hash{0x01}}, NewDoubleSignEvidence(first.Header(), second.Header()), consensus.ErrUnknownAncestor},
+	}
+	for _, tt := range tests {
+		signer, err := b.engine.VerifyEvidence(b.chain, tt.head, tt.evidence)
+		if !errors.Is(err, tt.err) {
+			t.Errorf("%s: error mismatch: have %v, want %v", tt.name, err, tt.err)
+		}
+		if err == nil && signer != crypto.PubkeyToAddress(b.keys[0].PublicKey) {
+			t.Errorf("%s: signer mismatch: have %x", tt.name, signer)
+		}
+	}
+	// Evidence valid on the chain of the including block is accepted on a side
+	// chain too, the fork evidence once the fork is the chain of the block
+	if _, err := b.chain.InsertChain(types.Blocks{other, forkFirst}); err != nil {
+		t.Fatalf("failed to insert fork: %v", err)
+	}
+	forkHead := &types.Header{Number: big.NewInt(3), ParentHash: forkFirst.Hash()}
+	if _, err := b.engine.VerifyEvidence(b.chain, forkHead, NewDoubleSignEvidence(forkFirst.Header(), forkSecond.Header())); err != nil {
+		t.Errorf("evidence on the chain of a side block rejected: %v", err)
+	}
+	if _, err := b.engine.VerifyEvidence(b.chain, next, NewDoubleSignEvidence(forkFirst.Header(), forkSecond.Header())); !errors.Is(err, errEvidenceFork) {
+		t.Errorf("evidence on an imported fork accepted: %v", err)
+	}
+}
+
+// Tests that the engine reports conflicting seals it verifies, but not seals at
+// the same height on different parents.
+func TestEngineDetectsDoubleSign(t *testing.T) {
+	b := newTestBackend(t, 1)
+
+	ch := make(chan *DoubleSignEvidence, 1)
+	sub := b.engine.SubscribeEvidence(ch)
+	defer sub.Unsubscribe()
+
+	first, second := b.makeConflict(b.chain.Genesis(), b.keys[0])
+	if _, err := b.chain.InsertChain(types.Blocks{first}); err != nil {
+		t.Fatalf("failed to insert first block: %v", err)
+	}
+	if _, err := b.chain.InsertChain(types.Blocks{second}); err != nil {
+		t.Fatalf("failed to insert second block: %v", err)
+	}
+	select {
+	case evidence := <-ch:
+		if want := NewDoubleSignEvidence(first.Header(), second.Header()).Hash(); evidence.Hash() != want {
+			t.Fatalf("evidence mismatch: have %x, want %x", evidence.Hash(), want)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("double sign not detected")
+	}
+	for _, parent := range []*types.Block{first, second} {
+		if _, err := b.chain.InsertChain(types.Blocks{b.makeBlock(parent, b.keys[0], 0, nil)}); err != nil {
+			t.Fatalf("failed to insert block on %x: %v", parent.Hash(), err)
+		}
+	}
+	select {
+	case evidence := <-ch:
+		t.Fatalf("seals on different parents reported: %x", evidence.Hash())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// Tests that the detector finds conflicting seals among the side chain blocks
+// and submits each evidence once.
+func TestDetectorSideChain(t *testing.T) {
+	b := newTestBackend(t, 1)
+
+	submitted := make(chan *DoubleSignEvidence, 2)
+	detector := NewDetector(b.engine, b.chain, func(evidence *DoubleSignEvidence) error {
+		submitted <- evidence
+		return nil
+	})
+	detector.Start()
+	defer detector.Stop()
+
+	// Feed the side chain blocks to the detector, as the node does
+	sides := make(chan core.ChainSideEvent, 16)
+	sub := b.chain.SubscribeChainSideEvent(sides)
+	defer sub.Unsubscribe()
+	go func() {
+		for {
+			select {
+			case ev := <-sides:
+				detector.CheckSide(ev.Header)
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+	first, second := b.makeConflict(b.chain.Genesis(), b.keys[0])
+	if _, err := b.chain.InsertChain(types.Blocks{first}); err != nil {
+		t.Fatalf("failed to insert first block: %v", err)
+	}
+	// Forget the verified seals, the conflict must be caught via the reorg
+	b.engine.seals.Purge()
+	if _, err := b.chain.InsertChain(types.Blocks{second}); err != nil {
+		t.Fatalf("failed to insert second block: %v", err)
+	}
+	select {
+	case evidence := <-submitted:
+		if want := NewDoubleSignEvidence(first.Header(), second.Header()).Hash(); evidence.Hash() != want {
+			t.Fatalf("evidence mismatch: have %x, want %x", evidence.Hash(), want)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("double sign not submitted")
+	}
+	// Reorging back must not resubmit the same evidence
+	if _, err := b.chain.InsertChain(types.Blocks{b.makeBlock(first, b.keys[0], 0, nil)}); err != nil {
+		t.Fatalf("failed to reorg back: %v", err)
+	}
+	select {
+	case <-submitted:
+		t.Fatalf("evidence submitted twice")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,430 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// maxVoteDistance is the number of blocks below the head votes are still accepted
+// for.
+const maxVoteDistance = 256
+
+var (
+	justifiedKey   = []byte("pixelzx-justified") // Latest block justified in the local view
+	lastVotePrefix = []byte("pixelzx-vote-")     // lastVotePrefix + address -> last vote signed by a local validator
+)
+
+var (
+	// errKnownVote is returned if a vote is already in the vote pool.
+	errKnownVote = errors.New("vote already known")
+
+	// errStaleVote is returned if a vote attests a block that is already final,
+	// or too far below the head to be tracked any more, or links from a source
+	// below the finalized block.
+	errStaleVote = errors.New("stale vote")
+
+	// errInvalidVoteLink is returned if the source of a vote is not an ancestor of
+	// the block it attests.
+	errInvalidVoteLink = errors.New("vote source not an ancestor of its target")
+)
+
+// FinalityChain is the subset of the blockchain the finality gadget needs to
+// mark blocks final.
+type FinalityChain interface {
+	consensus.ChainHeaderReader
+
+	// CurrentFinalBlock retrieves the header of the latest finalized block.
+	CurrentFinalBlock() *types.Header
+
+	// SetFinalized marks a block as finalized.
+	SetFinalized(header *types.Header)
+
+	// SetSafe marks a block as safe.
+	SetSafe(header *types.Header)
+}
+
+// tally is the collection of votes attesting a single block.
+type tally struct {
+	header     *types.Header
+	validators map[common.Address]uint64 // Validators allowed to attest the block and their voting power
+	total      uint64                    // Total voting power of the validators
+	votes      map[common.Address]*Vote  // Votes of the attesting validators
+}
+
+// quorum returns whether validators with more than two thirds of the voting
+// power attested the block from a source accepted by the filter.
+func (t *tally) quorum(accept func(source Checkpoint) bool) bool {
+	var power uint64
+	for signer, vote := range t.votes {
+		if accept(vote.Source) {
+			power += t.validators[signer]
+		}
+	}
+	return 3*power > 2*t.total
+}
+
+// Finality is the vote based finality gadget of the PIXELZX engine, a Casper FFG
+// style protocol with every block being a checkpoint.
+//
+// Once the local validator imports a new chain head, which the node passes on to
+// NewHead, it signs a vote linking the latest justified ancestor of the head (the
+// source) to the head (the target), which is gossiped to the network. The votes are tallied against the validator
+// set that was allowed to seal the target block:
+//
+//   - a block is justified once validators with more than two thirds of the
+//     voting power attested it from justified sources, the finalized block
+//     being justified by definition.
+//   - a justified block is finalized once such a quorum attested its direct
+//     child from it, marking it (and with it all its ancestors) finalized and
+//     safe. The blockchain refuses to reorg finalized blocks out of the canonical
+//     chain.
+//
+// A validator never signs two votes for the same height, nor a vote whose source
+// is below the source of a vote it signed before, which would surround it. Its
+// last vote is persisted, so the rules hold across restarts: the validator stays
+// locked on its latest source, only attesting blocks descending from it, or from
+// a higher justified block. Conflicting blocks can then only both be finalized
+// if validators with at least a third of the voting power sign votes breaking
+// the rules, which the slashing protection of honest validators refuses.
+type Finality struct {
+	engine *Pixelzx
+	chain  FinalityChain
+
+	tallies   map[common.Hash]*tally        // Votes of not yet finalized blocks, keyed by block hash
+	justified map[common.Hash]*types.Header // Justified blocks above the finalized one
+	lock      sync.Mutex
+
+	voteFeed event.Feed
+	scope    event.SubscriptionScope
+}
+
+// NewFinality creates the finality gadget tracking the given chain, resuming
+// from the latest block justified before a restart.
+func NewFinality(engine *Pixelzx, chain FinalityChain) *Finality {
+	f := &Finality{
+		engine:    engine,
+		chain:     chain,
+		tallies:   make(map[common.Hash]*tally),
+		justified: make(map[common.Hash]*types.Header),
+	}
+	if blob, err := engine.db.Get(justifiedKey); err == nil {
+		var justified Checkpoint
+		if err := rlp.DecodeBytes(blob, &justified); err != nil {
+			log.Warn("Failed to decode justified block", "err", err)
+		} else if header := chain.GetHeader(justified.Hash, justified.Number); header != nil && justified.Number > f.finalized().Number.Uint64() {
+			f.justified[justified.Hash] = header
+		}
+	}
+	return f
+}
+
+// Stop terminates the vote subscriptions.
+func (f *Finality) Stop() {
+	f.scope.Close()
+}
+
+// SubscribeVotes subscribes to the valid votes entering the vote pool, both the
+// ones signed locally and the ones received from the network.
+func (f *Finality) SubscribeVotes(ch chan<- *Vote) event.Subscription {
+	return f.scope.Track(f.voteFeed.Subscribe(ch))
+}
+
+// AddVote validates a vote and adds it to the vote pool, justifying and
+// finalizing the blocks whose quorum the vote completes.
+func (f *Finality) AddVote(vote *Vote) error {
+	f.lock.Lock()
+	err := f.addVote(vote)
+	f.lock.Unlock()
+
+	if err != nil {
+		return err
+	}
+	f.voteFeed.Send(vote)
+	return nil
+}
+
+// Pending retrieves all the votes of not yet finalized blocks.
+func (f *Finality) Pending() []*Vote {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	var votes []*Vote
+	for _, t := range f.tallies {
+		for _, vote := range t.votes {
+			votes = append(votes, vote)
+		}
+	}
+	return votes
+}
+
+// finalized returns the latest finalized block, or the genesis if none is yet.
+func (f *Finality) finalized() *types.Header {
+	if final := f.chain.CurrentFinalBlock(); final != nil {
+		return final
+	}
+	return f.chain.GetHeaderByNumber(0)
+}
+
+// isJustified returns whether a block is justified in the local view. The caller
+// must hold the lock.
+func (f *Finality) isJustified(checkpoint Checkpoint) bool {
+	if final := f.finalized(); final.Hash() == checkpoint.Hash {
+		return true
+	}
+	header, ok := f.justified[checkpoint.Hash]
+	return ok && header.Number.Uint64() == checkpoint.Number
+}
+
+// isAncestor returns whether a checkpoint is an ancestor of the given block.
+func (f *Finality) isAncestor(ancestor Checkpoint, header *types.Header) bool {
+	if canon := f.chain.GetHeaderByNumber(header.Number.Uint64()); canon != nil && canon.Hash() == header.Hash() {
+		canon = f.chain.GetHeaderByNumber(ancestor.Number)
+		return canon != nil && canon.Hash() == ancestor.Hash
+	}
+	for header != nil && header.Number.Uint64() > ancestor.Number {
+		header = f.chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	}
+	return header != nil && header.Hash() == ancestor.Hash
+}
+
+// addVote validates a vote and tallies it. The caller must hold the lock.
+func (f *Finality) addVote(vote *Vote) error {
+	if vote.Target.Number == 0 {
+		return errStaleVote
+	}
+	if vote.Source.Number >= vote.Target.Number {
+		return errInvalidVoteLink
+	}
+	final := f.finalized().Number.Uint64()
+	if vote.Target.Number <= final || vote.Source.Number < final {
+		return errStaleVote
+	}
+	if head := f.chain.CurrentHeader(); vote.Target.Number+maxVoteDistance < head.Number.Uint64() {
+		return errStaleVote
+	}
+	t := f.tallies[vote.Target.Hash]
+	if t == nil {
+		header := f.chain.GetHeader(vote.Target.Hash, vote.Target.Number)
+		if header == nil {
+			return errUnknownBlock
+		}
+		snap, err := f.engine.snapshot(f.chain, vote.Target.Number-1, header.ParentHash, nil)
+		if err != nil {
+			return err
+		}
+		t = &tally{
+			header:     header,
+			validators: snap.Validators,
+			total:      uint64(snap.totalPower()),
+			votes:      make(map[common.Address]*Vote),
+		}
+	}
+	if !f.isAncestor(vote.Source, t.header) {
+		return errInvalidVoteLink
+	}
+	signer, err := vote.Signer()
+	if err != nil {
+		return err
+	}
+	if _, ok := t.validators[signer]; !ok {
+		return errUnauthorizedValidator
+	}
+	if _, ok := t.votes[signer]; ok {
+		return errKnownVote
+	}
+	t.votes[signer] = vote
+	f.tallies[vote.Target.Hash] = t
+
+	f.process()
+	return nil
+}
+
+// process justifies the blocks that reached their quorum, until no more can be,
+// and finalizes the justified blocks whose direct child they justified. The
+// caller must hold the lock.
+func (f *Finality) process() {
+	for changed := true; changed; {
+		changed = false
+		for hash, t := range f.tallies {
+			if _, ok := f.justified[hash]; !ok {
+				if !t.quorum(f.isJustified) {
+					continue
+				}
+				f.justify(t.header)
+				changed = true
+			}
+			parent := Checkpoint{Number: t.header.Number.Uint64() - 1, Hash: t.header.ParentHash}
+			if f.isJustified(parent) && t.quorum(func(source Checkpoint) bool { return source == parent }) {
+				if header := f.chain.GetHeader(parent.Hash, parent.Number); header != nil {
+					f.finalize(header)
+				}
+			}
+		}
+	}
+}
+
+// justify marks a block as justified, persisting it if it's the latest one. The
+// caller must hold the lock.
+func (f *Finality) justify(header *types.Header) {
+	f.justified[header.Hash()] = header
+
+	for _, justified := range f.justified {
+		if justified.Number.Cmp(header.Number) > 0 {
+			return
+		}
+	}
+	blob, err := rlp.EncodeToBytes(&Checkpoint{Number: header.Number.Uint64(), Hash: header.Hash()})
+	if err != nil {
+		log.Error("Failed to encode justified block", "err", err)
+		return
+	}
+	if err := f.engine.db.Put(justifiedKey, blob); err != nil {
+		log.Error("Failed to store justified block", "err", err)
+	}
+	log.Debug("Justified block", "number", header.Number, "hash", header.Hash())
+}
+
+// finalize marks a block as finalized and safe if it's canonical and above the
+// current finalized block, dropping the votes and justifications it made
+// obsolete. The caller must hold the lock.
+func (f *Finality) finalize(header *types.Header) {
+	number := header.Number.Uint64()
+	if f.finalized().Number.Uint64() >= number {
+		return
+	}
+	if canon := f.chain.GetHeaderByNumber(number); canon == nil || canon.Hash() != header.Hash() {
+		return
+	}
+	f.chain.SetFinalized(header)
+	f.chain.SetSafe(header)
+
+	log.Debug("Finalized block", "number", number, "hash", header.Hash())
+
+	for hash, t := range f.tallies {
+		if t.header.Number.Uint64() <= number {
+			delete(f.tallies, hash)
+		}
+	}
+	for hash, justified := range f.justified {
+		if justified.Number.Uint64() <= number {
+			delete(f.justified, hash)
+		}
+	}
+}
+
+// NewHead attests a new chain head if the local node is an active validator,
+// finalizes any block which reached its quorum before becoming canonical and
+// drops the votes fallen too far below the head.
+func (f *Finality) NewHead(head *types.Header) {
+	if vote := f.attest(head); vote != nil {
+		if err := f.AddVote(vote); err != nil {
+			log.Warn("Failed to add local vote", "number", vote.Target.Number, "hash", vote.Target.Hash, "err", err)
+		}
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	for hash, t := range f.tallies {
+		if t.header.Number.Uint64()+maxVoteDistance < head.Number.Uint64() {
+			delete(f.tallies, hash)
+		}
+	}
+	f.process()
+}
+
+// source returns the latest justified ancestor of the given block. The caller
+// must hold the lock.
+func (f *Finality) source(header *types.Header) Checkpoint {
+	final := f.finalized()
+	source := Checkpoint{Number: final.Number.Uint64(), Hash: final.Hash()}
+	for hash, justified := range f.justified {
+		number := justified.Number.Uint64()
+		if number > source.Number && number < header.Number.Uint64() && f.isAncestor(Checkpoint{Number: number, Hash: hash}, header) {
+			source = Checkpoint{Number: number, Hash: hash}
+		}
+	}
+	return source
+}
+
+// attest signs a vote for the given block if the local validator is allowed to,
+// returning nil otherwise. The vote is persisted before it is signed.
+func (f *Finality) attest(header *types.Header) *Vote {
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil
+	}
+	signer := f.engine.Signer()
+	if signer == (common.Address{}) {
+		return nil
+	}
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	key := append(lastVotePrefix, signer.Bytes()...)
+	last := new(Vote)
+	if blob, err := f.engine.db.Get(key); err != nil {
+		last = nil
+	} else if err := rlp.DecodeBytes(blob, last); err != nil {
+		log.Error("Failed to decode last vote", "err", err)
+		return nil
+	}
+	source := f.source(header)
+
+	// Never attest two blocks at the same height, nor surround a signed vote
+	if last != nil {
+		if number <= last.Target.Number {
+			return nil
+		}
+		if source.Number < last.Source.Number || (source.Number == last.Source.Number && source.Hash != last.Source.Hash) {
+			log.Debug("Not attesting block off the locked source", "number", number, "source", source.Number, "locked", last.Source.Number)
+			return nil
+		}
+	}
+	snap, err := f.engine.snapshot(f.chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		log.Warn("Failed to retrieve validator snapshot for attestation", "number", number, "err", err)
+		return nil
+	}
+	if _, ok := snap.Validators[signer]; !ok {
+		return nil
+	}
+	target := Checkpoint{Number: number, Hash: header.Hash()}
+	blob, err := rlp.EncodeToBytes(&Vote{Source: source, Target: target})
+	if err != nil {
+		log.Error("Failed to encode vote", "err", err)
+		return nil
+	}
+	if err := f.engine.db.Put(key, blob); err != nil {
+		log.Error("Failed to store vote", "err", err)
+		return nil
+	}
+	vote, err := f.engine.SignVote(source, target)
+	if err != nil {
+		log.Warn("Failed to sign vote", "number", number, "err", err)
+		return nil
+	}
+	return vote
+}
@@ -0,0 +1,226 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// vote creates a vote of the given validator attesting a block from a source.
+func (b *testBackend) vote(key *ecdsa.PrivateKey, source, block *types.Block) *Vote {
+	var (
+		from = Checkpoint{Number: source.NumberU64(), Hash: source.Hash()}
+		to   = Checkpoint{Number: block.NumberU64(), Hash: block.Hash()}
+	)
+	sig, err := crypto.Sign(crypto.Keccak256(VoteRLP(from, to)), key)
+	if err != nil {
+		b.t.Fatalf("failed to sign vote: %v", err)
+	}
+	return &Vote{Source: from, Target: to, Signature: sig}
+}
+
+// makeChain creates and imports a chain of in-turn blocks on top of parent.
+func (b *testBackend) makeChain(parent *types.Block, n int) []*types.Block {
+	blocks := make([]*types.Block, n)
+	for i := range blocks {
+		blocks[i] = b.makeBlock(parent, b.proposer(parent), 0, nil)
+		if _, err := b.chain.InsertChain(types.Blocks{blocks[i]}); err != nil {
+			b.t.Fatalf("block %d: failed to insert: %v", blocks[i].NumberU64(), err)
+		}
+		parent = blocks[i]
+	}
+	return blocks
+}
+
+// Tests that a block is justified once validators with more than two thirds of
+// the voting power attested it from a justified source, that a justified block
+// is finalized once its direct child is justified from it, and that invalid
+// votes are rejected.
+func TestFinalityQuorum(t *testing.T) {
+	b := newTestBackend(t, 1, 1, 1)
+
+	var (
+		genesis     = b.chain.Genesis()
+		finality    = NewFinality(b.engine, b.chain)
+		blocks      = b.makeChain(genesis, 3)
+		outsider, _ = crypto.GenerateKey()
+	)
+	// Justifying a block from the genesis finalizes nothing yet
+	for _, key := range b.keys {
+		if err := finality.AddVote(b.vote(key, genesis, blocks[0])); err != nil {
+			t.Fatalf("failed to add vote: %v", err)
+		}
+	}
+	if final := b.chain.CurrentFinalBlock(); final != nil {
+		t.Fatalf("block finalized without justified child: %d", final.Number)
+	}
+	// Two thirds of the voting power is not enough to justify the child
+	for _, key := range b.keys[:2] {
+		if err := finality.AddVote(b.vote(key, blocks[0], blocks[1])); err != nil {
+			t.Fatalf("failed to add vote: %v", err)
+		}
+	}
+	if final := b.chain.CurrentFinalBlock(); final != nil {
+		t.Fatalf("block finalized without quorum: %d", final.Number)
+	}
+	if err := finality.AddVote(b.vote(b.keys[0], blocks[0], blocks[1])); !errors.Is(err, errKnownVote) {
+		t.Errorf("duplicate vote error mismatch: have %v, want %v", err, errKnownVote)
+	}
+	if err := finality.AddVote(b.vote(b.keys[0], genesis, blocks[1])); !errors.Is(err, errKnownVote) {
+		t.Errorf("vote from another source error mismatch: have %v, want %v", err, errKnownVote)
+	}
+	if err := finality.AddVote(b.vote(outsider, blocks[0], blocks[1])); !errors.Is(err, errUnauthorizedValidator) {
+		t.Errorf("outsider vote error mismatch: have %v, want %v", err, errUnauthorizedValidator)
+	}
+	forged := b.vote(b.keys[2], blocks[0], blocks[1])
+	forged.Signature = forged.Signature[:10]
+	if err := finality.AddVote(forged); !errors.Is(err, errInvalidVoteSignature) {
+		t.Errorf("malformed vote error mismatch: have %v, want %v", err, errInvalidVoteSignature)
+	}
+	unknown := b.makeBlock(blocks[2], b.proposer(blocks[2]), 0, nil)
+	if err := finality.AddVote(b.vote(b.keys[2], blocks[2], unknown)); !errors.Is(err, errUnknownBlock) {
+		t.Errorf("unknown block vote error mismatch: have %v, want %v", err, errUnknownBlock)
+	}
+	if err := finality.AddVote(b.vote(b.keys[2], blocks[1], blocks[1])); !errors.Is(err, errInvalidVoteLink) {
+		t.Errorf("self link vote error mismatch: have %v, want %v", err, errInvalidVoteLink)
+	}
+	fork := b.makeBlock(genesis, b.backup(genesis), 10, nil)
+	if _, err := b.chain.InsertChain(types.Blocks{fork}); err != nil {
+		t.Fatalf("failed to insert side block: %v", err)
+	}
+	if err := finality.AddVote(b.vote(b.keys[2], fork, blocks[1])); !errors.Is(err, errInvalidVoteLink) {
+		t.Errorf("foreign source vote error mismatch: have %v, want %v", err, errInvalidVoteLink)
+	}
+	// The last vote completes the quorum
+	if err := finality.AddVote(b.vote(b.keys[2], blocks[0], blocks[1])); err != nil {
+		t.Fatalf("failed to add vote: %v", err)
+	}
+	for name, header := range map[string]*types.Header{"finalized": b.chain.CurrentFinalBlock(), "safe": b.chain.CurrentSafeBlock()} {
+		if header == nil || header.Hash() != blocks[0].Hash() {
+			t.Fatalf("%s block mismatch: have %v, want %d", name, header, blocks[0].NumberU64())
+		}
+	}
+	if err := finality.AddVote(b.vote(b.keys[0], genesis, blocks[0])); !errors.Is(err, errStaleVote) {
+		t.Errorf("finalized block vote error mismatch: have %v, want %v", err, errStaleVote)
+	}
+	if err := finality.AddVote(b.vote(b.keys[0], genesis, blocks[2])); !errors.Is(err, errStaleVote) {
+		t.Errorf("vote from below finalized block error mismatch: have %v, want %v", err, errStaleVote)
+	}
+	for _, vote := range finality.Pending() {
+		if vote.Target.Number <= blocks[0].NumberU64() {
+			t.Errorf("finalized vote not pruned: %d", vote.Target.Number)
+		}
+	}
+	// Reorgs dropping the finalized block must be refused, above it allowed
+	heavier := b.makeChain(fork, len(blocks)-1)
+	if _, err := b.chain.InsertChain(types.Blocks{b.makeBlock(heavier[1], b.proposer(heavier[1]), 0, nil)}); err == nil {
+		t.Fatalf("reorg below finalized block accepted")
+	}
+	if head := b.chain.CurrentBlock(); head.Hash() != blocks[2].Hash() {
+		t.Fatalf("head changed by refused reorg: have %d [%x], want %d", head.Number, head.Hash(), blocks[2].NumberU64())
+	}
+	fork = b.makeBlock(blocks[0], b.backupExcept(blocks[0], blocks[0].Coinbase()), 10, nil)
+	if _, err := b.chain.InsertChain(types.Blocks{fork}); err != nil {
+		t.Fatalf("failed to insert side block: %v", err)
+	}
+	if head := b.makeChain(fork, 2)[1]; b.chain.CurrentBlock().Hash() != head.Hash() {
+		t.Fatalf("reorg above finalized block refused")
+	}
+}
+
+// Tests that the local validator attests every new head exactly once from its
+// latest justified ancestor, never a block below one it already attested, and
+// never from a source below the one of its last vote, also after a restart.
+func TestFinalityAttestation(t *testing.T) {
+	b := newTestBackend(t, 1, 1, 1)
+
+	var (
+		key      = b.keys[0]
+		genesis  = b.chain.Genesis()
+		finality = NewFinality(b.engine, b.chain)
+		blocks   = b.makeChain(genesis, 3)
+	)
+	b.engine.Authorize(crypto.PubkeyToAddress(key.PublicKey), func(account accounts.Account, mimeType string, message []byte) ([]byte, error) {
+		if mimeType != accounts.MimetypePixelzxVote {
+			t.Errorf("mime type mismatch: have %s, want %s", mimeType, accounts.MimetypePixelzxVote)
+		}
+		return crypto.Sign(crypto.Keccak256(message), key)
+	})
+	finality.NewHead(blocks[1].Header())
+	finality.NewHead(blocks[1].Header())
+	finality.NewHead(blocks[0].Header())
+
+	votes := finality.Pending()
+	if len(votes) != 1 {
+		t.Fatalf("vote count mismatch: have %d, want 1", len(votes))
+	}
+	if votes[0].Target.Hash != blocks[1].Hash() || votes[0].Source.Hash != genesis.Hash() {
+		t.Fatalf("vote mismatch: have %d -> %d, want %d -> %d", votes[0].Source.Number, votes[0].Target.Number, 0, blocks[1].NumberU64())
+	}
+	if signer, err := votes[0].Signer(); err != nil || signer != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("vote signer mismatch: have %x (%v), want %x", signer, err, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	// Once the attested block is justified, the next vote links from it
+	for _, key := range b.keys[1:] {
+		if err := finality.AddVote(b.vote(key, genesis, blocks[1])); err != nil {
+			t.Fatalf("failed to add vote: %v", err)
+		}
+	}
+	finality.NewHead(blocks[2].Header())
+
+	var vote *Vote
+	for _, v := range finality.Pending() {
+		if v.Target.Hash == blocks[2].Hash() {
+			vote = v
+		}
+	}
+	if vote == nil || vote.Source.Hash != blocks[1].Hash() {
+		t.Fatalf("vote from justified block missing: %v", vote)
+	}
+	// A heavier fork off the justified block is not attested, the validator is
+	// locked on it, also after a restart
+	fork := b.makeBlock(blocks[0], b.backupExcept(blocks[0], blocks[0].Coinbase()), 10, nil)
+	if _, err := b.chain.InsertChain(types.Blocks{fork}); err != nil {
+		t.Fatalf("failed to insert fork: %v", err)
+	}
+	forked := b.makeChain(fork, 3)
+	if head := b.chain.CurrentBlock(); head.Hash() != forked[2].Hash() {
+		t.Fatalf("fork not canonical: head %d [%x]", head.Number, head.Hash())
+	}
+	finality.NewHead(forked[2].Header())
+
+	restarted := NewFinality(b.engine, b.chain)
+	restarted.NewHead(forked[2].Header())
+	restarted.NewHead(blocks[2].Header())
+
+	for _, f := range []*Finality{finality, restarted} {
+		for _, v := range f.Pending() {
+			if signer, _ := v.Signer(); signer == crypto.PubkeyToAddress(key.PublicKey) && v.Target.Number > blocks[2].NumberU64() {
+				t.Fatalf("locked validator attested block %d from %d", v.Target.Number, v.Source.Number)
+			}
+		}
+	}
+	if votes := restarted.Pending(); len(votes) != 0 {
+		t.Fatalf("restarted validator attested again: %d votes", len(votes))
+	}
+}
@@ -0,0 +1,52 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// ReorgNeeded implements consensus.ForkChooser, preferring the heavier of two
+// competing chains. The weight of a chain is the sum of the difficulties of its
+// blocks since the fork point, so in-turn blocks count twice as much as backup
+// ones: a backup proposer can't displace the in-turn block of its slot, and a
+// chain sealed by the in-turn proposers outweighs a longer one filled by backups.
+// On equal weight, the current chain is kept.
+func (p *Pixelzx) ReorgNeeded(chain consensus.ChainHeaderReader, current, extern *types.Header) (bool, error) {
+	var (
+		localWeight  = new(big.Int)
+		externWeight = new(big.Int)
+	)
+	// Walk both chains back to their common ancestor, summing up their weight
+	for current.Hash() != extern.Hash() {
+		if current.Number.Cmp(extern.Number) >= 0 {
+			localWeight.Add(localWeight, current.Difficulty)
+			if current = chain.GetHeader(current.ParentHash, current.Number.Uint64()-1); current == nil {
+				return false, consensus.ErrUnknownAncestor
+			}
+		} else {
+			externWeight.Add(externWeight, extern.Difficulty)
+			if extern = chain.GetHeader(extern.ParentHash, extern.Number.Uint64()-1); extern == nil {
+				return false, consensus.ErrUnknownAncestor
+			}
+		}
+	}
+	return externWeight.Cmp(localWeight) > 0, nil
+}
@@ -0,0 +1,86 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"crypto/ecdsa"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Tests that competing blocks only become the head if they win the fork choice,
+// and are imported as side blocks otherwise.
+func TestForkChoice(t *testing.T) {
+	b := newTestBackend(t, 1, 1, 1, 1)
+
+	var (
+		genesis = b.chain.Genesis()
+		inturn  = b.makeBlock(genesis, b.proposer(genesis), 0, nil)
+		backup  = b.makeBlock(genesis, b.backup(genesis), 10, nil)
+	)
+	insert := func(block *types.Block) {
+		t.Helper()
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+	}
+	assertHead := func(want *types.Block) {
+		t.Helper()
+		if head := b.chain.CurrentBlock(); head.Hash() != want.Hash() {
+			t.Fatalf("head mismatch: have #%d %x, want #%d %x", head.Number, head.Hash(), want.NumberU64(), want.Hash())
+		}
+	}
+	// A backup block arriving after the in-turn one of the same slot loses
+	insert(inturn)
+	insert(backup)
+	assertHead(inturn)
+
+	if b.chain.GetBlock(backup.Hash(), 1) == nil {
+		t.Fatalf("losing block not imported")
+	}
+	// A backup block on top of the backup one only matches the weight of the
+	// in-turn block, the current head is kept
+	second := b.makeBlock(backup, b.backupExcept(backup, backup.Coinbase()), 10, nil)
+	insert(second)
+	assertHead(inturn)
+
+	// An in-turn block on top of them outweighs it
+	third := b.makeBlock(second, b.proposer(second), 0, nil)
+	insert(third)
+	assertHead(third)
+
+	// Re-importing the lighter chain doesn't switch back to it
+	insert(inturn)
+	assertHead(third)
+}
+
+// backupExcept returns the key of a validator which is neither the in-turn
+// proposer of the block after parent, nor any of the excluded validators.
+func (b *testBackend) backupExcept(parent *types.Block, excluded ...common.Address) *ecdsa.PrivateKey {
+	proposer := b.proposer(parent)
+	for _, key := range b.keys {
+		if key != proposer && !slices.Contains(excluded, crypto.PubkeyToAddress(key.PublicKey)) {
+			return key
+		}
+	}
+	b.t.Fatalf("no backup proposer available")
+	return nil
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"math/big"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/governance"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// GenesisAlloc assembles the genesis allocation of a PIXELZX network: the staking
+// system contract with the initial validators registered, and the governance
+// system contract if the network is governed from genesis on.
+func GenesisAlloc(config *params.PixelzxConfig, validators []staking.GenesisValidator) types.GenesisAlloc {
+	alloc := types.GenesisAlloc{
+		params.PixelzxStakingAddress: staking.GenesisAccount(validators),
+	}
+	if config.IsGovernance(common.Big0) {
+		alloc[params.PixelzxGovernanceAddress] = governance.GenesisAccount()
+	}
+	return alloc
+}
+
+// CheckpointValidators returns the validator set listed in the extra-data of a
+// checkpoint header, like the genesis one.
+func CheckpointValidators(header *types.Header) ([]Validator, error) {
+	return parseValidators(header)
+}
+
+// GenesisExtraData assembles the genesis extra-data of a PIXELZX network: the
+// vanity, the initial validators (sorted by address) with their voting power in
+// whole PZX and an empty seal.
+func GenesisExtraData(vanity string, validators []staking.GenesisValidator) []byte {
+	set := make([]Validator, len(validators))
+	for i, v := range validators {
+		power := new(big.Int).Div(v.Stake, big.NewInt(params.PZX))
+		set[i] = Validator{Address: v.Address, Power: power.Uint64()}
+	}
+	slices.SortFunc(set, func(a, b Validator) int {
+		return a.Address.Cmp(b.Address)
+	})
+	extra := make([]byte, extraVanity, extraVanity+len(set)*validatorBytes+extraSeal)
+	copy(extra, vanity)
+	extra = append(extra, encodeValidators(set)...)
+	return append(extra, make([]byte, crypto.SignatureLength)...)
+}
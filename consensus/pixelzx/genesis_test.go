@@ -0,0 +1,58 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that the genesis allocations and extra-data embedded into core for the
+// built-in networks are the ones assembled from their bootstrap validators.
+func TestBuiltinGenesis(t *testing.T) {
+	tests := []struct {
+		name    string
+		genesis *core.Genesis
+		vanity  string
+		hash    common.Hash
+	}{
+		{"mainnet", core.DefaultPixelzxGenesisBlock(), "PIXELZX mainnet", params.PixelzxMainnetGenesisHash},
+		{"testnet", core.DefaultPixelzxTestnetGenesisBlock(), "PIXELZX testnet", params.PixelzxTestnetGenesisHash},
+	}
+	for _, tt := range tests {
+		validators, err := parseValidators(tt.genesis.ToBlock().Header())
+		if err != nil {
+			t.Fatalf("%s: failed to parse validators: %v", tt.name, err)
+		}
+		config := tt.genesis.Config.Pixelzx
+		stakes := make([]staking.GenesisValidator, len(validators))
+		for i, v := range validators {
+			stakes[i] = staking.GenesisValidator{Address: v.Address, Stake: config.MinValidatorStake, Moniker: "genesis"}
+		}
+		rebuilt := *tt.genesis
+		rebuilt.Alloc = GenesisAlloc(config, stakes)
+		rebuilt.ExtraData = GenesisExtraData(tt.vanity, stakes)
+
+		if hash := rebuilt.ToBlock().Hash(); hash != tt.hash {
+			t.Errorf("%s: genesis hash mismatch: have %x, want %x", tt.name, hash, tt.hash)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/governance"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// processGovernance tallies the governance proposals whose vote ended by the
+// epoch block and applies the approved ones due, from the next block on.
+func (p *Pixelzx) processGovernance(header *types.Header, state vm.StateDB) {
+	if !p.config.IsGovernance(header.Number) {
+		return
+	}
+	for _, proposal := range governance.ProcessEpoch(state, header.Number.Uint64(), p.config.ExecutionDelay) {
+		log.Info("Processed governance proposal", "id", proposal.ID, "status", proposal.Status, "param", proposal.Param,
+			"value", proposal.Value, "yes", proposal.YesVotes, "no", proposal.NoVotes, "execution", proposal.ExecutionBlock)
+	}
+}
+
+// governed returns the value of a parameter set by governance in effect at the
+// block with the given number, or false if the configured value applies.
+func (p *Pixelzx) governed(state governance.StateDB, param governance.Param, number uint64) (*big.Int, bool) {
+	if !p.config.IsGovernance(new(big.Int).SetUint64(number)) {
+		return nil, false
+	}
+	return governance.Value(state, param, number)
+}
+
+// GasLimitTarget implements consensus.GasLimiter, returning the gas limit set by
+// governance, if any. Governance only changes parameters from the block after an
+// epoch boundary, so the parent state and the post state of a block agree on it.
+func (p *Pixelzx) GasLimitTarget(header *types.Header, state vm.StateDB) (uint64, bool) {
+	target, ok := p.governed(state, governance.GasLimit, header.Number.Uint64())
+	if !ok {
+		return 0, false
+	}
+	return target.Uint64(), true
+}
+
+// verifyGasLimit checks that the gas limit of a block moves towards the target
+// set by governance, like the block producers are required to.
+func (p *Pixelzx) verifyGasLimit(chain consensus.ChainHeaderReader, header *types.Header, state vm.StateDB) error {
+	target, ok := p.GasLimitTarget(header, state)
+	if !ok {
+		return nil
+	}
+	parent := chain.GetHeader(header.ParentHash, header.Number.Uint64()-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	if want := misc.CalcGasLimit(parent.GasLimit, target); header.GasLimit != want {
+		return fmt.Errorf("%w: have %d, want %d, target %d", errInvalidGasLimit, header.GasLimit, want, target)
+	}
+	return nil
+}
@@ -0,0 +1,263 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	_ "embed"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+const (
+	maxDescriptionLength = 1024 // Maximum length of a proposal description, in bytes
+	maxPendingProposals  = 16   // Maximum number of proposals voted on or queued at once
+)
+
+// Gas charged for the calls to the contract, beside the usual call costs.
+const (
+	proposeGas = 100_000
+	voteGas    = 50_000
+	viewGas    = 10_000
+)
+
+//go:embed governance.abi
+var abiJSON string
+
+// ABI is the interface of the governance contract.
+var ABI abi.ABI
+
+// revertSelector is the selector of the Error(string) revert reason.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+func init() {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	ABI = parsed
+
+	vm.RegisterSystemContracts(func(config *params.ChainConfig, rules params.Rules) vm.PrecompiledContracts {
+		if !rules.IsPixelzxGovernance {
+			return nil
+		}
+		return vm.PrecompiledContracts{
+			params.PixelzxGovernanceAddress: &contract{config: config.Pixelzx},
+		}
+	})
+}
+
+// contract is the native implementation of the governance system contract.
+type contract struct {
+	config *params.PixelzxConfig
+}
+
+// Name implements vm.PrecompiledContract.
+func (c *contract) Name() string {
+	return "PIXELZX_GOVERNANCE"
+}
+
+// RequiredGas implements vm.PrecompiledContract, charging a flat fee per method.
+func (c *contract) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := ABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+	switch method.Name {
+	case "propose":
+		return proposeGas
+	case "vote":
+		return voteGas
+	default:
+		return viewGas
+	}
+}
+
+// Run implements vm.PrecompiledContract. It is only reached when the contract is
+// invoked via CALLCODE or DELEGATECALL, which is not allowed.
+func (c *contract) Run(input []byte) ([]byte, error) {
+	return nil, vm.ErrSystemContractContext
+}
+
+// RunStateful implements vm.StatefulPrecompiledContract, dispatching the call to
+// the requested method.
+func (c *contract) RunStateful(evm *vm.EVM, caller common.Address, input []byte, value *uint256.Int, readOnly bool) ([]byte, error) {
+	if len(input) < 4 {
+		return revert("missing method selector")
+	}
+	method, err := ABI.MethodById(input[:4])
+	if err != nil {
+		return revert("unknown method")
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return revert("invalid arguments")
+	}
+	if readOnly && !method.IsConstant() {
+		return nil, vm.ErrWriteProtection
+	}
+	if !value.IsZero() {
+		return revert("method is not payable")
+	}
+	call := &call{
+		contract: c,
+		evm:      evm,
+		storage:  storage{evm.StateDB},
+		caller:   caller,
+		method:   method,
+	}
+	switch method.Name {
+	case "propose":
+		return call.propose(Param(args[0].(uint8)), args[1].(*big.Int), args[2].(string))
+	case "vote":
+		return call.vote(args[0].(*big.Int), args[1].(bool))
+	case "proposalCount":
+		return method.Outputs.Pack(new(big.Int).SetUint64(call.storage.proposalCount()))
+	case "getProposal":
+		return call.getProposal(args[0].(*big.Int))
+	case "hasVoted":
+		id := args[0].(*big.Int)
+		return method.Outputs.Pack(id.IsUint64() && call.storage.voted(id.Uint64(), args[1].(common.Address)))
+	case "votingPower":
+		return method.Outputs.Pack(VotingPower(evm.StateDB, args[0].(common.Address)))
+	case "getParam":
+		return call.getParam(Param(args[0].(uint8)))
+	}
+	return revert("unknown method")
+}
+
+// call is the execution context of a single call to the contract.
+type call struct {
+	contract *contract
+	evm      *vm.EVM
+	storage  storage
+	caller   common.Address
+	method   *abi.Method
+}
+
+// number returns the number of the block the call is executed in.
+func (c *call) number() uint64 {
+	return c.evm.Context.BlockNumber.Uint64()
+}
+
+func (c *call) propose(param Param, value *big.Int, description string) ([]byte, error) {
+	if err := param.check(value); err != nil {
+		return revert(err.Error())
+	}
+	switch {
+	case len(description) > maxDescriptionLength:
+		return revert("description too long")
+	case VotingPower(c.evm.StateDB, c.caller).Sign() == 0:
+		return revert("no bonded stake")
+	}
+	pending := c.storage.pending()
+	if len(pending) >= maxPendingProposals {
+		return revert("too many pending proposals")
+	}
+	var (
+		id        = c.storage.proposalCount() + 1
+		votingEnd = c.number() + c.contract.config.VotingPeriod
+	)
+	c.storage.setProposalCount(id)
+	c.storage.setProposal(id, c.caller, param, uint256.MustFromBig(value), description, votingEnd)
+	c.storage.setPending(append(pending, id))
+
+	c.emit("ProposalCreated", []common.Hash{idKey(id), common.BytesToHash(c.caller.Bytes())}, uint8(param), value, new(big.Int).SetUint64(votingEnd))
+	return c.method.Outputs.Pack(new(big.Int).SetUint64(id))
+}
+
+func (c *call) vote(id *big.Int, support bool) ([]byte, error) {
+	if !id.IsUint64() || id.Uint64() == 0 || id.Uint64() > c.storage.proposalCount() {
+		return revert("unknown proposal")
+	}
+	n := id.Uint64()
+	switch {
+	case c.storage.status(n) != StatusVoting || c.number() >= c.storage.votingEnd(n):
+		return revert("voting closed")
+	case c.storage.voted(n, c.caller):
+		return revert("already voted")
+	}
+	weight := VotingPower(c.evm.StateDB, c.caller)
+	if weight.Sign() == 0 {
+		return revert("no bonded stake")
+	}
+	c.storage.addVote(n, c.caller, support, uint256.MustFromBig(weight))
+
+	c.emit("Voted", []common.Hash{idKey(n), common.BytesToHash(c.caller.Bytes())}, support, weight)
+	return nil, nil
+}
+
+func (c *call) getProposal(id *big.Int) ([]byte, error) {
+	if !id.IsUint64() {
+		return revert("unknown proposal")
+	}
+	p := Proposal(c.storage.db, id.Uint64())
+	if p == nil {
+		return revert("unknown proposal")
+	}
+	return c.method.Outputs.Pack(p.Proposer, uint8(p.Param), p.Value, p.Description, uint8(p.Status),
+		new(big.Int).SetUint64(p.VotingEnd), new(big.Int).SetUint64(p.ExecutionBlock), p.YesVotes, p.NoVotes)
+}
+
+func (c *call) getParam(param Param) ([]byte, error) {
+	if _, ok := paramNames[param]; !ok {
+		return revert("unknown parameter")
+	}
+	value, ok := Value(c.storage.db, param, c.number())
+	if !ok {
+		value = new(big.Int)
+	}
+	return c.method.Outputs.Pack(ok, value)
+}
+
+// emit adds a contract event to the state, with the given words as indexed
+// topics and the remaining values as the log data.
+func (c *call) emit(name string, indexed []common.Hash, values ...any) {
+	event := ABI.Events[name]
+	topics := append([]common.Hash{event.ID}, indexed...)
+
+	data, err := event.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		panic(err) // Only happens on a programming error
+	}
+	c.evm.StateDB.AddLog(&types.Log{
+		Address:     params.PixelzxGovernanceAddress,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: c.number(),
+	})
+}
+
+// revert aborts the call with an Error(string) revert reason.
+func revert(reason string) ([]byte, error) {
+	str, _ := abi.NewType("string", "", nil)
+	data, err := abi.Arguments{{Type: str}}.Pack(reason)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, revertSelector...), data...), vm.ErrExecutionReverted
+}
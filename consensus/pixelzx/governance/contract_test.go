@@ -0,0 +1,301 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+var (
+	stakerA = common.Address{0x0a} // Validator bonding 60% of the stake
+	stakerB = common.Address{0x0b} // Validator bonding 30% of the stake
+	stakerC = common.Address{0x0c} // Validator bonding 10% of the stake
+)
+
+// pzx converts an amount of whole PZX into wei.
+func pzx(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(params.PZX))
+}
+
+// testEnv is a minimal execution environment to call the governance contract in,
+// with three validators staked.
+type testEnv struct {
+	t      *testing.T
+	config *params.ChainConfig
+	state  *state.StateDB
+	number uint64
+}
+
+func newTestEnv(t *testing.T) *testEnv {
+	config := *params.AllPixelzxProtocolChanges
+	config.Pixelzx = &params.PixelzxConfig{Period: 3, Epoch: 200, MinValidatorStake: pzx(10), UnbondingPeriod: 100, VotingPeriod: 50, ExecutionDelay: 100}
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	stakes := staking.GenesisAccount([]staking.GenesisValidator{
+		{Address: stakerA, Stake: pzx(60)},
+		{Address: stakerB, Stake: pzx(30)},
+		{Address: stakerC, Stake: pzx(10)},
+	})
+	statedb.SetCode(params.PixelzxStakingAddress, stakes.Code)
+	statedb.SetNonce(params.PixelzxStakingAddress, stakes.Nonce, tracing.NonceChangeGenesis)
+	statedb.SetBalance(params.PixelzxStakingAddress, uint256.MustFromBig(stakes.Balance), tracing.BalanceIncreaseGenesisBalance)
+	for key, value := range stakes.Storage {
+		statedb.SetState(params.PixelzxStakingAddress, key, value)
+	}
+	account := GenesisAccount()
+	statedb.SetCode(params.PixelzxGovernanceAddress, account.Code)
+	statedb.SetNonce(params.PixelzxGovernanceAddress, account.Nonce, tracing.NonceChangeGenesis)
+
+	return &testEnv{t: t, config: &config, state: statedb, number: 1}
+}
+
+func (env *testEnv) evm() *vm.EVM {
+	ctx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *uint256.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, sender, recipient common.Address, amount *uint256.Int) {
+			db.SubBalance(sender, amount, tracing.BalanceChangeTransfer)
+			db.AddBalance(recipient, amount, tracing.BalanceChangeTransfer)
+		},
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber: new(big.Int).SetUint64(env.number),
+		Time:        env.number * 3,
+		Difficulty:  new(big.Int),
+		BaseFee:     new(big.Int),
+		Random:      &common.Hash{},
+	}
+	return vm.NewEVM(ctx, env.state, env.config, vm.Config{})
+}
+
+// call invokes a contract method, returning the unpacked results or the revert
+// reason as an error.
+func (env *testEnv) call(from common.Address, method string, args ...any) ([]any, error) {
+	input, err := ABI.Pack(method, args...)
+	if err != nil {
+		env.t.Fatalf("failed to pack %s call: %v", method, err)
+	}
+	ret, _, err := env.evm().Call(from, params.PixelzxGovernanceAddress, input, 1_000_000, new(uint256.Int))
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		reason, _ := abi.UnpackRevert(ret)
+		return nil, errors.New(reason)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ABI.Unpack(method, ret)
+}
+
+// mustCall invokes a contract method, failing the test if it reverts.
+func (env *testEnv) mustCall(from common.Address, method string, args ...any) []any {
+	out, err := env.call(from, method, args...)
+	if err != nil {
+		env.t.Fatalf("%s call failed: %v", method, err)
+	}
+	return out
+}
+
+// expectRevert invokes a contract method, failing the test unless it reverts
+// with the given reason.
+func (env *testEnv) expectRevert(reason string, from common.Address, method string, args ...any) {
+	env.t.Helper()
+	if _, err := env.call(from, method, args...); err == nil || err.Error() != reason {
+		env.t.Fatalf("%s: revert mismatch: have %v, want %q", method, err, reason)
+	}
+}
+
+// propose makes a proposal, returning its id.
+func (env *testEnv) propose(from common.Address, param Param, value *big.Int) uint64 {
+	return env.mustCall(from, "propose", uint8(param), value, "test proposal")[0].(*big.Int).Uint64()
+}
+
+// Tests that proposals are only accepted from stakers with valid values, and that
+// votes are weighted by stake and accepted once per voter during the vote.
+func TestProposeAndVote(t *testing.T) {
+	env := newTestEnv(t)
+
+	env.expectRevert("no bonded stake", common.Address{0xff}, "propose", uint8(GasLimit), big.NewInt(30_000_000), "")
+	env.expectRevert("gas limit out of range [5000, 9223372036854775807]", stakerA, "propose", uint8(GasLimit), big.NewInt(1), "")
+	env.expectRevert("maxValidators out of range", stakerA, "propose", uint8(MaxValidators), new(big.Int), "")
+	env.expectRevert("unknown parameter 9", stakerA, "propose", uint8(9), big.NewInt(1), "")
+
+	id := env.propose(stakerC, GasLimit, big.NewInt(30_000_000))
+	if id != 1 {
+		t.Fatalf("proposal id mismatch: have %d, want 1", id)
+	}
+	if count := env.mustCall(stakerA, "proposalCount")[0].(*big.Int); count.Uint64() != 1 {
+		t.Fatalf("proposal count mismatch: have %v, want 1", count)
+	}
+	env.mustCall(stakerA, "vote", big.NewInt(1), true)
+	env.mustCall(stakerB, "vote", big.NewInt(1), false)
+	env.expectRevert("already voted", stakerA, "vote", big.NewInt(1), false)
+	env.expectRevert("no bonded stake", common.Address{0xff}, "vote", big.NewInt(1), true)
+	env.expectRevert("unknown proposal", stakerA, "vote", big.NewInt(2), true)
+
+	out := env.mustCall(stakerA, "getProposal", big.NewInt(1))
+	if proposer := out[0].(common.Address); proposer != stakerC {
+		t.Errorf("proposer mismatch: have %x, want %x", proposer, stakerC)
+	}
+	if end := out[5].(*big.Int); end.Uint64() != 51 {
+		t.Errorf("voting end mismatch: have %v, want 51", end)
+	}
+	if yes, no := out[7].(*big.Int), out[8].(*big.Int); yes.Cmp(pzx(60)) != 0 || no.Cmp(pzx(30)) != 0 {
+		t.Errorf("votes mismatch: have %v/%v, want %v/%v", yes, no, pzx(60), pzx(30))
+	}
+	if voted := env.mustCall(stakerA, "hasVoted", big.NewInt(1), stakerB)[0].(bool); !voted {
+		t.Errorf("vote of B not recorded")
+	}
+	if voted := env.mustCall(stakerA, "hasVoted", big.NewInt(1), stakerC)[0].(bool); voted {
+		t.Errorf("vote of C recorded")
+	}
+	// Votes are refused once the voting period ends
+	env.number = 51
+	env.expectRevert("voting closed", stakerC, "vote", big.NewInt(1), true)
+}
+
+// Tests that ended proposals are tallied, approved ones applied after the delay
+// from the block after the epoch boundary, and the others rejected.
+func TestProcessEpoch(t *testing.T) {
+	env := newTestEnv(t)
+
+	approvedID := env.propose(stakerA, GasLimit, big.NewInt(40_000_000))
+	env.mustCall(stakerA, "vote", big.NewInt(int64(approvedID)), true)
+	env.mustCall(stakerB, "vote", big.NewInt(int64(approvedID)), false)
+
+	noQuorumID := env.propose(stakerA, BlockReward, pzx(5))
+	env.mustCall(stakerC, "vote", big.NewInt(int64(noQuorumID)), true)
+
+	noMajorityID := env.propose(stakerA, MaxValidators, big.NewInt(5))
+	env.mustCall(stakerA, "vote", big.NewInt(int64(noMajorityID)), false)
+	env.mustCall(stakerB, "vote", big.NewInt(int64(noMajorityID)), true)
+
+	// Nothing happens while the votes are open
+	if changed := ProcessEpoch(env.state, 50, env.config.Pixelzx.ExecutionDelay); len(changed) != 0 {
+		t.Fatalf("proposals processed during the vote: %v", changed)
+	}
+	// Once the votes end, they are tallied
+	changed := ProcessEpoch(env.state, 200, env.config.Pixelzx.ExecutionDelay)
+	if len(changed) != 3 {
+		t.Fatalf("changed proposals mismatch: have %d, want 3", len(changed))
+	}
+	for id, want := range map[uint64]Status{approvedID: StatusQueued, noQuorumID: StatusRejected, noMajorityID: StatusRejected} {
+		if have := Proposal(env.state, id).Status; have != want {
+			t.Errorf("proposal %d: status mismatch: have %v, want %v", id, have, want)
+		}
+	}
+	if have := Proposal(env.state, approvedID).ExecutionBlock; have != 300 {
+		t.Errorf("execution block mismatch: have %d, want 300", have)
+	}
+	if _, ok := Value(env.state, GasLimit, 201); ok {
+		t.Fatalf("queued proposal applied before its execution block")
+	}
+	// Once the delay passes, the proposal is applied from the next block on
+	if changed := ProcessEpoch(env.state, 400, env.config.Pixelzx.ExecutionDelay); len(changed) != 1 || changed[0].Status != StatusExecuted {
+		t.Fatalf("queued proposal not executed: %v", changed)
+	}
+	if _, ok := Value(env.state, GasLimit, 400); ok {
+		t.Errorf("proposal applied at the epoch block")
+	}
+	if value, ok := Value(env.state, GasLimit, 401); !ok || value.Uint64() != 40_000_000 {
+		t.Errorf("governed value mismatch: have %v/%v, want 40000000", value, ok)
+	}
+	if pending := (storage{env.state}).pending(); len(pending) != 0 {
+		t.Errorf("processed proposals left pending: %v", pending)
+	}
+	// A later change keeps answering for the blocks before it
+	env.number = 401
+	id := env.propose(stakerA, GasLimit, big.NewInt(50_000_000))
+	env.mustCall(stakerA, "vote", big.NewInt(int64(id)), true)
+	ProcessEpoch(env.state, 600, 0)
+
+	if value, _ := Value(env.state, GasLimit, 600); value.Uint64() != 40_000_000 {
+		t.Errorf("previous value mismatch: have %v, want 40000000", value)
+	}
+	if value, _ := Value(env.state, GasLimit, 601); value.Uint64() != 50_000_000 {
+		t.Errorf("new value mismatch: have %v, want 50000000", value)
+	}
+	env.number = 601
+	if out := env.mustCall(stakerA, "getParam", uint8(GasLimit)); !out[0].(bool) || out[1].(*big.Int).Uint64() != 50_000_000 {
+		t.Errorf("getParam mismatch: have %v", out)
+	}
+	if out := env.mustCall(stakerA, "getParam", uint8(MinGasPrice)); out[0].(bool) {
+		t.Errorf("ungoverned parameter reported as set: %v", out)
+	}
+}
+
+// Tests that votes weigh the stake bonded when they were cast, so neither stake
+// undelegated nor stake delegated after voting changes the tally.
+func TestVoteWeightSnapshot(t *testing.T) {
+	env := newTestEnv(t)
+
+	id := env.propose(stakerA, GasLimit, big.NewInt(40_000_000))
+	env.mustCall(stakerA, "vote", big.NewInt(int64(id)), true)
+	env.mustCall(stakerB, "vote", big.NewInt(int64(id)), false)
+
+	// A undelegates most of its stake before the vote ends, which stays locked
+	// until then, while B bonds more stake
+	input, err := staking.ABI.Pack("undelegate", stakerA, pzx(50))
+	if err != nil {
+		t.Fatalf("failed to pack undelegate call: %v", err)
+	}
+	if _, _, err := env.evm().Call(stakerA, params.PixelzxStakingAddress, input, 1_000_000, new(uint256.Int)); err != nil {
+		t.Fatalf("failed to undelegate: %v", err)
+	}
+	input, err = staking.ABI.Pack("delegate", stakerB)
+	if err != nil {
+		t.Fatalf("failed to pack delegate call: %v", err)
+	}
+	env.state.AddBalance(stakerB, uint256.MustFromBig(pzx(40)), tracing.BalanceChangeUnspecified)
+	if _, _, err := env.evm().Call(stakerB, params.PixelzxStakingAddress, input, 1_000_000, uint256.MustFromBig(pzx(40))); err != nil {
+		t.Fatalf("failed to delegate: %v", err)
+	}
+	ProcessEpoch(env.state, 200, env.config.Pixelzx.ExecutionDelay)
+
+	proposal := Proposal(env.state, id)
+	if proposal.Status != StatusQueued {
+		t.Errorf("status mismatch: have %v, want %v", proposal.Status, StatusQueued)
+	}
+	if proposal.YesVotes.Cmp(pzx(60)) != 0 || proposal.NoVotes.Cmp(pzx(30)) != 0 {
+		t.Errorf("votes mismatch: have %v/%v, want %v/%v", proposal.YesVotes, proposal.NoVotes, pzx(60), pzx(30))
+	}
+}
+
+// Tests that the number of pending proposals is capped.
+func TestPendingProposalLimit(t *testing.T) {
+	env := newTestEnv(t)
+	for i := 0; i < maxPendingProposals; i++ {
+		env.propose(stakerA, MinGasPrice, big.NewInt(int64(i)))
+	}
+	env.expectRevert("too many pending proposals", stakerA, "propose", uint8(MinGasPrice), big.NewInt(1), "")
+
+	// Processed proposals free up their place
+	ProcessEpoch(env.state, 200, 0)
+	env.number = 201
+	env.propose(stakerA, MinGasPrice, big.NewInt(1))
+}
@@ -0,0 +1,273 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package governance implements the PIXELZX governance system contract.
+//
+// The contract lives at params.PixelzxGovernanceAddress on networks configured
+// with a governance voting period. Stakers propose changes to a fixed set of
+// network parameters and vote on them with the stake they bonded in the staking
+// contract. At epoch boundaries the consensus engine tallies the proposals whose
+// vote ended, queues the approved ones for the configured execution delay, and
+// then applies them: the engine and the nodes read the governed values straight
+// from the contract's storage.
+package governance
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+const (
+	maxBasisPoints = 10_000 // Basis points of a whole
+	quorum         = 3_334  // Share of the bonded stake that must vote on a proposal, in basis points
+	threshold      = 5_000  // Share of the votes cast that must be exceeded by the votes in favour, in basis points
+)
+
+// Param is a network parameter that can be changed by governance.
+type Param uint8
+
+const (
+	GasLimit      Param = iota + 1 // Gas limit block producers move the block gas limit towards
+	MinGasPrice                    // Minimum gas price (tip) transactions are accepted and included with
+	BlockReward                    // Amount of wei issued per block, overriding the reward schedule
+	MaxValidators                  // Maximum number of active validators per epoch
+)
+
+var paramNames = map[Param]string{
+	GasLimit:      "gasLimit",
+	MinGasPrice:   "minGasPrice",
+	BlockReward:   "blockReward",
+	MaxValidators: "maxValidators",
+}
+
+// Params lists all governed parameters.
+var Params = []Param{GasLimit, MinGasPrice, BlockReward, MaxValidators}
+
+// String implements the stringer interface.
+func (p Param) String() string {
+	if name, ok := paramNames[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(p))
+}
+
+// ParseParam returns the governed parameter of the given name.
+func ParseParam(name string) (Param, error) {
+	for param, n := range paramNames {
+		if n == name {
+			return param, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown governance parameter %q", name)
+}
+
+// check validates a value proposed for the parameter.
+func (p Param) check(value *big.Int) error {
+	switch p {
+	case GasLimit:
+		if value.Cmp(new(big.Int).SetUint64(params.MinGasLimit)) < 0 || value.Cmp(new(big.Int).SetUint64(params.MaxGasLimit)) > 0 {
+			return fmt.Errorf("gas limit out of range [%d, %d]", params.MinGasLimit, params.MaxGasLimit)
+		}
+	case MinGasPrice, BlockReward:
+		// Leave headroom for the reward and fee arithmetic
+		if value.BitLen() > 128 {
+			return fmt.Errorf("%s out of range", p)
+		}
+	case MaxValidators:
+		if value.Sign() == 0 || !value.IsUint64() {
+			return fmt.Errorf("%s out of range", p)
+		}
+	default:
+		return fmt.Errorf("unknown parameter %d", uint8(p))
+	}
+	return nil
+}
+
+// Status is the stage a proposal is at.
+type Status uint8
+
+const (
+	StatusVoting   Status = iota // Open for voting until the voting end
+	StatusQueued                 // Approved, waiting for its execution block
+	StatusRejected               // Rejected for lack of quorum or majority
+	StatusExecuted               // Approved and applied
+)
+
+var statusNames = []string{"voting", "queued", "rejected", "executed"}
+
+// String implements the stringer interface.
+func (s Status) String() string {
+	if int(s) < len(statusNames) {
+		return statusNames[s]
+	}
+	return fmt.Sprintf("unknown(%d)", uint8(s))
+}
+
+// ProposalInfo is the record of a governance proposal.
+type ProposalInfo struct {
+	ID             uint64         // Sequential number of the proposal, starting at 1
+	Proposer       common.Address // Staker the proposal was made by
+	Param          Param          // Parameter the proposal changes
+	Value          *big.Int       // Value the parameter is set to
+	Description    string         // Human readable rationale of the proposal
+	Status         Status         // Stage the proposal is at
+	VotingEnd      uint64         // Block number from which votes are no longer accepted
+	ExecutionBlock uint64         // Block number from which an approved proposal is applied, at the next epoch boundary
+	YesVotes       *big.Int       // Stake voting in favour of the proposal
+	NoVotes        *big.Int       // Stake voting against the proposal
+}
+
+// ProposalCount returns the number of proposals made.
+func ProposalCount(db StateDB) uint64 {
+	return storage{db}.proposalCount()
+}
+
+// Proposal returns the record of a proposal, or nil if there is none with the
+// given id.
+func Proposal(db StateDB, id uint64) *ProposalInfo {
+	s := storage{db}
+	if id == 0 || id > s.proposalCount() {
+		return nil
+	}
+	return s.proposal(id)
+}
+
+// Value returns the value of a governed parameter in effect at the block with
+// the given number, or false if governance didn't set the parameter by then and
+// the configured one applies.
+func Value(db StateDB, param Param, number uint64) (*big.Int, bool) {
+	value, ok := storage{db}.param(param, number)
+	if !ok {
+		return nil, false
+	}
+	return value.ToBig(), true
+}
+
+// VotingPower returns the stake a voter bonded to all validators, which is the
+// weight of the votes it casts.
+func VotingPower(db StateDB, voter common.Address) *big.Int {
+	power := new(big.Int)
+	for _, validator := range staking.Validators(db) {
+		power.Add(power, staking.Delegation(db, voter, validator))
+	}
+	return power
+}
+
+// bondedStake returns the stake bonded to all validators.
+func bondedStake(db StateDB) *big.Int {
+	total := new(big.Int)
+	for _, validator := range staking.Validators(db) {
+		total.Add(total, staking.TotalStake(db, validator))
+	}
+	return total
+}
+
+// tally sums the votes cast on a proposal, each weighing the stake its voter
+// bonded when casting it. Stake undelegated after voting stays locked for the
+// unbonding period, which outlasts the voting period, so it can't vote twice
+// from another account. The final votes are recorded with the proposal.
+func tally(db StateDB, id uint64) (yes, no *big.Int) {
+	var (
+		s        = storage{db}
+		yesVotes = new(uint256.Int)
+		noVotes  = new(uint256.Int)
+	)
+	for _, voter := range s.voters(id) {
+		support, weight := s.vote(id, voter)
+		if support {
+			yesVotes.Add(yesVotes, weight)
+		} else {
+			noVotes.Add(noVotes, weight)
+		}
+	}
+	s.setVotes(id, yesVotes, noVotes)
+	return yesVotes.ToBig(), noVotes.ToBig()
+}
+
+// approved returns whether the votes cast on a proposal reach the quorum of the
+// bonded stake and a majority in favour.
+func approved(yes, no, bonded *big.Int) bool {
+	turnout := new(big.Int).Add(yes, no)
+	if bonded.Sign() == 0 || turnout.Sign() == 0 {
+		return false
+	}
+	if new(big.Int).Mul(turnout, big.NewInt(maxBasisPoints)).Cmp(new(big.Int).Mul(bonded, big.NewInt(quorum))) < 0 {
+		return false
+	}
+	return new(big.Int).Mul(yes, big.NewInt(maxBasisPoints)).Cmp(new(big.Int).Mul(turnout, big.NewInt(threshold))) > 0
+}
+
+// ProcessEpoch processes the pending proposals at the epoch block with the given
+// number. Proposals whose vote ended are tallied against the current bonded
+// stake, queuing the approved ones for the given execution delay. Queued ones
+// due are then applied, changing their parameter from the next block on. It
+// returns the proposals that moved to another stage.
+func ProcessEpoch(db StateDB, number uint64, delay uint64) []*ProposalInfo {
+	var (
+		s       = storage{db}
+		pending = s.pending()
+		bonded  = bondedStake(db)
+		kept    []uint64
+		changed []*ProposalInfo
+	)
+	for _, id := range pending {
+		var (
+			proposal = s.proposal(id)
+			status   = proposal.Status
+		)
+		if status == StatusVoting && proposal.VotingEnd <= number {
+			proposal.YesVotes, proposal.NoVotes = tally(db, id)
+			if approved(proposal.YesVotes, proposal.NoVotes, bonded) {
+				status, proposal.ExecutionBlock = StatusQueued, number+delay
+				s.setExecutionBlock(id, proposal.ExecutionBlock)
+			} else {
+				status = StatusRejected
+			}
+		}
+		if status == StatusQueued && proposal.ExecutionBlock <= number {
+			s.setParam(proposal.Param, uint256.MustFromBig(proposal.Value), number+1)
+			status = StatusExecuted
+		}
+		if status != proposal.Status {
+			s.setStatus(id, status)
+			proposal.Status = status
+			changed = append(changed, proposal)
+		}
+		if status == StatusVoting || status == StatusQueued {
+			kept = append(kept, id)
+		}
+	}
+	if len(kept) != len(pending) {
+		s.setPending(kept)
+	}
+	return changed
+}
+
+// GenesisAccount creates the genesis allocation of the governance contract, with
+// no proposals made and no parameters governed yet.
+func GenesisAccount() types.Account {
+	return types.Account{
+		Code:    params.PixelzxGovernanceCode,
+		Balance: new(big.Int),
+		Nonce:   1,
+	}
+}
@@ -0,0 +1,328 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package governance
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// StateDB is the subset of the state database the governance contract storage
+// is accessed through, the same as the staking contract's one.
+type StateDB interface {
+	GetState(common.Address, common.Hash) common.Hash
+	SetState(common.Address, common.Hash, common.Hash) common.Hash
+}
+
+// Storage layout of the governance contract, namespaced like the staking one:
+//
+//	keccak("proposals")                  number of proposals made
+//	keccak("proposal" ‖ id) + offset     proposal record fields
+//	keccak("vote" ‖ id ‖ voter) + offset vote fields of a voter on the proposal
+//	keccak("voters" ‖ id)                number of voters on the proposal
+//	keccak(keccak("voters" ‖ id)) + i    address of the i-th voter on the proposal
+//	keccak("pending")                    number of proposals being voted on or queued
+//	keccak(keccak("pending")) + i        id of the i-th pending proposal
+//	keccak("param" ‖ param) + offset     governed parameter fields
+var proposalsSlot = slot("proposals")
+
+// pendingSlot is the head of the list of proposals not yet rejected or executed,
+// which are processed by the consensus engine at epoch boundaries.
+var pendingSlot = slot("pending")
+
+// Field offsets within a proposal record.
+const (
+	proposalProposerOffset    = iota // Address the proposal was made by
+	proposalParamOffset              // Parameter the proposal changes
+	proposalValueOffset              // Value the parameter is set to
+	proposalStatusOffset             // Stage of the proposal
+	proposalVotingEndOffset          // Block number from which votes are no longer accepted
+	proposalExecutionOffset          // Block number from which an approved proposal may be applied
+	proposalYesOffset                // Stake voting in favour of the proposal
+	proposalNoOffset                 // Stake voting against the proposal
+	proposalDescriptionOffset        // Human readable rationale of the proposal
+)
+
+// Field offsets within a vote record.
+const (
+	voteWeightOffset  = iota // Stake bonded by the voter when voting, zero if it didn't vote
+	voteSupportOffset        // Set if the vote is in favour of the proposal
+)
+
+// Field offsets within a governed parameter record.
+const (
+	paramSetOffset        = iota // Set if the parameter was ever changed by governance
+	paramValueOffset             // Current value of the parameter
+	paramPrevSetOffset           // Set if the parameter was governed before the last change
+	paramPrevValueOffset         // Value of the parameter before the last change
+	paramActivationOffset        // Block number from which the current value applies
+)
+
+// slot derives a storage slot from a tag and a list of keys.
+func slot(tag string, keys ...common.Hash) common.Hash {
+	data := []byte(tag)
+	for _, key := range keys {
+		data = append(data, key.Bytes()...)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// idKey returns the storage key of a proposal id or parameter.
+func idKey(id uint64) common.Hash {
+	return uint256.NewInt(id).Bytes32()
+}
+
+// offset returns the storage slot n positions after the given one.
+func offset(slot common.Hash, n uint64) common.Hash {
+	pos := new(uint256.Int).SetBytes32(slot[:])
+	return pos.AddUint64(pos, n).Bytes32()
+}
+
+// storage is a typed accessor over the governance contract's storage.
+type storage struct {
+	db StateDB
+}
+
+func (s storage) get(slot common.Hash) common.Hash {
+	return s.db.GetState(params.PixelzxGovernanceAddress, slot)
+}
+
+func (s storage) set(slot common.Hash, value common.Hash) {
+	s.db.SetState(params.PixelzxGovernanceAddress, slot, value)
+}
+
+func (s storage) getUint(slot common.Hash) *uint256.Int {
+	value := s.get(slot)
+	return new(uint256.Int).SetBytes32(value[:])
+}
+
+func (s storage) setUint(slot common.Hash, value *uint256.Int) {
+	s.set(slot, value.Bytes32())
+}
+
+func (s storage) getBool(slot common.Hash) bool {
+	return s.get(slot) != (common.Hash{})
+}
+
+func (s storage) setBool(slot common.Hash, value bool) {
+	var word common.Hash
+	if value {
+		word[common.HashLength-1] = 1
+	}
+	s.set(slot, word)
+}
+
+// getBytes reads a dynamic byte array, stored as its length followed by 32 byte
+// chunks starting at the hash of the slot.
+func (s storage) getBytes(slot common.Hash) []byte {
+	size := s.getUint(slot).Uint64()
+	if size == 0 {
+		return nil
+	}
+	var (
+		data = make([]byte, 0, size+31)
+		base = crypto.Keccak256Hash(slot[:])
+	)
+	for i := uint64(0); uint64(len(data)) < size; i++ {
+		chunk := s.get(offset(base, i))
+		data = append(data, chunk[:]...)
+	}
+	return data[:size]
+}
+
+// setBytes writes a dynamic byte array. Proposals are never rewritten, so there
+// are no chunks of a previous value to clear.
+func (s storage) setBytes(slot common.Hash, data []byte) {
+	base := crypto.Keccak256Hash(slot[:])
+	s.setUint(slot, uint256.NewInt(uint64(len(data))))
+	for i := uint64(0); i*32 < uint64(len(data)); i++ {
+		var chunk common.Hash
+		copy(chunk[:], data[i*32:])
+		s.set(offset(base, i), chunk)
+	}
+}
+
+// proposalCount returns the number of proposals made, which is also the id of
+// the last one.
+func (s storage) proposalCount() uint64 {
+	return s.getUint(proposalsSlot).Uint64()
+}
+
+func (s storage) setProposalCount(count uint64) {
+	s.setUint(proposalsSlot, uint256.NewInt(count))
+}
+
+func (s storage) proposalField(id uint64, field uint64) common.Hash {
+	return offset(slot("proposal", idKey(id)), field)
+}
+
+// proposal reads the record of an existing proposal.
+func (s storage) proposal(id uint64) *ProposalInfo {
+	return &ProposalInfo{
+		ID:             id,
+		Proposer:       common.BytesToAddress(s.get(s.proposalField(id, proposalProposerOffset)).Bytes()),
+		Param:          Param(s.getUint(s.proposalField(id, proposalParamOffset)).Uint64()),
+		Value:          s.getUint(s.proposalField(id, proposalValueOffset)).ToBig(),
+		Description:    string(s.getBytes(s.proposalField(id, proposalDescriptionOffset))),
+		Status:         Status(s.getUint(s.proposalField(id, proposalStatusOffset)).Uint64()),
+		VotingEnd:      s.getUint(s.proposalField(id, proposalVotingEndOffset)).Uint64(),
+		ExecutionBlock: s.getUint(s.proposalField(id, proposalExecutionOffset)).Uint64(),
+		YesVotes:       s.getUint(s.proposalField(id, proposalYesOffset)).ToBig(),
+		NoVotes:        s.getUint(s.proposalField(id, proposalNoOffset)).ToBig(),
+	}
+}
+
+// setProposal writes the immutable fields of a new proposal.
+func (s storage) setProposal(id uint64, proposer common.Address, param Param, value *uint256.Int, description string, votingEnd uint64) {
+	s.set(s.proposalField(id, proposalProposerOffset), common.BytesToHash(proposer.Bytes()))
+	s.setUint(s.proposalField(id, proposalParamOffset), uint256.NewInt(uint64(param)))
+	s.setUint(s.proposalField(id, proposalValueOffset), value)
+	s.setBytes(s.proposalField(id, proposalDescriptionOffset), []byte(description))
+	s.setUint(s.proposalField(id, proposalVotingEndOffset), uint256.NewInt(votingEnd))
+}
+
+func (s storage) status(id uint64) Status {
+	return Status(s.getUint(s.proposalField(id, proposalStatusOffset)).Uint64())
+}
+
+func (s storage) setStatus(id uint64, status Status) {
+	s.setUint(s.proposalField(id, proposalStatusOffset), uint256.NewInt(uint64(status)))
+}
+
+func (s storage) votingEnd(id uint64) uint64 {
+	return s.getUint(s.proposalField(id, proposalVotingEndOffset)).Uint64()
+}
+
+func (s storage) setExecutionBlock(id uint64, number uint64) {
+	s.setUint(s.proposalField(id, proposalExecutionOffset), uint256.NewInt(number))
+}
+
+// setVotes overwrites the stake voting in favour of and against a proposal.
+func (s storage) setVotes(id uint64, yes, no *uint256.Int) {
+	s.setUint(s.proposalField(id, proposalYesOffset), yes)
+	s.setUint(s.proposalField(id, proposalNoOffset), no)
+}
+
+func (s storage) voteField(id uint64, voter common.Address, field uint64) common.Hash {
+	return offset(slot("vote", idKey(id), common.BytesToHash(voter.Bytes())), field)
+}
+
+// vote reads the vote of a voter on a proposal, with a zero weight if it didn't
+// vote.
+func (s storage) vote(id uint64, voter common.Address) (support bool, weight *uint256.Int) {
+	return s.getBool(s.voteField(id, voter, voteSupportOffset)), s.getUint(s.voteField(id, voter, voteWeightOffset))
+}
+
+func (s storage) voted(id uint64, voter common.Address) bool {
+	_, weight := s.vote(id, voter)
+	return !weight.IsZero()
+}
+
+// addVote records the vote of a new voter on a proposal, adding its stake to the
+// votes in favour of or against it.
+func (s storage) addVote(id uint64, voter common.Address, support bool, weight *uint256.Int) {
+	s.setUint(s.voteField(id, voter, voteWeightOffset), weight)
+	s.setBool(s.voteField(id, voter, voteSupportOffset), support)
+
+	var (
+		head  = slot("voters", idKey(id))
+		count = s.getUint(head).Uint64()
+	)
+	s.set(offset(crypto.Keccak256Hash(head[:]), count), common.BytesToHash(voter.Bytes()))
+	s.setUint(head, uint256.NewInt(count+1))
+
+	field := s.proposalField(id, proposalNoOffset)
+	if support {
+		field = s.proposalField(id, proposalYesOffset)
+	}
+	votes := s.getUint(field)
+	s.setUint(field, votes.Add(votes, weight))
+}
+
+// voters returns the addresses which voted on a proposal, in the order they
+// voted.
+func (s storage) voters(id uint64) []common.Address {
+	var (
+		head  = slot("voters", idKey(id))
+		base  = crypto.Keccak256Hash(head[:])
+		count = s.getUint(head).Uint64()
+		list  = make([]common.Address, count)
+	)
+	for i := uint64(0); i < count; i++ {
+		list[i] = common.BytesToAddress(s.get(offset(base, i)).Bytes())
+	}
+	return list
+}
+
+// pending returns the ids of the proposals being voted on or queued, in the order
+// they were made.
+func (s storage) pending() []uint64 {
+	var (
+		base  = crypto.Keccak256Hash(pendingSlot[:])
+		count = s.getUint(pendingSlot).Uint64()
+		list  = make([]uint64, count)
+	)
+	for i := uint64(0); i < count; i++ {
+		list[i] = s.getUint(offset(base, i)).Uint64()
+	}
+	return list
+}
+
+// setPending overwrites the list of pending proposals, clearing the slots beyond
+// the new list.
+func (s storage) setPending(list []uint64) {
+	var (
+		base = crypto.Keccak256Hash(pendingSlot[:])
+		prev = s.getUint(pendingSlot).Uint64()
+	)
+	for i := uint64(0); i < uint64(len(list)) || i < prev; i++ {
+		var id uint64
+		if i < uint64(len(list)) {
+			id = list[i]
+		}
+		s.setUint(offset(base, i), uint256.NewInt(id))
+	}
+	s.setUint(pendingSlot, uint256.NewInt(uint64(len(list))))
+}
+
+func (s storage) paramField(param Param, field uint64) common.Hash {
+	return offset(slot("param", idKey(uint64(param))), field)
+}
+
+// param returns the value of a governed parameter in effect at the given block,
+// or false if the parameter was not set by governance by then.
+func (s storage) param(param Param, number uint64) (*uint256.Int, bool) {
+	if number >= s.getUint(s.paramField(param, paramActivationOffset)).Uint64() {
+		return s.getUint(s.paramField(param, paramValueOffset)), s.getBool(s.paramField(param, paramSetOffset))
+	}
+	return s.getUint(s.paramField(param, paramPrevValueOffset)), s.getBool(s.paramField(param, paramPrevSetOffset))
+}
+
+// setParam changes a governed parameter from the given block on. The previous
+// value is kept, answering the lookups of blocks before the change. Changes made
+// for the same block replace each other, keeping the value preceding all.
+func (s storage) setParam(param Param, value *uint256.Int, activation uint64) {
+	if s.getUint(s.paramField(param, paramActivationOffset)).Uint64() != activation {
+		s.set(s.paramField(param, paramPrevSetOffset), s.get(s.paramField(param, paramSetOffset)))
+		s.set(s.paramField(param, paramPrevValueOffset), s.get(s.paramField(param, paramValueOffset)))
+	}
+	s.setBool(s.paramField(param, paramSetOffset), true)
+	s.setUint(s.paramField(param, paramValueOffset), value)
+	s.setUint(s.paramField(param, paramActivationOffset), uint256.NewInt(activation))
+}
@@ -0,0 +1,149 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/governance"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that parameters voted by governance are applied by the engine from the
+// block after the epoch boundary their execution delay ends at: the gas limit
+// moves towards the governed target, the governed block reward is issued and the
+// validator set is capped at the governed maximum.
+func TestGovernedParams(t *testing.T) {
+	b := newCustomTestBackend(t, func(config *params.PixelzxConfig) {
+		config.VotingPeriod = 8
+		config.ExecutionDelay = 8
+	}, 1, 2, 5)
+
+	parent := b.chain.Genesis()
+	advance := func(txs ...*types.Transaction) {
+		block := b.makeBlockWithTxs(parent, b.proposer(parent), 0, txs, nil)
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+		for i, receipt := range b.chain.GetReceiptsByHash(block.Hash()) {
+			if receipt.Status != types.ReceiptStatusSuccessful {
+				t.Fatalf("block %d, tx %d: governance call failed", block.NumberU64(), i)
+			}
+		}
+		parent = block
+	}
+	// Propose all changes with the largest staker, and vote them in along with
+	// the second largest one
+	targetGasLimit := parent.GasLimit() + 100_000
+	advance(
+		b.governanceTx(b.keys[2], 0, "propose", uint8(governance.GasLimit), new(big.Int).SetUint64(targetGasLimit), "raise gas limit"),
+		b.governanceTx(b.keys[2], 1, "propose", uint8(governance.BlockReward), pzx(3), "issue rewards"),
+		b.governanceTx(b.keys[2], 2, "propose", uint8(governance.MaxValidators), big.NewInt(2), "shrink validator set"),
+	)
+	advance(
+		b.governanceTx(b.keys[2], 3, "vote", big.NewInt(1), true),
+		b.governanceTx(b.keys[2], 4, "vote", big.NewInt(2), true),
+		b.governanceTx(b.keys[2], 5, "vote", big.NewInt(3), true),
+		b.governanceTx(b.keys[1], 0, "vote", big.NewInt(1), true),
+		b.governanceTx(b.keys[1], 1, "vote", big.NewInt(2), true),
+		b.governanceTx(b.keys[1], 2, "vote", big.NewInt(3), true),
+	)
+	// The votes end at block 9, get tallied at block 16 and executed at block 24
+	for parent.NumberU64() < 24 {
+		advance()
+		if parent.GasLimit() != b.chain.Genesis().GasLimit() {
+			t.Fatalf("block %d: gas limit changed before execution", parent.NumberU64())
+		}
+	}
+	statedb, _ := b.chain.StateAt(parent.Root())
+	for id := uint64(1); id <= 3; id++ {
+		if status := governance.Proposal(statedb, id).Status; status != governance.StatusExecuted {
+			t.Fatalf("proposal %d: status mismatch: have %v, want %v", id, status, governance.StatusExecuted)
+		}
+	}
+	snap, err := b.engine.snapshot(b.chain, parent.NumberU64(), parent.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve snapshot: %v", err)
+	}
+	if validators := snap.validators(); len(validators) != 2 {
+		t.Fatalf("validator count mismatch: have %d, want 2", len(validators))
+	}
+	// The block after the checkpoint applies the governed gas limit and reward
+	before := statedb.GetBalance(crypto.PubkeyToAddress(b.proposer(parent).PublicKey)).ToBig()
+	gasLimit := parent.GasLimit()
+	advance()
+
+	if want := misc.CalcGasLimit(gasLimit, targetGasLimit); parent.GasLimit() != want || want == gasLimit {
+		t.Errorf("gas limit mismatch: have %d, want %d", parent.GasLimit(), want)
+	}
+	statedb, _ = b.chain.StateAt(parent.Root())
+	after := statedb.GetBalance(parent.Coinbase()).ToBig()
+	if diff := new(big.Int).Sub(after, before); diff.Cmp(pzx(3)) != 0 {
+		t.Errorf("block reward mismatch: have %v, want %v", diff, pzx(3))
+	}
+	// Blocks ignoring the governed gas limit are rejected
+	block := b.makeBlock(parent, b.proposer(parent), 0, func(header *types.Header) {
+		header.GasLimit = parent.GasLimit()
+	})
+	if _, err := b.chain.InsertChain(types.Blocks{block}); !errors.Is(err, errInvalidGasLimit) {
+		t.Fatalf("error mismatch: have %v, want %v", err, errInvalidGasLimit)
+	}
+}
+
+// Tests that governance scheduled in a hard-fork is enabled from the fork block
+// on: the system contract is installed before the transactions of the block, and
+// calls made before it have no effect.
+func TestGovernanceFork(t *testing.T) {
+	b := newCustomTestBackend(t, func(config *params.PixelzxConfig) {
+		config.VotingPeriod = 8
+		config.ExecutionDelay = 8
+		config.GovernanceBlock = big.NewInt(2)
+	}, 1, 2, 5)
+
+	parent := b.chain.Genesis()
+	for nonce := uint64(0); nonce < 2; nonce++ {
+		tx := b.governanceTx(b.keys[2], nonce, "propose", uint8(governance.GasLimit), big.NewInt(40_000_000), "raise gas limit")
+		block := b.makeBlockWithTxs(parent, b.proposer(parent), 0, []*types.Transaction{tx}, nil)
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+		parent = block
+
+		statedb, _ := b.chain.StateAt(parent.Root())
+		installed := bytes.Equal(statedb.GetCode(params.PixelzxGovernanceAddress), params.PixelzxGovernanceCode)
+		if want := parent.NumberU64() >= 2; installed != want {
+			t.Fatalf("block %d: governance installed mismatch: have %v, want %v", parent.NumberU64(), installed, want)
+		}
+		if installed && statedb.GetNonce(params.PixelzxGovernanceAddress) != 1 {
+			t.Fatalf("block %d: governance nonce mismatch: have %d, want 1", parent.NumberU64(), statedb.GetNonce(params.PixelzxGovernanceAddress))
+		}
+	}
+	// Only the proposal made from the fork block on is recorded
+	statedb, _ := b.chain.StateAt(parent.Root())
+	if count := governance.ProposalCount(statedb); count != 1 {
+		t.Fatalf("proposal count mismatch: have %d, want 1", count)
+	}
+	if proposal := governance.Proposal(statedb, 1); proposal.VotingEnd != 2+8 {
+		t.Fatalf("voting end mismatch: have %d, want %d", proposal.VotingEnd, 2+8)
+	}
+}
@@ -0,0 +1,248 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keys
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// InterchangeVersion is the version of the slashing protection interchange
+// format.
+const InterchangeVersion = "1"
+
+// Interchange is the slashing protection interchange format, carrying the
+// records of consensus keys from one host to another along with them.
+type Interchange struct {
+	Metadata InterchangeMetadata `json:"metadata"`
+	Data     []InterchangeKey    `json:"data"`
+}
+
+// InterchangeMetadata identifies the format and the chain of the records.
+type InterchangeMetadata struct {
+	Version     string      `json:"interchange_format_version"`
+	GenesisHash common.Hash `json:"genesis_hash"`
+}
+
+// InterchangeKey is the recent seals and the highest vote signed by a consensus
+// key.
+type InterchangeKey struct {
+	Address      common.Address     `json:"address"`
+	SignedBlocks []InterchangeBlock `json:"signed_blocks,omitempty"`
+	SignedVote   *InterchangeEntry  `json:"signed_vote,omitempty"`
+}
+
+// InterchangeBlock is a block sealed by a consensus key: its height, parent and
+// seal hash. A zero seal hash stands for conflicting seals merged at the height
+// on the parent, refusing any further one.
+type InterchangeBlock struct {
+	Number     uint64      `json:"number,string"`
+	ParentHash common.Hash `json:"parent_hash"`
+	Hash       common.Hash `json:"hash"`
+}
+
+// InterchangeEntry is a block attested by a consensus key: its height and hash,
+// along with the source of the attestation. A zero hash stands for conflicting
+// votes merged at the height, refusing any further one.
+type InterchangeEntry struct {
+	Number uint64            `json:"number,string"`
+	Hash   common.Hash       `json:"hash"`
+	Source *InterchangeEntry `json:"source,omitempty"`
+}
+
+// Export returns the records of all consensus keys in the interchange format,
+// for the chain with the given genesis hash.
+func (p *Protection) Export(genesis common.Hash) (*Interchange, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	records := make(map[common.Address]*InterchangeKey)
+	for _, prefix := range [][]byte{blockRecordPrefix, voteRecordPrefix} {
+		it := p.db.NewIterator(prefix, nil)
+		for it.Next() {
+			if len(it.Key()) != len(prefix)+common.AddressLength {
+				continue
+			}
+			addr := common.BytesToAddress(it.Key()[len(prefix):])
+			if records[addr] == nil {
+				records[addr] = &InterchangeKey{Address: addr}
+			}
+			var err error
+			if bytes.Equal(prefix, blockRecordPrefix) {
+				var seals []sealRecord
+				if err = rlp.DecodeBytes(it.Value(), &seals); err == nil {
+					for _, seal := range seals {
+						records[addr].SignedBlocks = append(records[addr].SignedBlocks, InterchangeBlock(seal))
+					}
+				}
+			} else {
+				rec := new(record)
+				if err = rlp.DecodeBytes(it.Value(), rec); err == nil {
+					records[addr].SignedVote = &InterchangeEntry{
+						Number: rec.Number,
+						Hash:   rec.Hash,
+						Source: &InterchangeEntry{Number: rec.SourceNumber, Hash: rec.SourceHash},
+					}
+				}
+			}
+			if err != nil {
+				it.Release()
+				return nil, fmt.Errorf("slashing protection record corrupted: %v", err)
+			}
+		}
+		err := it.Error()
+		it.Release()
+		if err != nil {
+			return nil, err
+		}
+	}
+	out := &Interchange{
+		Metadata: InterchangeMetadata{Version: InterchangeVersion, GenesisHash: genesis},
+		Data:     make([]InterchangeKey, 0, len(records)),
+	}
+	for _, key := range records {
+		out.Data = append(out.Data, *key)
+	}
+	slices.SortFunc(out.Data, func(a, b InterchangeKey) int { return a.Address.Cmp(b.Address) })
+	return out, nil
+}
+
+// Import merges records in the interchange format, of the chain with the given
+// genesis hash, into the local ones. Imported seals are added to the local ones,
+// differing seals at the same height on the same parent being merged into one
+// refusing any seal there. The higher of the imported and the local vote record
+// of a key is kept, along with the higher of their sources; differing records at
+// the same height are merged into one refusing any vote at that height. It
+// returns the number of records changed.
+func (p *Protection) Import(in *Interchange, genesis common.Hash) (int, error) {
+	if in.Metadata.Version != InterchangeVersion {
+		return 0, fmt.Errorf("unsupported interchange format version %q", in.Metadata.Version)
+	}
+	if in.Metadata.GenesisHash != genesis {
+		return 0, fmt.Errorf("interchange of another chain: genesis %x, local genesis %x", in.Metadata.GenesisHash, genesis)
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	var (
+		batch   = p.db.NewBatch()
+		seals   = make(map[string][]sealRecord) // seal records written to the batch, by key
+		votes   = make(map[string]record)       // vote records written to the batch, by key
+		changed int
+	)
+	for _, key := range in.Data {
+		if len(key.SignedBlocks) > 0 {
+			dbkey := slices.Concat(blockRecordPrefix, key.Address.Bytes())
+			local, ok := seals[string(dbkey)]
+			if !ok {
+				var err error
+				if local, err = p.readSeals(dbkey); err != nil {
+					return 0, err
+				}
+			}
+			merged := slices.Clone(local)
+			for _, imported := range key.SignedBlocks {
+				merged = mergeSeal(merged, sealRecord(imported))
+			}
+			if merged = pruneSeals(merged); !slices.Equal(merged, local) {
+				blob, err := rlp.EncodeToBytes(merged)
+				if err != nil {
+					return 0, err
+				}
+				if err := batch.Put(dbkey, blob); err != nil {
+					return 0, err
+				}
+				if _, ok := seals[string(dbkey)]; !ok {
+					changed++
+				}
+				seals[string(dbkey)] = merged
+			}
+		}
+		if key.SignedVote != nil {
+			dbkey := slices.Concat(voteRecordPrefix, key.Address.Bytes())
+			last, err := p.readVote(dbkey)
+			if err != nil {
+				return 0, err
+			}
+			if rec, ok := votes[string(dbkey)]; ok {
+				last = &rec
+			}
+			next := record{Number: key.SignedVote.Number, Hash: key.SignedVote.Hash}
+			if source := key.SignedVote.Source; source != nil {
+				next.SourceNumber, next.SourceHash = source.Number, source.Hash
+			}
+			if last != nil {
+				if next = mergeVotes(*last, next); next == *last {
+					continue
+				}
+			}
+			blob, err := rlp.EncodeToBytes(&next)
+			if err != nil {
+				return 0, err
+			}
+			if err := batch.Put(dbkey, blob); err != nil {
+				return 0, err
+			}
+			if _, ok := votes[string(dbkey)]; !ok {
+				changed++
+			}
+			votes[string(dbkey)] = next
+		}
+	}
+	if err := batch.Write(); err != nil {
+		return 0, err
+	}
+	return changed, p.db.SyncKeyValue()
+}
+
+// mergeSeal adds a seal record to the records of a key, refusing any seal at its
+// height on its parent if a different one is recorded there.
+func mergeSeal(seals []sealRecord, next sealRecord) []sealRecord {
+	for i, seal := range seals {
+		if seal.Number == next.Number && seal.ParentHash == next.ParentHash {
+			if seal != next {
+				seals[i].Hash = common.Hash{}
+			}
+			return seals
+		}
+	}
+	return append(seals, next)
+}
+
+// mergeVotes combines two vote records of a key into one refusing every vote
+// either of them refuses.
+func mergeVotes(a, b record) record {
+	if a == b {
+		return a
+	}
+	// Keep the record of the highest vote, refusing any vote at its height if
+	// the other one is at the same height, or links from a higher source
+	if b.Number > a.Number {
+		a, b = b, a
+	}
+	if b.Number == a.Number {
+		a.Hash = common.Hash{}
+	}
+	if b.SourceNumber > a.SourceNumber {
+		a.Hash, a.SourceNumber, a.SourceHash = common.Hash{}, b.SourceNumber, b.SourceHash
+	}
+	return a
+}
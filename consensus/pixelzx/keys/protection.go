@@ -0,0 +1,244 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keys
+
+import (
+	"cmp"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// sealWindow is the number of heights below the highest block sealed by a key
+// the records of its seals are kept for. Seals further below are refused, reorgs
+// that deep are prevented by finality anyway.
+const sealWindow = 256
+
+// ErrSlashable is returned if signing a consensus message would conflict with
+// one signed before, getting the validator slashed.
+var ErrSlashable = errors.New("slashable signature refused")
+
+var (
+	blockRecordPrefix = []byte("pixelzx-protection-block-") // blockRecordPrefix + address -> recently sealed block records
+	voteRecordPrefix  = []byte("pixelzx-protection-vote-")  // voteRecordPrefix + address -> signed vote record
+)
+
+// sealRecord is a block sealed by a key. A zero hash refuses any seal at the
+// height on the parent, standing for conflicting ones merged by an import.
+type sealRecord struct {
+	Number     uint64      // Height of the sealed block
+	ParentHash common.Hash // Parent of the sealed block
+	Hash       common.Hash // Seal hash of the sealed block
+}
+
+// record is the highest vote signed by a key. A zero hash refuses any vote at the
+// height, standing for conflicting ones merged by an import.
+type record struct {
+	Number       uint64      // Height of the attested block
+	Hash         common.Hash // Hash of the attested block
+	SourceNumber uint64      `rlp:"optional"` // Height of the source of the attestation
+	SourceHash   common.Hash `rlp:"optional"` // Hash of the source of the attestation
+}
+
+// Protection keeps records of the blocks sealed and attested by consensus keys,
+// refusing to sign messages conflicting with them:
+//
+//   - a seal of a different block at the height and on the parent of a recorded
+//     one. Validators may seal at a height again on another parent, after a reorg
+//     moved the chain onto a branch they have a turn on.
+//   - a vote below the height of the recorded one, or a different one at the same
+//     height, and a vote from a source below the recorded one, which would
+//     surround a vote signed before.
+//
+// The records are persisted before the message is signed, so a restarted
+// validator never signs conflicting messages.
+type Protection struct {
+	db   ethdb.KeyValueStore
+	lock sync.Mutex
+}
+
+// NewProtection creates a slashing protection keeping its records in the given
+// database.
+func NewProtection(db ethdb.KeyValueStore) *Protection {
+	return &Protection{db: db}
+}
+
+// Protect wraps a signer function, checking every consensus message against the
+// records of the signing key and recording it before it is signed.
+func (p *Protection) Protect(signFn pixelzx.SignerFn) pixelzx.SignerFn {
+	return func(signer accounts.Account, mimeType string, message []byte) ([]byte, error) {
+		if err := p.check(signer.Address, mimeType, message); err != nil {
+			return nil, err
+		}
+		return signFn(signer, mimeType, message)
+	}
+}
+
+// check validates a consensus message against the records of the key, and
+// records it if allowed.
+func (p *Protection) check(signer common.Address, mimeType string, message []byte) error {
+	switch mimeType {
+	case accounts.MimetypePixelzx:
+		header := new(types.Header)
+		if err := rlp.DecodeBytes(message, header); err != nil {
+			return fmt.Errorf("invalid header to seal: %v", err)
+		}
+		return p.checkSeal(signer, sealRecord{
+			Number:     header.Number.Uint64(),
+			ParentHash: header.ParentHash,
+			Hash:       crypto.Keccak256Hash(message),
+		})
+	case accounts.MimetypePixelzxVote:
+		var vote struct {
+			Source pixelzx.Checkpoint
+			Target pixelzx.Checkpoint
+		}
+		if err := rlp.DecodeBytes(message, &vote); err != nil {
+			return fmt.Errorf("invalid vote to sign: %v", err)
+		}
+		if vote.Source.Number >= vote.Target.Number {
+			return fmt.Errorf("invalid vote to sign: source %d not below target %d", vote.Source.Number, vote.Target.Number)
+		}
+		return p.checkVote(signer, record{
+			Number:       vote.Target.Number,
+			Hash:         vote.Target.Hash,
+			SourceNumber: vote.Source.Number,
+			SourceHash:   vote.Source.Hash,
+		})
+	default:
+		return checkMimeType(mimeType)
+	}
+}
+
+// checkSeal validates a seal against the seal records of the key, and records
+// it if allowed.
+func (p *Protection) checkSeal(signer common.Address, next sealRecord) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	key := slices.Concat(blockRecordPrefix, signer.Bytes())
+	seals, err := p.readSeals(key)
+	if err != nil {
+		return err
+	}
+	if n := len(seals); n > 0 && next.Number+sealWindow <= seals[n-1].Number {
+		return fmt.Errorf("%w: height %d too far below signed height %d", ErrSlashable, next.Number, seals[n-1].Number)
+	}
+	for _, seal := range seals {
+		if seal.Number != next.Number || seal.ParentHash != next.ParentHash {
+			continue
+		}
+		if seal == next {
+			return nil // signed already, signing again is harmless
+		}
+		return fmt.Errorf("%w: conflicting seal at signed height %d", ErrSlashable, next.Number)
+	}
+	return p.write(key, pruneSeals(append(seals, next)))
+}
+
+// checkVote validates a vote against the vote record of the key, and records it
+// if allowed.
+func (p *Protection) checkVote(signer common.Address, next record) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	key := slices.Concat(voteRecordPrefix, signer.Bytes())
+	last, err := p.readVote(key)
+	if err != nil {
+		return err
+	}
+	if last != nil {
+		switch {
+		case next.Number < last.Number:
+			return fmt.Errorf("%w: height %d below signed height %d", ErrSlashable, next.Number, last.Number)
+		case next == *last:
+			return nil // signed already, signing again is harmless
+		case next.Number == last.Number:
+			return fmt.Errorf("%w: conflicting vote at signed height %d", ErrSlashable, next.Number)
+		case next.SourceNumber < last.SourceNumber:
+			return fmt.Errorf("%w: source %d below signed source %d", ErrSlashable, next.SourceNumber, last.SourceNumber)
+		}
+	}
+	return p.write(key, &next)
+}
+
+// write persists a record under the given key, syncing it to disk.
+func (p *Protection) write(key []byte, val interface{}) error {
+	blob, err := rlp.EncodeToBytes(val)
+	if err != nil {
+		return err
+	}
+	if err := p.db.Put(key, blob); err != nil {
+		return err
+	}
+	return p.db.SyncKeyValue()
+}
+
+// readSeals retrieves the seal records stored under the given key, ordered by
+// height.
+func (p *Protection) readSeals(key []byte) ([]sealRecord, error) {
+	var seals []sealRecord
+	if err := p.read(key, &seals); err != nil {
+		return nil, err
+	}
+	return seals, nil
+}
+
+// readVote retrieves the vote record stored under the given key, or nil if none
+// is.
+func (p *Protection) readVote(key []byte) (*record, error) {
+	rec := new(record)
+	if err := p.read(key, rec); err != nil || rec.Number == 0 {
+		return nil, err
+	}
+	return rec, nil
+}
+
+// read decodes the record stored under the given key, leaving it untouched if
+// none is.
+func (p *Protection) read(key []byte, rec interface{}) error {
+	if ok, err := p.db.Has(key); err != nil || !ok {
+		return err
+	}
+	blob, err := p.db.Get(key)
+	if err != nil {
+		return err
+	}
+	if err := rlp.DecodeBytes(blob, rec); err != nil {
+		return fmt.Errorf("slashing protection record corrupted: %v", err)
+	}
+	return nil
+}
+
+// pruneSeals orders seal records by height, dropping the ones fallen out of the
+// window below the highest.
+func pruneSeals(seals []sealRecord) []sealRecord {
+	slices.SortStableFunc(seals, func(a, b sealRecord) int { return cmp.Compare(a.Number, b.Number) })
+	highest := seals[len(seals)-1].Number
+	return slices.DeleteFunc(seals, func(seal sealRecord) bool {
+		return seal.Number+sealWindow <= highest
+	})
+}
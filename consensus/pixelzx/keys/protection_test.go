@@ -0,0 +1,197 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keys
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb/memorydb"
+)
+
+// Tests that the slashing protection refuses seals and votes conflicting with
+// the ones signed before, also after a restart.
+func TestProtection(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	var (
+		db      = memorydb.New()
+		account = accounts.Account{Address: crypto.PubkeyToAddress(key.PublicKey)}
+	)
+	header := func(number int64, extra byte) []byte {
+		return seal(number, common.Hash{}, extra)
+	}
+	tests := []struct {
+		mimeType string
+		message  []byte
+		err      error
+	}{
+		{accounts.MimetypePixelzx, header(10, 0), nil},
+		{accounts.MimetypePixelzx, header(10, 0), nil},          // same seal again
+		{accounts.MimetypePixelzx, header(10, 1), ErrSlashable}, // conflicting seal
+		{accounts.MimetypePixelzx, header(11, 1), nil},
+		{accounts.MimetypePixelzx, seal(10, common.Hash{1}, 0), nil},          // reproposal on another parent
+		{accounts.MimetypePixelzx, seal(9, common.Hash{1}, 0), nil},           // below the last on another branch
+		{accounts.MimetypePixelzx, seal(9, common.Hash{1}, 1), ErrSlashable},  // conflicting seal on another branch
+		{accounts.MimetypePixelzx, seal(300, common.Hash{}, 0), nil},          // dropping the seals out of the window
+		{accounts.MimetypePixelzx, seal(44, common.Hash{2}, 0), ErrSlashable}, // too far below to be checked
+		{accounts.MimetypePixelzx, seal(45, common.Hash{2}, 0), nil},
+		{accounts.MimetypePixelzxVote, vote(2, 5, common.Hash{1}), nil}, // votes are recorded apart
+		{accounts.MimetypePixelzxVote, vote(2, 5, common.Hash{1}), nil}, // same vote again
+		{accounts.MimetypePixelzxVote, vote(2, 5, common.Hash{2}), ErrSlashable},
+		{accounts.MimetypePixelzxVote, vote(3, 5, common.Hash{1}), ErrSlashable}, // same target from another source
+		{accounts.MimetypePixelzxVote, vote(2, 4, common.Hash{1}), ErrSlashable},
+		{accounts.MimetypePixelzxVote, vote(1, 6, common.Hash{2}), ErrSlashable}, // surrounding the last vote
+		{accounts.MimetypePixelzxVote, vote(2, 6, common.Hash{2}), nil},
+		{accounts.MimetypePixelzxVote, vote(4, 7, common.Hash{2}), nil},
+	}
+	signFn := NewProtection(db).Protect(LocalSigner(key))
+	if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(6, 6, common.Hash{2})); err == nil {
+		t.Fatalf("signed vote with source not below its target")
+	}
+	for i, tt := range tests {
+		if _, err := signFn(account, tt.mimeType, tt.message); !errors.Is(err, tt.err) {
+			t.Fatalf("test %d: error mismatch: have %v, want %v", i, err, tt.err)
+		}
+	}
+	// Reopen the protection over the same database, the records must remain
+	signFn = NewProtection(db).Protect(LocalSigner(key))
+	if _, err := signFn(account, accounts.MimetypePixelzx, header(300, 2)); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("conflicting seal after restart: have %v, want %v", err, ErrSlashable)
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(4, 7, common.Hash{3})); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("conflicting vote after restart: have %v, want %v", err, ErrSlashable)
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(3, 8, common.Hash{3})); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("surrounding vote after restart: have %v, want %v", err, ErrSlashable)
+	}
+	// Other keys have records of their own
+	other, _ := crypto.GenerateKey()
+	signFn = NewProtection(db).Protect(LocalSigner(other))
+	if _, err := signFn(accounts.Account{Address: crypto.PubkeyToAddress(other.PublicKey)}, accounts.MimetypePixelzx, header(1, 0)); err != nil {
+		t.Fatalf("failed to seal with another key: %v", err)
+	}
+}
+
+// Tests that records survive an interchange round trip to another database, and
+// that imports merge into the local records without ever lowering them.
+func TestProtectionInterchange(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	var (
+		account = accounts.Account{Address: crypto.PubkeyToAddress(key.PublicKey)}
+		genesis = common.Hash{0xff}
+		source  = NewProtection(memorydb.New())
+	)
+	signFn := source.Protect(LocalSigner(key))
+	if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(3, 7, common.Hash{1})); err != nil {
+		t.Fatalf("failed to sign vote: %v", err)
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzx, seal(7, common.Hash{1}, 0)); err != nil {
+		t.Fatalf("failed to seal: %v", err)
+	}
+	exported, err := source.Export(genesis)
+	if err != nil {
+		t.Fatalf("failed to export: %v", err)
+	}
+	blob, err := json.Marshal(exported)
+	if err != nil {
+		t.Fatalf("failed to encode interchange: %v", err)
+	}
+	interchange := new(Interchange)
+	if err := json.Unmarshal(blob, interchange); err != nil {
+		t.Fatalf("failed to decode interchange: %v", err)
+	}
+	target := NewProtection(memorydb.New())
+	if _, err := target.Import(interchange, common.Hash{0xee}); err == nil {
+		t.Fatalf("imported interchange of another chain")
+	}
+	if n, err := target.Import(interchange, genesis); err != nil || n != 2 {
+		t.Fatalf("import mismatch: have %d records (%v), want 2", n, err)
+	}
+	signFn = target.Protect(LocalSigner(key))
+	if _, err := signFn(account, accounts.MimetypePixelzx, seal(7, common.Hash{1}, 1)); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("conflicting seal after import: have %v, want %v", err, ErrSlashable)
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzx, seal(7, common.Hash{2}, 1)); err != nil {
+		t.Fatalf("failed to seal on another parent after import: %v", err)
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(3, 7, common.Hash{2})); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("conflicting vote after import: have %v, want %v", err, ErrSlashable)
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(3, 8, common.Hash{2})); err != nil {
+		t.Fatalf("failed to vote above imported record: %v", err)
+	}
+	// Importing lower records changes nothing, differing ones at the same height
+	// refuse any vote at it
+	conflicts := &Interchange{
+		Metadata: InterchangeMetadata{Version: InterchangeVersion, GenesisHash: genesis},
+		Data: []InterchangeKey{
+			{Address: account.Address, SignedVote: &InterchangeEntry{Number: 7, Hash: common.Hash{1}, Source: &InterchangeEntry{Number: 3}}},
+			{Address: account.Address, SignedVote: &InterchangeEntry{Number: 8, Hash: common.Hash{3}, Source: &InterchangeEntry{Number: 3}}},
+			{Address: account.Address, SignedBlocks: []InterchangeBlock{{Number: 8, ParentHash: common.Hash{1}, Hash: common.Hash{1}}}},
+		},
+	}
+	if n, err := target.Import(conflicts, genesis); err != nil || n != 2 {
+		t.Fatalf("merge mismatch: have %d records (%v), want 2", n, err)
+	}
+	for _, hash := range []common.Hash{{2}, {3}} {
+		if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(3, 8, hash)); !errors.Is(err, ErrSlashable) {
+			t.Fatalf("vote %x at merged height: have %v, want %v", hash, err, ErrSlashable)
+		}
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzx, seal(8, common.Hash{1}, 0)); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("seal conflicting with imported one: have %v, want %v", err, ErrSlashable)
+	}
+	// Importing a lower vote from a higher source refuses votes surrounding it
+	surrounded := &Interchange{
+		Metadata: InterchangeMetadata{Version: InterchangeVersion, GenesisHash: genesis},
+		Data: []InterchangeKey{
+			{Address: account.Address, SignedVote: &InterchangeEntry{Number: 6, Hash: common.Hash{1}, Source: &InterchangeEntry{Number: 5}}},
+		},
+	}
+	if n, err := target.Import(surrounded, genesis); err != nil || n != 1 {
+		t.Fatalf("merge mismatch: have %d records (%v), want 1", n, err)
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(4, 9, common.Hash{1})); !errors.Is(err, ErrSlashable) {
+		t.Fatalf("vote surrounding imported one: have %v, want %v", err, ErrSlashable)
+	}
+	if _, err := signFn(account, accounts.MimetypePixelzxVote, vote(5, 9, common.Hash{1})); err != nil {
+		t.Fatalf("failed to vote from imported source: %v", err)
+	}
+}
+
+// vote returns the rlp of a vote attesting a block from a source at the given
+// height.
+func vote(source, number uint64, hash common.Hash) []byte {
+	return pixelzx.VoteRLP(pixelzx.Checkpoint{Number: source}, pixelzx.Checkpoint{Number: number, Hash: hash})
+}
+
+// seal returns the rlp of a header to seal at the given height on a parent.
+func seal(number int64, parent common.Hash, extra byte) []byte {
+	return pixelzx.PixelzxRLP(&types.Header{
+		ParentHash: parent,
+		Number:     big.NewInt(number),
+		Difficulty: big.NewInt(2),
+		Extra:      append([]byte{extra}, make([]byte, 32+crypto.SignatureLength)...),
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keys
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	// errUnknownSigner is returned if a signature is requested from a key other
+	// than the one of the signer.
+	errUnknownSigner = errors.New("unknown consensus key")
+
+	// errInvalidSignature is returned if a remote signer returns a signature not
+	// made by the requested key.
+	errInvalidSignature = errors.New("remote signer returned an invalid signature")
+)
+
+// checkMimeType rejects anything but consensus messages, so consensus keys can't
+// be used to sign transactions or arbitrary data.
+func checkMimeType(mimeType string) error {
+	switch mimeType {
+	case accounts.MimetypePixelzx, accounts.MimetypePixelzxVote:
+		return nil
+	}
+	return fmt.Errorf("refusing to sign %s with a consensus key", mimeType)
+}
+
+// LocalSigner returns a signer function signing consensus messages with the
+// given decrypted consensus key.
+func LocalSigner(key *ecdsa.PrivateKey) pixelzx.SignerFn {
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	return func(signer accounts.Account, mimeType string, message []byte) ([]byte, error) {
+		if signer.Address != addr {
+			return nil, fmt.Errorf("%w: %s", errUnknownSigner, signer.Address.Hex())
+		}
+		if err := checkMimeType(mimeType); err != nil {
+			return nil, err
+		}
+		return crypto.Sign(crypto.Keccak256(message), key)
+	}
+}
+
+// RemoteSigner returns a signer function delegating the signing of consensus
+// messages to an external signer, like clef, serving the account_signData API
+// at the given endpoint. The signer must manage the consensus key of validator.
+func RemoteSigner(endpoint string, validator common.Address) (pixelzx.SignerFn, error) {
+	signer, err := external.NewExternalSigner(endpoint)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to external signer: %v", err)
+	}
+	if !signer.Contains(accounts.Account{Address: validator}) {
+		return nil, fmt.Errorf("external signer at %s does not manage consensus key %s", endpoint, validator.Hex())
+	}
+	return func(account accounts.Account, mimeType string, message []byte) ([]byte, error) {
+		if account.Address != validator {
+			return nil, fmt.Errorf("%w: %s", errUnknownSigner, account.Address.Hex())
+		}
+		if err := checkMimeType(mimeType); err != nil {
+			return nil, err
+		}
+		sig, err := signer.SignData(account, mimeType, message)
+		if err != nil {
+			return nil, err
+		}
+		// Don't trust the remote end, a seal by another key would only get the
+		// block rejected by the network.
+		pubkey, err := crypto.SigToPub(crypto.Keccak256(message), sig)
+		if err != nil || crypto.PubkeyToAddress(*pubkey) != validator {
+			return nil, errInvalidSignature
+		}
+		return sig, nil
+	}, nil
+}
@@ -0,0 +1,225 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package keys manages the consensus keys PIXELZX validators seal and attest
+// blocks with.
+//
+// Consensus keys are kept in a store of their own, apart from the account
+// keystore, so they never become accounts able to sign transactions. A validator
+// registers the public key it seals with in the staking contract, which maps the
+// key's address back to the validator holding the stake. Seals and votes are signed
+// either with a key of the store or by a remote signer like clef, and are checked
+// against the slashing protection records of the key before being signed.
+package keys
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// keyFileVersion is the version of the consensus key file format.
+const keyFileVersion = 1
+
+var (
+	// ErrNoKey is returned if a consensus key is not in the store.
+	ErrNoKey = errors.New("no such consensus key")
+
+	// ErrKeyExists is returned if a consensus key is added to the store twice.
+	ErrKeyExists = errors.New("consensus key already exists")
+)
+
+// keyFile is the on-disk format of a consensus key. The private key is encrypted
+// like the keys of the account keystore, the public key is kept in the clear so
+// it can be exported without the password.
+type keyFile struct {
+	Address   common.Address      `json:"address"`
+	PublicKey hexutil.Bytes       `json:"publicKey"`
+	Crypto    keystore.CryptoJSON `json:"crypto"`
+	Version   int                 `json:"version"`
+}
+
+// Store is a directory of password encrypted consensus keys, one file per key.
+type Store struct {
+	dir     string // Directory holding the key files
+	scryptN int    // Scrypt parameters the keys are encrypted with
+	scryptP int
+}
+
+// NewStore creates a consensus key store in the given directory, encrypting new
+// keys with the given scrypt parameters.
+func NewStore(dir string, scryptN, scryptP int) *Store {
+	return &Store{dir: dir, scryptN: scryptN, scryptP: scryptP}
+}
+
+// Dir returns the directory of the store.
+func (s *Store) Dir() string {
+	return s.dir
+}
+
+// Keys returns the addresses of the keys in the store, sorted.
+func (s *Store) Keys() ([]common.Address, error) {
+	entries, err := os.ReadDir(s.dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var addrs []common.Address
+	for _, entry := range entries {
+		name, ok := strings.CutSuffix(entry.Name(), ".json")
+		if !ok || entry.IsDir() || !common.IsHexAddress(name) {
+			continue
+		}
+		addrs = append(addrs, common.HexToAddress(name))
+	}
+	slices.SortFunc(addrs, common.Address.Cmp)
+	return addrs, nil
+}
+
+// Generate creates a new random key in the store, encrypted with the passphrase.
+func (s *Store) Generate(passphrase string) (common.Address, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return common.Address{}, err
+	}
+	return s.Import(key, passphrase)
+}
+
+// Import adds a key to the store, encrypted with the passphrase.
+func (s *Store) Import(key *ecdsa.PrivateKey, passphrase string) (common.Address, error) {
+	addr := crypto.PubkeyToAddress(key.PublicKey)
+	if _, err := os.Stat(s.path(addr)); err == nil {
+		return common.Address{}, fmt.Errorf("%w: %s", ErrKeyExists, addr.Hex())
+	}
+	if err := s.write(key, passphrase); err != nil {
+		return common.Address{}, err
+	}
+	return addr, nil
+}
+
+// PublicKey returns the public key of a key in the store.
+func (s *Store) PublicKey(addr common.Address) (*ecdsa.PublicKey, error) {
+	file, err := s.read(addr)
+	if err != nil {
+		return nil, err
+	}
+	pubkey, err := crypto.UnmarshalPubkey(file.PublicKey)
+	if err != nil || crypto.PubkeyToAddress(*pubkey) != addr {
+		return nil, fmt.Errorf("consensus key %s corrupted: invalid public key", addr.Hex())
+	}
+	return pubkey, nil
+}
+
+// Unlock decrypts a key of the store with its passphrase.
+func (s *Store) Unlock(addr common.Address, passphrase string) (*ecdsa.PrivateKey, error) {
+	file, err := s.read(addr)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := keystore.DecryptDataV3(file.Crypto, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	key, err := crypto.ToECDSA(blob)
+	if err != nil || crypto.PubkeyToAddress(key.PublicKey) != addr {
+		return nil, fmt.Errorf("consensus key %s corrupted: invalid private key", addr.Hex())
+	}
+	return key, nil
+}
+
+// Update re-encrypts a key of the store with a new passphrase, replacing the key
+// file atomically.
+func (s *Store) Update(addr common.Address, passphrase, newPassphrase string) error {
+	key, err := s.Unlock(addr, passphrase)
+	if err != nil {
+		return err
+	}
+	return s.write(key, newPassphrase)
+}
+
+// path returns the path of the key file of the given address.
+func (s *Store) path(addr common.Address) string {
+	return filepath.Join(s.dir, addr.Hex()+".json")
+}
+
+// read loads the key file of the given address.
+func (s *Store) read(addr common.Address) (*keyFile, error) {
+	blob, err := os.ReadFile(s.path(addr))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("%w: %s", ErrNoKey, addr.Hex())
+	}
+	if err != nil {
+		return nil, err
+	}
+	file := new(keyFile)
+	if err := json.Unmarshal(blob, file); err != nil {
+		return nil, fmt.Errorf("consensus key %s corrupted: %v", addr.Hex(), err)
+	}
+	if file.Version != keyFileVersion {
+		return nil, fmt.Errorf("consensus key %s: unsupported version %d", addr.Hex(), file.Version)
+	}
+	if file.Address != addr {
+		return nil, fmt.Errorf("consensus key %s corrupted: address %s", addr.Hex(), file.Address.Hex())
+	}
+	return file, nil
+}
+
+// write encrypts a key and writes its key file, through a temporary file renamed
+// into place once complete.
+func (s *Store) write(key *ecdsa.PrivateKey, passphrase string) error {
+	enc, err := keystore.EncryptDataV3(crypto.FromECDSA(key), []byte(passphrase), s.scryptN, s.scryptP)
+	if err != nil {
+		return err
+	}
+	blob, err := json.Marshal(&keyFile{
+		Address:   crypto.PubkeyToAddress(key.PublicKey),
+		PublicKey: crypto.FromECDSAPub(&key.PublicKey),
+		Crypto:    enc,
+		Version:   keyFileVersion,
+	})
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(s.dir, 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(s.dir, ".key-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(blob); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path(crypto.PubkeyToAddress(key.PublicKey)))
+}
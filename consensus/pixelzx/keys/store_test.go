@@ -0,0 +1,104 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package keys
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Tests that keys are generated, imported, unlocked and re-encrypted, and that their
+// public keys are readable without the password.
+func TestStore(t *testing.T) {
+	store := NewStore(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+
+	generated, err := store.Generate("foo")
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	key, _ := crypto.GenerateKey()
+	imported, err := store.Import(key, "bar")
+	if err != nil {
+		t.Fatalf("failed to import key: %v", err)
+	}
+	if imported != crypto.PubkeyToAddress(key.PublicKey) {
+		t.Fatalf("imported address mismatch: have %x, want %x", imported, crypto.PubkeyToAddress(key.PublicKey))
+	}
+	if _, err := store.Import(key, "bar"); !errors.Is(err, ErrKeyExists) {
+		t.Fatalf("duplicate import error mismatch: have %v, want %v", err, ErrKeyExists)
+	}
+	want := []common.Address{generated, imported}
+	slices.SortFunc(want, common.Address.Cmp)
+	if have, err := store.Keys(); err != nil || !slices.Equal(have, want) {
+		t.Fatalf("keys mismatch: have %x (%v), want %x", have, err, want)
+	}
+	if pubkey, err := store.PublicKey(imported); err != nil || !pubkey.Equal(&key.PublicKey) {
+		t.Fatalf("public key mismatch: %v", err)
+	}
+	if _, err := store.Unlock(imported, "foo"); !errors.Is(err, keystore.ErrDecrypt) {
+		t.Fatalf("wrong password error mismatch: have %v, want %v", err, keystore.ErrDecrypt)
+	}
+	if _, err := store.Unlock(common.Address{1}, "foo"); !errors.Is(err, ErrNoKey) {
+		t.Fatalf("missing key error mismatch: have %v, want %v", err, ErrNoKey)
+	}
+	if err := store.Update(imported, "bar", "baz"); err != nil {
+		t.Fatalf("failed to re-encrypt key: %v", err)
+	}
+	if _, err := store.Unlock(imported, "bar"); !errors.Is(err, keystore.ErrDecrypt) {
+		t.Fatalf("re-encrypted key unlocked with old password: %v", err)
+	}
+	unlocked, err := store.Unlock(imported, "baz")
+	if err != nil {
+		t.Fatalf("failed to unlock re-encrypted key: %v", err)
+	}
+	if !unlocked.Equal(key) {
+		t.Fatalf("re-encrypted key mismatch")
+	}
+	if have, _ := store.Keys(); len(have) != 2 {
+		t.Fatalf("key count mismatch after re-encryption: have %d, want 2", len(have))
+	}
+}
+
+// Tests that local signers only sign consensus messages of their own key.
+func TestLocalSigner(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	var (
+		addr   = crypto.PubkeyToAddress(key.PublicKey)
+		signFn = LocalSigner(key)
+		vote   = pixelzx.VoteRLP(pixelzx.Checkpoint{}, pixelzx.Checkpoint{Number: 1, Hash: common.Hash{1}})
+	)
+	sig, err := signFn(accounts.Account{Address: addr}, accounts.MimetypePixelzxVote, vote)
+	if err != nil {
+		t.Fatalf("failed to sign vote: %v", err)
+	}
+	if signer, err := (&pixelzx.Vote{Target: pixelzx.Checkpoint{Number: 1, Hash: common.Hash{1}}, Signature: sig}).Signer(); err != nil || signer != addr {
+		t.Fatalf("vote signer mismatch: have %x (%v), want %x", signer, err, addr)
+	}
+	if _, err := signFn(accounts.Account{Address: common.Address{1}}, accounts.MimetypePixelzxVote, vote); !errors.Is(err, errUnknownSigner) {
+		t.Fatalf("unknown signer error mismatch: have %v, want %v", err, errUnknownSigner)
+	}
+	if _, err := signFn(accounts.Account{Address: addr}, accounts.MimetypeTextPlain, []byte("hello")); err == nil {
+		t.Fatalf("signed plain text with a consensus key")
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// trackLiveness records whether the in-turn validator proposed the block.
+func (p *Pixelzx) trackLiveness(chain consensus.ChainHeaderReader, header *types.Header, state vm.StateDB) {
+	window := p.config.LivenessWindow
+	if window == 0 {
+		return
+	}
+	number := header.Number.Uint64()
+	snap, err := p.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		log.Error("Failed to retrieve validator snapshot for liveness", "number", number, "err", err)
+		return
+	}
+	var missed common.Address
+	if inturn := snap.proposer(); inturn != header.Coinbase {
+		missed = staking.ValidatorOf(state, inturn)
+	}
+	staking.RecordSlot(state, window, number, missed)
+}
+
+// jailOffline jails the validators that missed too many of their slots within
+// the liveness window.
+func (p *Pixelzx) jailOffline(state vm.StateDB) {
+	window := p.config.LivenessWindow
+	if window == 0 {
+		return
+	}
+	for _, validator := range staking.Validators(state) {
+		missed := staking.MissedSlots(state, validator)
+		if missed <= p.config.MaxMissedSlots {
+			continue
+		}
+		if staking.JailForDowntime(state, window, validator) {
+			log.Warn("Jailed offline validator", "validator", validator, "missed", missed, "window", window)
+		}
+	}
+}
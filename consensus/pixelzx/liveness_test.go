@@ -0,0 +1,106 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Tests that a validator missing its slots is jailed at the epoch boundary,
+// dropped from the validator set, and may unjail itself via the staking contract.
+func TestLivenessJailing(t *testing.T) {
+	b := newCustomTestBackend(t, func(config *params.PixelzxConfig) {
+		config.LivenessWindow = 8
+		config.MaxMissedSlots = 1
+	}, 1, 1, 1)
+
+	var (
+		offlineKey = b.keys[0]
+		offline    = crypto.PubkeyToAddress(offlineKey.PublicKey)
+		api        = NewAPI(b.engine, b.chain)
+		parent     = b.chain.Genesis()
+		missed     uint64
+	)
+	insert := func(block *types.Block) {
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+	}
+	// Run the first epoch with the offline validator's slots taken over by backups
+	for parent.NumberU64() < b.genesis.Config.Pixelzx.Epoch {
+		var block *types.Block
+		if key := b.proposer(parent); key != offlineKey {
+			block = b.makeBlock(parent, key, 0, nil)
+		} else {
+			block = b.makeBlock(parent, b.keys[1], 10, nil)
+			missed++
+		}
+		insert(block)
+		parent = block
+
+		if parent.NumberU64() < b.genesis.Config.Pixelzx.Epoch {
+			number := rpc.BlockNumber(parent.NumberU64())
+			if have, err := api.GetMissedSlots(offline, &number); err != nil || have != missed {
+				t.Fatalf("block %d: missed slots mismatch: have %d (%v), want %d", parent.NumberU64(), have, err, missed)
+			}
+		}
+	}
+	if missed <= 1 {
+		t.Fatalf("offline validator missed too few slots to be jailed: %d", missed)
+	}
+	liveness, err := api.GetLiveness(nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve liveness: %v", err)
+	}
+	for _, v := range liveness.Validators {
+		if jailed := v.Address == offline; v.Jailed != jailed || v.Tombstoned {
+			t.Errorf("validator %x: jail status mismatch: have %v/%v, want %v/false", v.Address, v.Jailed, v.Tombstoned, jailed)
+		}
+		if v.MissedSlots != 0 {
+			t.Errorf("validator %x: missed slots not cleared: %d", v.Address, v.MissedSlots)
+		}
+	}
+	validators, err := api.GetValidators(nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve validators: %v", err)
+	}
+	if len(validators) != 2 {
+		t.Fatalf("validator count mismatch: have %d, want 2", len(validators))
+	}
+	// Unjail the validator, which makes it eligible again at the next epoch
+	block := b.makeBlockWithTxs(parent, b.proposer(parent), 0, []*types.Transaction{
+		b.stakingTx(offlineKey, 0, nil, "unjail"),
+	}, nil)
+	insert(block)
+
+	if receipts := b.chain.GetReceiptsByHash(block.Hash()); receipts[0].Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("unjail failed")
+	}
+	if liveness, err = api.GetLiveness(nil); err != nil {
+		t.Fatalf("failed to retrieve liveness: %v", err)
+	}
+	for _, v := range liveness.Validators {
+		if v.Jailed {
+			t.Errorf("validator %x still jailed", v.Address)
+		}
+	}
+}
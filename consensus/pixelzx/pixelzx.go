@@ -0,0 +1,972 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package pixelzx implements the PIXELZX proof-of-stake consensus engine.
+package pixelzx
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"math/rand"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/misc"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/governance"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/event"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/ethereum/go-ethereum/trie"
+	"golang.org/x/crypto/sha3"
+)
+
+const (
+	checkpointInterval = 1024 // Number of blocks after which to save the validator snapshot to the database
+	inmemorySnapshots  = 128  // Number of recent validator snapshots to keep in memory
+	inmemorySignatures = 4096 // Number of recent block signatures to keep in memory
+	inmemorySeals      = 4096 // Number of recent validator seals to keep in memory for double sign detection
+
+	wiggleTime = 500 * time.Millisecond // Random delay (per validator) to allow concurrent backup proposers
+)
+
+// PIXELZX proof-of-stake protocol constants.
+var (
+	epochLength = uint64(200) // Default number of blocks after which to checkpoint and rotate the validator set
+
+	extraVanity    = 32                       // Fixed number of extra-data prefix bytes reserved for proposer vanity
+	extraSeal      = crypto.SignatureLength   // Fixed number of extra-data suffix bytes reserved for proposer seal
+	validatorBytes = common.AddressLength + 8 // Number of extra-data bytes of a single checkpoint validator entry
+
+	uncleHash = types.CalcUncleHash(nil) // Always Keccak256(RLP([])) as uncles are meaningless outside of PoW.
+
+	diffInTurn = big.NewInt(2) // Block difficulty for in-turn proposals
+	diffNoTurn = big.NewInt(1) // Block difficulty for out-of-turn proposals
+)
+
+// Various error messages to mark blocks invalid. These should be private to
+// prevent engine specific errors from being referenced in the remainder of the
+// codebase, inherently breaking if the engine is swapped out. Please put common
+// error types into the consensus package.
+var (
+	// errUnknownBlock is returned when the list of validators is requested for a
+	// block that is not part of the local blockchain.
+	errUnknownBlock = errors.New("unknown block")
+
+	// errInvalidNonce is returned if a block's nonce is non-zero.
+	errInvalidNonce = errors.New("non-zero nonce")
+
+	// errMissingVanity is returned if a block's extra-data section is shorter than
+	// 32 bytes, which is required to store the proposer vanity.
+	errMissingVanity = errors.New("extra-data 32 byte vanity prefix missing")
+
+	// errMissingSignature is returned if a block's extra-data section doesn't seem
+	// to contain a 65 byte secp256k1 signature.
+	errMissingSignature = errors.New("extra-data 65 byte signature suffix missing")
+
+	// errExtraValidators is returned if non-checkpoint block contain validator
+	// data in their extra-data fields.
+	errExtraValidators = errors.New("non-checkpoint block contains extra validator list")
+
+	// errInvalidCheckpointValidators is returned if a checkpoint block contains an
+	// invalid list of validators (i.e. non divisible by 28 bytes, unsorted or
+	// without voting power).
+	errInvalidCheckpointValidators = errors.New("invalid validator list on checkpoint block")
+
+	// errMismatchingCheckpointValidators is returned if a checkpoint block contains
+	// a list of validators different than the one the local node calculated.
+	errMismatchingCheckpointValidators = errors.New("mismatching validator list on checkpoint block")
+
+	// errInvalidMixDigest is returned if a block's mix digest is non-zero.
+	errInvalidMixDigest = errors.New("non-zero mix digest")
+
+	// errInvalidUncleHash is returned if a block contains an non-empty uncle list.
+	errInvalidUncleHash = errors.New("non empty uncle hash")
+
+	// errInvalidDifficulty is returned if the difficulty of a block is neither 1 nor 2.
+	errInvalidDifficulty = errors.New("invalid difficulty")
+
+	// errWrongDifficulty is returned if the difficulty of a block doesn't match the
+	// turn of the proposer.
+	errWrongDifficulty = errors.New("wrong difficulty")
+
+	// errInvalidTimestamp is returned if the timestamp of a block is lower than
+	// the previous block's timestamp + the minimum block period.
+	errInvalidTimestamp = errors.New("invalid timestamp")
+
+	// errBackupTooEarly is returned if a block sealed by an out-of-turn proposer
+	// doesn't leave the in-turn proposer its full block period to propose.
+	errBackupTooEarly = errors.New("out-of-turn block sealed too early")
+
+	// errInvalidValidatorChain is returned if a validator set is attempted to be
+	// modified via out-of-range or non-contiguous headers.
+	errInvalidValidatorChain = errors.New("invalid validator chain")
+
+	// errUnauthorizedValidator is returned if a header is signed by a non-validator.
+	errUnauthorizedValidator = errors.New("unauthorized validator")
+
+	// errRecentlySigned is returned if a header is signed out-of-turn by a validator
+	// that already sealed one of the recent blocks.
+	errRecentlySigned = errors.New("recently signed")
+
+	// errInvalidCoinbase is returned if the coinbase of a block is not the address
+	// of the proposer that sealed it.
+	errInvalidCoinbase = errors.New("coinbase not the block proposer")
+
+	// errInvalidGasLimit is returned if the gas limit of a block doesn't move
+	// towards the target set by governance.
+	errInvalidGasLimit = errors.New("gas limit not moving towards governed target")
+)
+
+// SignerFn hashes and signs the data to be signed by a backing account.
+type SignerFn func(signer accounts.Account, mimeType string, message []byte) ([]byte, error)
+
+// ecrecover extracts the Ethereum account address from a signed header.
+func ecrecover(header *types.Header, sigcache *sigLRU) (common.Address, error) {
+	// If the signature's already cached, return that
+	hash := header.Hash()
+	if address, known := sigcache.Get(hash); known {
+		return address, nil
+	}
+	// Retrieve the signature from the header extra-data
+	if len(header.Extra) < extraSeal {
+		return common.Address{}, errMissingSignature
+	}
+	signature := header.Extra[len(header.Extra)-extraSeal:]
+
+	// Recover the public key and the Ethereum address
+	pubkey, err := crypto.Ecrecover(SealHash(header).Bytes(), signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	var signer common.Address
+	copy(signer[:], crypto.Keccak256(pubkey[1:])[12:])
+
+	sigcache.Add(hash, signer)
+	return signer, nil
+}
+
+// parseValidators extracts the validator set embedded into the extra-data of a
+// checkpoint header. The entries must be sorted by address, unique and carry a
+// non-zero voting power.
+func parseValidators(header *types.Header) ([]Validator, error) {
+	if len(header.Extra) < extraVanity+extraSeal {
+		return nil, errMissingSignature
+	}
+	data := header.Extra[extraVanity : len(header.Extra)-extraSeal]
+	if len(data) == 0 || len(data)%validatorBytes != 0 {
+		return nil, errInvalidCheckpointValidators
+	}
+	validators := make([]Validator, len(data)/validatorBytes)
+	for i := range validators {
+		entry := data[i*validatorBytes:]
+		copy(validators[i].Address[:], entry[:common.AddressLength])
+		validators[i].Power = binary.BigEndian.Uint64(entry[common.AddressLength:validatorBytes])
+
+		if validators[i].Power == 0 {
+			return nil, errInvalidCheckpointValidators
+		}
+		if i > 0 && validators[i-1].Address.Cmp(validators[i].Address) >= 0 {
+			return nil, errInvalidCheckpointValidators
+		}
+	}
+	return validators, nil
+}
+
+// encodeValidators serializes a validator set into the format embedded into the
+// extra-data of checkpoint headers.
+func encodeValidators(validators []Validator) []byte {
+	data := make([]byte, len(validators)*validatorBytes)
+	for i, v := range validators {
+		entry := data[i*validatorBytes:]
+		copy(entry, v.Address[:])
+		binary.BigEndian.PutUint64(entry[common.AddressLength:], v.Power)
+	}
+	return data
+}
+
+// Pixelzx is the proof-of-stake consensus engine of the PIXELZX network. Blocks
+// are sealed by a set of staked validators, taking turns proportionally to their
+// voting power.
+type Pixelzx struct {
+	config *params.PixelzxConfig // Consensus engine configuration parameters
+	db     ethdb.Database        // Database to store and retrieve snapshot checkpoints
+
+	recents    *lru.Cache[common.Hash, *Snapshot] // Snapshots for recent block to speed up reorgs
+	signatures *sigLRU                            // Signatures of recent blocks to speed up mining
+	seals      *lru.Cache[sealKey, *types.Header] // Headers recently sealed by each validator, to detect double signing
+
+	evidenceFeed event.Feed              // Feed of detected double sign evidence
+	scope        event.SubscriptionScope // Subscription scope tracking the evidence subscribers
+
+	signer common.Address // Ethereum address of the signing key
+	signFn SignerFn       // Signer function to authorize hashes with
+	lock   sync.RWMutex   // Protects the signer fields
+}
+
+// New creates a PIXELZX proof-of-stake consensus engine with the initial
+// validators set to the ones embedded into the genesis block.
+func New(config *params.PixelzxConfig, db ethdb.Database) *Pixelzx {
+	// Set any missing consensus parameters to their defaults
+	conf := *config
+	if conf.Epoch == 0 {
+		conf.Epoch = epochLength
+	}
+	// Allocate the snapshot caches and create the engine
+	recents := lru.NewCache[common.Hash, *Snapshot](inmemorySnapshots)
+	signatures := lru.NewCache[common.Hash, common.Address](inmemorySignatures)
+	seals := lru.NewCache[sealKey, *types.Header](inmemorySeals)
+
+	return &Pixelzx{
+		config:     &conf,
+		db:         db,
+		recents:    recents,
+		signatures: signatures,
+		seals:      seals,
+	}
+}
+
+// Author implements consensus.Engine, returning the Ethereum address recovered
+// from the signature in the header's extra-data section.
+func (p *Pixelzx) Author(header *types.Header) (common.Address, error) {
+	return ecrecover(header, p.signatures)
+}
+
+// VerifyHeader checks whether a header conforms to the consensus rules.
+func (p *Pixelzx) VerifyHeader(chain consensus.ChainHeaderReader, header *types.Header) error {
+	return p.verifyHeader(chain, header, nil)
+}
+
+// VerifyHeaders is similar to VerifyHeader, but verifies a batch of headers. The
+// method returns a quit channel to abort the operations and a results channel to
+// retrieve the async verifications (the order is that of the input slice).
+func (p *Pixelzx) VerifyHeaders(chain consensus.ChainHeaderReader, headers []*types.Header) (chan<- struct{}, <-chan error) {
+	abort := make(chan struct{})
+	results := make(chan error, len(headers))
+
+	go func() {
+		for i, header := range headers {
+			err := p.verifyHeader(chain, header, headers[:i])
+
+			select {
+			case <-abort:
+				return
+			case results <- err:
+			}
+		}
+	}()
+	return abort, results
+}
+
+// verifyHeader checks whether a header conforms to the consensus rules. The
+// caller may optionally pass in a batch of parents (ascending order) to avoid
+// looking those up from the database. This is useful for concurrently verifying
+// a batch of new headers.
+func (p *Pixelzx) verifyHeader(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header) error {
+	if header.Number == nil {
+		return errUnknownBlock
+	}
+	number := header.Number.Uint64()
+
+	// Don't waste time checking blocks from the future
+	if header.Time > uint64(time.Now().Unix()) {
+		return consensus.ErrFutureBlock
+	}
+	// Nonces are meaningless in proof-of-stake, enforce zero
+	if header.Nonce != (types.BlockNonce{}) {
+		return errInvalidNonce
+	}
+	// Check that the extra-data contains both the vanity and signature
+	if len(header.Extra) < extraVanity {
+		return errMissingVanity
+	}
+	if len(header.Extra) < extraVanity+extraSeal {
+		return errMissingSignature
+	}
+	// Ensure that the extra-data contains a validator list on checkpoint, but none otherwise
+	checkpoint := (number % p.config.Epoch) == 0
+	validatorsBytes := len(header.Extra) - extraVanity - extraSeal
+	if !checkpoint && validatorsBytes != 0 {
+		return errExtraValidators
+	}
+	if checkpoint && (validatorsBytes == 0 || validatorsBytes%validatorBytes != 0) {
+		return errInvalidCheckpointValidators
+	}
+	// Ensure that the mix digest is zero as we don't have fork protection currently
+	if header.MixDigest != (common.Hash{}) {
+		return errInvalidMixDigest
+	}
+	// Ensure that the block doesn't contain any uncles which are meaningless in PoS
+	if header.UncleHash != uncleHash {
+		return errInvalidUncleHash
+	}
+	// Ensure that the block's difficulty is meaningful (may not be correct at this point)
+	if number > 0 {
+		if header.Difficulty == nil || (header.Difficulty.Cmp(diffInTurn) != 0 && header.Difficulty.Cmp(diffNoTurn) != 0) {
+			return errInvalidDifficulty
+		}
+	}
+	// Verify that the gas limit is <= 2^63-1
+	if header.GasLimit > params.MaxGasLimit {
+		return fmt.Errorf("invalid gasLimit: have %v, max %v", header.GasLimit, params.MaxGasLimit)
+	}
+	// All basic checks passed, verify cascading fields
+	return p.verifyCascadingFields(chain, header, parents)
+}
+
+// verifyCascadingFields verifies all the header fields that are not standalone,
+// rather depend on a batch of previous headers. The caller may optionally pass
+// in a batch of parents (ascending order) to avoid looking those up from the
+// database. This is useful for concurrently verifying a batch of new headers.
+func (p *Pixelzx) verifyCascadingFields(chain consensus.ChainHeaderReader, header *types.Header, parents []*types.Header) error {
+	// The genesis block is the always valid dead-end
+	number := header.Number.Uint64()
+	if number == 0 {
+		return nil
+	}
+	// Ensure that the block's timestamp isn't too close to its parent
+	var parent *types.Header
+	if len(parents) > 0 {
+		parent = parents[len(parents)-1]
+	} else {
+		parent = chain.GetHeader(header.ParentHash, number-1)
+	}
+	if parent == nil || parent.Number.Uint64() != number-1 || parent.Hash() != header.ParentHash {
+		return consensus.ErrUnknownAncestor
+	}
+	if header.Time <= parent.Time || parent.Time+p.config.Period > header.Time {
+		return errInvalidTimestamp
+	}
+	// Verify that the gasUsed is <= gasLimit
+	if header.GasUsed > header.GasLimit {
+		return fmt.Errorf("invalid gasUsed: have %d, gasLimit %d", header.GasUsed, header.GasLimit)
+	}
+	config := chain.Config()
+	if !config.IsLondon(header.Number) {
+		// Verify BaseFee not present before EIP-1559 fork.
+		if header.BaseFee != nil {
+			return fmt.Errorf("invalid baseFee before fork: have %d, want <nil>", header.BaseFee)
+		}
+		if err := misc.VerifyGaslimit(parent.GasLimit, header.GasLimit); err != nil {
+			return err
+		}
+	} else if err := eip1559.VerifyEIP1559Header(config, parent, header); err != nil {
+		// Verify the header's EIP-1559 attributes.
+		return err
+	}
+	// Verify existence / non-existence of withdrawalsHash. Withdrawals are not
+	// supported, so the hash must be that of the empty list after Shanghai.
+	shanghai := config.IsShanghai(header.Number, header.Time)
+	if shanghai && (header.WithdrawalsHash == nil || *header.WithdrawalsHash != types.EmptyWithdrawalsHash) {
+		return fmt.Errorf("invalid withdrawalsHash: have %v, expected %x", header.WithdrawalsHash, types.EmptyWithdrawalsHash)
+	}
+	if !shanghai && header.WithdrawalsHash != nil {
+		return fmt.Errorf("invalid withdrawalsHash: have %x, expected nil", header.WithdrawalsHash)
+	}
+	// Verify the existence / non-existence of cancun-specific header fields
+	if !config.IsCancun(header.Number, header.Time) {
+		switch {
+		case header.ExcessBlobGas != nil:
+			return fmt.Errorf("invalid excessBlobGas: have %d, expected nil", header.ExcessBlobGas)
+		case header.BlobGasUsed != nil:
+			return fmt.Errorf("invalid blobGasUsed: have %d, expected nil", header.BlobGasUsed)
+		case header.ParentBeaconRoot != nil:
+			return fmt.Errorf("invalid parentBeaconRoot, have %#x, expected nil", header.ParentBeaconRoot)
+		}
+	} else {
+		if header.ParentBeaconRoot == nil || *header.ParentBeaconRoot != (common.Hash{}) {
+			return fmt.Errorf("invalid parentBeaconRoot, have %v, expected zero hash", header.ParentBeaconRoot)
+		}
+		if err := eip4844.VerifyEIP4844Header(config, parent, header); err != nil {
+			return err
+		}
+	}
+	// Retrieve the snapshot needed to verify this header and cache it
+	snap, err := p.snapshot(chain, number-1, header.ParentHash, parents)
+	if err != nil {
+		return err
+	}
+	// All basic checks passed, verify the seal and return. The validator list of
+	// checkpoint blocks is derived from the staking contract, so it can only be
+	// checked against the post state of the block (see VerifyState).
+	return p.verifySeal(snap, header, parent)
+}
+
+// snapshot retrieves the validator snapshot at a given point in time.
+func (p *Pixelzx) snapshot(chain consensus.ChainHeaderReader, number uint64, hash common.Hash, parents []*types.Header) (*Snapshot, error) {
+	// Search for a snapshot in memory or on disk for checkpoints
+	var (
+		headers []*types.Header
+		snap    *Snapshot
+	)
+	for snap == nil {
+		// If an in-memory snapshot was found, use that
+		if s, ok := p.recents.Get(hash); ok {
+			snap = s
+			break
+		}
+		// If an on-disk checkpoint snapshot can be found, use that
+		if p.persisted(number) {
+			if s, err := loadSnapshot(p.config, p.signatures, p.db, hash); err == nil {
+				log.Trace("Loaded validator snapshot from disk", "number", number, "hash", hash)
+				snap = s
+				break
+			}
+		}
+		// If we're at the genesis, snapshot the initial state. Alternatively if we're
+		// at a checkpoint block without a parent (light client CHT), or we have piled
+		// up more headers than allowed to be reorged (chain reinit from a freezer),
+		// consider the checkpoint trusted and snapshot it.
+		if number == 0 || (number%p.config.Epoch == 0 && (len(headers) > params.FullImmutabilityThreshold || chain.GetHeaderByNumber(number-1) == nil)) {
+			checkpoint := chain.GetHeaderByNumber(number)
+			if checkpoint != nil {
+				hash := checkpoint.Hash()
+
+				validators, err := parseValidators(checkpoint)
+				if err != nil {
+					return nil, err
+				}
+				snap = newSnapshot(p.config, p.signatures, number, hash, validators)
+				if err := snap.store(p.db); err != nil {
+					return nil, err
+				}
+				log.Info("Stored checkpoint snapshot to disk", "number", number, "hash", hash)
+				break
+			}
+		}
+		// No snapshot for this header, gather the header and move backward
+		var header *types.Header
+		if len(parents) > 0 {
+			// If we have explicit parents, pick from there (enforced)
+			header = parents[len(parents)-1]
+			if header.Hash() != hash || header.Number.Uint64() != number {
+				return nil, consensus.ErrUnknownAncestor
+			}
+			parents = parents[:len(parents)-1]
+		} else {
+			// No explicit parents (or no more left), reach out to the database
+			header = chain.GetHeader(hash, number)
+			if header == nil {
+				return nil, consensus.ErrUnknownAncestor
+			}
+		}
+		headers = append(headers, header)
+		number, hash = number-1, header.ParentHash
+	}
+	// Previous snapshot found, apply any pending headers on top of it
+	for i := 0; i < len(headers)/2; i++ {
+		headers[i], headers[len(headers)-1-i] = headers[len(headers)-1-i], headers[i]
+	}
+	snap, err := snap.apply(headers)
+	if err != nil {
+		return nil, err
+	}
+	p.recents.Add(snap.Hash, snap)
+
+	// If we've generated a new checkpoint snapshot, save to disk
+	if p.persisted(snap.Number) && len(headers) > 0 {
+		if err = snap.store(p.db); err != nil {
+			return nil, err
+		}
+		log.Trace("Stored validator snapshot to disk", "number", snap.Number, "hash", snap.Hash)
+	}
+	return snap, err
+}
+
+// persisted returns whether the validator snapshot at the given block is saved
+// to disk. Besides the regular checkpoints, the snapshots of all epoch blocks are
+// persisted, so a restarting node only ever replays the headers of a single epoch.
+func (p *Pixelzx) persisted(number uint64) bool {
+	return number%checkpointInterval == 0 || number%p.config.Epoch == 0
+}
+
+// VerifyUncles implements consensus.Engine, always returning an error for any
+// uncles as this consensus mechanism doesn't permit uncles. Withdrawals are
+// rejected too, there is no beacon chain to source them from.
+func (p *Pixelzx) VerifyUncles(chain consensus.ChainReader, block *types.Block) error {
+	if len(block.Uncles()) > 0 {
+		return errors.New("uncles not allowed")
+	}
+	if len(block.Withdrawals()) > 0 {
+		return errors.New("withdrawals not allowed")
+	}
+	return nil
+}
+
+// verifySeal checks whether the signature contained in the header satisfies the
+// consensus protocol requirements: the block must be sealed by an active
+// validator which is also the block's coinbase, its difficulty must match the
+// turn of the validator, and out-of-turn proposers must give the in-turn one its
+// full slot before stepping in, unless they sealed one of the recent blocks.
+func (p *Pixelzx) verifySeal(snap *Snapshot, header *types.Header, parent *types.Header) error {
+	// Verifying the genesis block is not supported
+	number := header.Number.Uint64()
+	if number == 0 {
+		return errUnknownBlock
+	}
+	// Resolve the authorization key and check against validators
+	signer, err := ecrecover(header, p.signatures)
+	if err != nil {
+		return err
+	}
+	if _, ok := snap.Validators[signer]; !ok {
+		return errUnauthorizedValidator
+	}
+	if header.Coinbase != signer {
+		return errInvalidCoinbase
+	}
+	p.recordSeal(header, signer)
+
+	// Ensure that the difficulty corresponds to the turn-ness of the signer
+	inturn := snap.inturn(signer)
+	if inturn && header.Difficulty.Cmp(diffInTurn) != 0 {
+		return errWrongDifficulty
+	}
+	if !inturn && header.Difficulty.Cmp(diffNoTurn) != 0 {
+		return errWrongDifficulty
+	}
+	if inturn {
+		return nil
+	}
+	// Backup proposers may only step in after the in-turn slot elapsed and if
+	// they didn't seal any of the recent blocks
+	if snap.recentlySigned(signer, number) {
+		return errRecentlySigned
+	}
+	if parent.Time+2*p.config.Period > header.Time {
+		return errBackupTooEarly
+	}
+	return nil
+}
+
+// Prepare implements consensus.Engine, preparing all the consensus fields of the
+// header for running the transactions on top.
+func (p *Pixelzx) Prepare(chain consensus.ChainHeaderReader, header *types.Header) error {
+	number := header.Number.Uint64()
+
+	// Assemble the validator snapshot to check the turn-ness of the proposer
+	snap, err := p.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+	// Copy signer protected by mutex to avoid race condition
+	p.lock.RLock()
+	signer := p.signer
+	p.lock.RUnlock()
+
+	// Proposers collect the fees of their blocks, nonces are unused
+	header.Coinbase = signer
+	header.Nonce = types.BlockNonce{}
+
+	// Set the correct difficulty
+	header.Difficulty = calcDifficulty(snap, signer)
+
+	// Ensure the extra data has all its components
+	if len(header.Extra) < extraVanity {
+		header.Extra = append(header.Extra, bytes.Repeat([]byte{0x00}, extraVanity-len(header.Extra))...)
+	}
+	header.Extra = append(header.Extra[:extraVanity], make([]byte, extraSeal)...)
+
+	// Mix digest is reserved for now, set to empty
+	header.MixDigest = common.Hash{}
+
+	// Ensure the timestamp has the correct delay
+	parent := chain.GetHeader(header.ParentHash, number-1)
+	if parent == nil {
+		return consensus.ErrUnknownAncestor
+	}
+	header.Time = p.slotTime(parent, snap.inturn(signer))
+	return nil
+}
+
+// NextSlot implements miner.SlotEngine, returning the timestamp the authorized
+// validator may propose the block on top of parent at, and whether it is the
+// in-turn proposer of the slot.
+func (p *Pixelzx) NextSlot(chain consensus.ChainHeaderReader, parent *types.Header) (uint64, bool, error) {
+	snap, err := p.snapshot(chain, parent.Number.Uint64(), parent.Hash(), nil)
+	if err != nil {
+		return 0, false, err
+	}
+	signer := p.Signer()
+	if _, authorized := snap.Validators[signer]; !authorized {
+		return 0, false, errUnauthorizedValidator
+	}
+	inturn := snap.inturn(signer)
+	if !inturn && snap.recentlySigned(signer, parent.Number.Uint64()+1) {
+		return 0, false, errRecentlySigned
+	}
+	return p.slotTime(parent, inturn), inturn, nil
+}
+
+// slotTime calculates the timestamp of a block proposed on top of parent. The
+// in-turn proposer proposes one block period after the parent, backup proposers
+// wait an additional period for the in-turn block to arrive.
+func (p *Pixelzx) slotTime(parent *types.Header, inturn bool) uint64 {
+	slot := parent.Time + p.config.Period
+	if !inturn {
+		slot += p.config.Period
+	}
+	if slot <= parent.Time {
+		slot = parent.Time + 1
+	}
+	if now := uint64(time.Now().Unix()); slot < now {
+		slot = now
+	}
+	return slot
+}
+
+// Finalize implements consensus.Engine, punishing the double signing evidence
+// included in the block, tracking the liveness of the validators, issuing the
+// block reward and paying it out along with the collected transaction tips. On
+// epoch blocks, the epoch transition is processed before the next validator set
+// is elected.
+func (p *Pixelzx) Finalize(chain consensus.ChainHeaderReader, header *types.Header, state vm.StateDB, body *types.Body) {
+	p.processEvidence(chain, header, state, body.Transactions)
+	p.trackLiveness(chain, header, state)
+	p.distributeRewards(header, state)
+
+	if header.Number.Uint64()%p.config.Epoch == 0 {
+		p.processEpoch(header, state)
+	}
+}
+
+// VerifyState implements consensus.StateVerifier, checking that blocks follow the
+// gas limit target set by governance, and that checkpoint blocks carry the
+// validator set elected by the staking contract in their post state.
+func (p *Pixelzx) VerifyState(chain consensus.ChainHeaderReader, header *types.Header, state vm.StateDB) error {
+	if err := p.verifyGasLimit(chain, header, state); err != nil {
+		return err
+	}
+	number := header.Number.Uint64()
+	if number%p.config.Epoch != 0 {
+		return nil
+	}
+	validators, err := p.electValidators(chain, header, state)
+	if err != nil {
+		return err
+	}
+	extraSuffix := len(header.Extra) - extraSeal
+	if !bytes.Equal(header.Extra[extraVanity:extraSuffix], encodeValidators(validators)) {
+		return errMismatchingCheckpointValidators
+	}
+	return nil
+}
+
+// electValidators reads the validator set of the next epoch from the staking
+// contract. Validators must not be jailed, must self stake at least the configured
+// minimum, and vote with their total stake in whole PZX. They are listed by the
+// address they seal with, the one of their registered consensus key if any. If more validators are
+// eligible than the maximum, configured or set by governance, the ones with the
+// highest total stake are elected. Should nobody be eligible, the current set is
+// retained to keep the chain alive.
+func (p *Pixelzx) electValidators(chain consensus.ChainHeaderReader, header *types.Header, state staking.StateDB) ([]Validator, error) {
+	type candidate struct {
+		Validator
+		stake *big.Int
+	}
+	var (
+		unit       = big.NewInt(params.PZX)
+		candidates []candidate
+	)
+	for _, addr := range staking.Validators(state) {
+		info := staking.Validator(state, addr)
+		if info.Jailed {
+			continue
+		}
+		if p.config.MinValidatorStake != nil && info.SelfStake.Cmp(p.config.MinValidatorStake) < 0 {
+			continue
+		}
+		power := new(big.Int).Div(info.TotalStake, unit)
+		if power.Sign() == 0 || !power.IsUint64() {
+			continue
+		}
+		candidates = append(candidates, candidate{
+			Validator: Validator{Address: info.Signer, Power: power.Uint64()},
+			stake:     info.TotalStake,
+		})
+	}
+	if len(candidates) == 0 {
+		number := header.Number.Uint64()
+		snap, err := p.snapshot(chain, number-1, header.ParentHash, nil)
+		if err != nil {
+			return nil, err
+		}
+		log.Warn("No eligible validators in staking contract, retaining current set", "number", number)
+		return snap.validators(), nil
+	}
+	// Only the validators with the highest total stake are active, ties broken
+	// by the lower address
+	limit := p.config.MaxValidators
+	if governed, ok := p.governed(state, governance.MaxValidators, header.Number.Uint64()+1); ok {
+		limit = governed.Uint64()
+	}
+	if limit > 0 && uint64(len(candidates)) > limit {
+		slices.SortFunc(candidates, func(a, b candidate) int {
+			if c := b.stake.Cmp(a.stake); c != 0 {
+				return c
+			}
+			return a.Address.Cmp(b.Address)
+		})
+		candidates = candidates[:limit]
+	}
+	validators := make([]Validator, len(candidates))
+	for i, c := range candidates {
+		validators[i] = c.Validator
+	}
+	slices.SortFunc(validators, func(a, b Validator) int {
+		return a.Address.Cmp(b.Address)
+	})
+	return validators, nil
+}
+
+// FinalizeAndAssemble implements consensus.Engine, ensuring no uncles nor
+// withdrawals are set, and returns the final block.
+func (p *Pixelzx) FinalizeAndAssemble(chain consensus.ChainHeaderReader, header *types.Header, state *state.StateDB, body *types.Body, receipts []*types.Receipt) (*types.Block, error) {
+	if len(body.Withdrawals) > 0 {
+		return nil, errors.New("pixelzx does not support withdrawals")
+	}
+	// All blocks after Shanghai must include an (empty) withdrawals root
+	body = &types.Body{Transactions: body.Transactions}
+	if chain.Config().IsShanghai(header.Number, header.Time) {
+		body.Withdrawals = make([]*types.Withdrawal, 0)
+	}
+	// Finalize block
+	p.Finalize(chain, header, state, body)
+
+	// Checkpoint blocks carry the validator set elected for the next epoch
+	if header.Number.Uint64()%p.config.Epoch == 0 {
+		if len(header.Extra) < extraVanity+extraSeal {
+			return nil, errMissingSignature
+		}
+		validators, err := p.electValidators(chain, header, state)
+		if err != nil {
+			return nil, err
+		}
+		extra := slices.Clone(header.Extra[:extraVanity])
+		extra = append(extra, encodeValidators(validators)...)
+		header.Extra = append(extra, header.Extra[len(header.Extra)-extraSeal:]...)
+	}
+
+	// Assign the final state root to header.
+	header.Root = state.IntermediateRoot(chain.Config().IsEIP158(header.Number))
+
+	// Assemble and return the final block for sealing.
+	return types.NewBlock(header, body, receipts, trie.NewStackTrie(nil)), nil
+}
+
+// Authorize injects a private key into the consensus engine to mint new blocks
+// with.
+func (p *Pixelzx) Authorize(signer common.Address, signFn SignerFn) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.signer = signer
+	p.signFn = signFn
+}
+
+// Signer returns the address of the validator the engine seals blocks with, or
+// the zero address if no signer was authorized.
+func (p *Pixelzx) Signer() common.Address {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.signer
+}
+
+// Seal implements consensus.Engine, attempting to create a sealed block using
+// the local signing credentials.
+func (p *Pixelzx) Seal(chain consensus.ChainHeaderReader, block *types.Block, results chan<- *types.Block, stop <-chan struct{}) error {
+	header := block.Header()
+
+	// Sealing the genesis block is not supported
+	number := header.Number.Uint64()
+	if number == 0 {
+		return errUnknownBlock
+	}
+	// For 0-period chains, refuse to seal empty blocks (no reward but would spin sealing)
+	if p.config.Period == 0 && len(block.Transactions()) == 0 {
+		return errors.New("sealing paused while waiting for transactions")
+	}
+	// Don't hold the signer fields for the entire sealing procedure
+	p.lock.RLock()
+	signer, signFn := p.signer, p.signFn
+	p.lock.RUnlock()
+
+	if signFn == nil {
+		return errors.New("pixelzx signer not authorized")
+	}
+	// Bail out if we're unauthorized to sign a block
+	snap, err := p.snapshot(chain, number-1, header.ParentHash, nil)
+	if err != nil {
+		return err
+	}
+	if _, authorized := snap.Validators[signer]; !authorized {
+		return errUnauthorizedValidator
+	}
+	if header.Coinbase != signer {
+		return errInvalidCoinbase
+	}
+	// If we're amongst the recent signers, wait for the next block
+	inturn := snap.inturn(signer)
+	if !inturn && snap.recentlySigned(signer, number) {
+		return errors.New("signed recently, must wait for others")
+	}
+	// Sweet, the protocol permits us to sign the block, wait for our time
+	delay := time.Until(time.Unix(int64(header.Time), 0))
+	if !inturn {
+		// It's not our turn explicitly to sign, delay it a bit
+		wiggle := time.Duration(len(snap.Validators)/2+1) * wiggleTime
+		delay += time.Duration(rand.Int63n(int64(wiggle)))
+
+		log.Trace("Out-of-turn signing requested", "wiggle", common.PrettyDuration(wiggle))
+	}
+	// Wait until sealing is terminated or delay timeout. The block is only signed
+	// once its slot arrived, so abandoned blocks never reach the signer and its
+	// slashing protection records.
+	log.Trace("Waiting for slot to sign and propagate", "delay", common.PrettyDuration(delay))
+	go func() {
+		select {
+		case <-stop:
+			return
+		case <-time.After(delay):
+		}
+		// Sign all the things!
+		sighash, err := signFn(accounts.Account{Address: signer}, accounts.MimetypePixelzx, PixelzxRLP(header))
+		if err != nil {
+			log.Warn("Failed to sign block", "number", number, "err", err)
+			return
+		}
+		copy(header.Extra[len(header.Extra)-extraSeal:], sighash)
+
+		select {
+		case results <- block.WithSeal(header):
+		default:
+			log.Warn("Sealing result is not read by miner", "sealhash", SealHash(header))
+		}
+	}()
+
+	return nil
+}
+
+// CalcDifficulty is the difficulty adjustment algorithm. It returns the difficulty
+// that a new block should have based on the previous blocks in the chain and the
+// current signer: 2 for the in-turn proposer, 1 for backup proposers.
+func (p *Pixelzx) CalcDifficulty(chain consensus.ChainHeaderReader, time uint64, parent *types.Header) *big.Int {
+	snap, err := p.snapshot(chain, parent.Number.Uint64(), parent.Hash(), nil)
+	if err != nil {
+		return nil
+	}
+	return calcDifficulty(snap, p.Signer())
+}
+
+// calcDifficulty returns the difficulty of a block sealed by the given validator
+// on top of the snapshot.
+func calcDifficulty(snap *Snapshot, signer common.Address) *big.Int {
+	if snap.inturn(signer) {
+		return new(big.Int).Set(diffInTurn)
+	}
+	return new(big.Int).Set(diffNoTurn)
+}
+
+// SealHash returns the hash of a block prior to it being sealed.
+func (p *Pixelzx) SealHash(header *types.Header) common.Hash {
+	return SealHash(header)
+}
+
+// Close implements consensus.Engine, terminating the evidence subscriptions.
+func (p *Pixelzx) Close() error {
+	p.scope.Close()
+	return nil
+}
+
+// SealHash returns the hash of a block prior to it being sealed.
+func SealHash(header *types.Header) (hash common.Hash) {
+	hasher := sha3.NewLegacyKeccak256()
+	encodeSigHeader(hasher, header)
+	hasher.(crypto.KeccakState).Read(hash[:])
+	return hash
+}
+
+// PixelzxRLP returns the rlp bytes which needs to be signed for the proof-of-stake
+// sealing. The RLP to sign consists of the entire header apart from the 65 byte
+// signature contained at the end of the extra data.
+//
+// Note, the method requires the extra data to be at least 65 bytes, otherwise it
+// panics. This is done to avoid accidentally using both forms (signature present
+// or not), which could be abused to produce different hashes for the same header.
+func PixelzxRLP(header *types.Header) []byte {
+	b := new(bytes.Buffer)
+	encodeSigHeader(b, header)
+	return b.Bytes()
+}
+
+func encodeSigHeader(w io.Writer, header *types.Header) {
+	enc := []interface{}{
+		header.ParentHash,
+		header.UncleHash,
+		header.Coinbase,
+		header.Root,
+		header.TxHash,
+		header.ReceiptHash,
+		header.Bloom,
+		header.Difficulty,
+		header.Number,
+		header.GasLimit,
+		header.GasUsed,
+		header.Time,
+		header.Extra[:len(header.Extra)-crypto.SignatureLength], // Yes, this will panic if extra is too short
+		header.MixDigest,
+		header.Nonce,
+	}
+	if header.BaseFee != nil {
+		enc = append(enc, header.BaseFee)
+	}
+	if header.WithdrawalsHash != nil {
+		enc = append(enc, header.WithdrawalsHash)
+	}
+	if header.BlobGasUsed != nil {
+		enc = append(enc, header.BlobGasUsed)
+	}
+	if header.ExcessBlobGas != nil {
+		enc = append(enc, header.ExcessBlobGas)
+	}
+	if header.ParentBeaconRoot != nil {
+		enc = append(enc, header.ParentBeaconRoot)
+	}
+	if header.RequestsHash != nil {
+		enc = append(enc, header.RequestsHash)
+	}
+	if err := rlp.Encode(w, enc); err != nil {
+		panic("can't encode: " + err.Error())
+	}
+}
@@ -0,0 +1,697 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/governance"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// testBackend is a PIXELZX chain with a fixed set of validators, used to mint
+// and import blocks sealed by arbitrary keys.
+type testBackend struct {
+	t       *testing.T
+	keys    []*ecdsa.PrivateKey // Validator keys, sorted by address
+	genesis *core.Genesis
+	db      ethdb.Database
+	engine  *Pixelzx
+	chain   *core.BlockChain
+}
+
+// newTestBackend creates a chain with one validator per given voting power.
+func newTestBackend(t *testing.T, powers ...uint64) *testBackend {
+	return newCustomTestBackend(t, nil, powers...)
+}
+
+// newCustomTestBackend creates a chain with one validator per given voting power,
+// allowing the consensus parameters to be tweaked before the chain is created.
+func newCustomTestBackend(t *testing.T, customize func(config *params.PixelzxConfig), powers ...uint64) *testBackend {
+	keys := make([]*ecdsa.PrivateKey, len(powers))
+	for i := range keys {
+		keys[i], _ = crypto.GenerateKey()
+	}
+	slices.SortFunc(keys, func(a, b *ecdsa.PrivateKey) int {
+		return crypto.PubkeyToAddress(a.PublicKey).Cmp(crypto.PubkeyToAddress(b.PublicKey))
+	})
+	var (
+		validators = make([]Validator, len(keys))
+		stakes     = make([]staking.GenesisValidator, len(keys))
+		alloc      = make(types.GenesisAlloc)
+	)
+	for i, key := range keys {
+		addr := crypto.PubkeyToAddress(key.PublicKey)
+		validators[i] = Validator{Address: addr, Power: powers[i]}
+		stakes[i] = staking.GenesisValidator{Address: addr, Stake: pzx(powers[i])}
+		alloc[addr] = types.Account{Balance: pzx(1000)}
+	}
+	alloc[params.PixelzxStakingAddress] = staking.GenesisAccount(stakes)
+
+	config := *params.AllPixelzxProtocolChanges
+	config.Pixelzx = &params.PixelzxConfig{Period: 10, Epoch: 8, MinValidatorStake: pzx(1), UnbondingPeriod: 16}
+	if customize != nil {
+		customize(config.Pixelzx)
+	}
+	if config.Pixelzx.IsGovernance(common.Big0) {
+		alloc[params.PixelzxGovernanceAddress] = governance.GenesisAccount()
+	}
+
+	genesis := &core.Genesis{
+		Config:    &config,
+		ExtraData: makeExtra(validators),
+		BaseFee:   big.NewInt(params.InitialBaseFee),
+		Alloc:     alloc,
+	}
+	db := rawdb.NewMemoryDatabase()
+	engine := New(config.Pixelzx, db)
+
+	chain, err := core.NewBlockChain(db, genesis, engine, core.DefaultConfig().WithArchive(true))
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	t.Cleanup(chain.Stop)
+
+	return &testBackend{t: t, keys: keys, genesis: genesis, db: db, engine: engine, chain: chain}
+}
+
+// pzx converts an amount of whole PZX into wei.
+func pzx(amount uint64) *big.Int {
+	return new(big.Int).Mul(new(big.Int).SetUint64(amount), big.NewInt(params.PZX))
+}
+
+// makeExtra assembles the extra-data of a checkpoint header.
+func makeExtra(validators []Validator) []byte {
+	extra := make([]byte, extraVanity)
+	extra = append(extra, encodeValidators(validators)...)
+	return append(extra, make([]byte, extraSeal)...)
+}
+
+// proposer returns the key of the in-turn proposer of the block after parent.
+func (b *testBackend) proposer(parent *types.Block) *ecdsa.PrivateKey {
+	snap, err := b.engine.snapshot(b.chain, parent.NumberU64(), parent.Hash(), nil)
+	if err != nil {
+		b.t.Fatalf("failed to retrieve snapshot: %v", err)
+	}
+	want := snap.proposer()
+	for _, key := range b.keys {
+		if crypto.PubkeyToAddress(key.PublicKey) == want {
+			return key
+		}
+	}
+	b.t.Fatalf("proposer %x not found", want)
+	return nil
+}
+
+// makeBlock creates a block on top of parent, sealed by the given key. The chain
+// maker spaces blocks 10 seconds apart, delay is added on top of that. The
+// modifier may be used to tweak the header prior to sealing.
+func (b *testBackend) makeBlock(parent *types.Block, key *ecdsa.PrivateKey, delay int64, modify func(header *types.Header)) *types.Block {
+	return b.makeBlockWithTxs(parent, key, delay, nil, modify)
+}
+
+// makeBlockWithTxs is like makeBlock, but also includes the given transactions.
+func (b *testBackend) makeBlockWithTxs(parent *types.Block, key *ecdsa.PrivateKey, delay int64, txs []*types.Transaction, modify func(header *types.Header)) *types.Block {
+	signer := crypto.PubkeyToAddress(key.PublicKey)
+
+	blocks, _ := core.GenerateChain(b.genesis.Config, parent, b.engine, b.db, 1, func(i int, gen *core.BlockGen) {
+		gen.SetCoinbase(signer)
+		gen.SetExtra(make([]byte, extraVanity+extraSeal))
+		if delay != 0 {
+			gen.OffsetTime(delay)
+		}
+		for _, tx := range txs {
+			gen.AddTx(tx)
+		}
+	})
+	header := blocks[0].Header()
+
+	snap, err := b.engine.snapshot(b.chain, parent.NumberU64(), parent.Hash(), nil)
+	if err != nil {
+		b.t.Fatalf("failed to retrieve snapshot: %v", err)
+	}
+	header.Difficulty = calcDifficulty(snap, signer)
+
+	// The chain maker only knows the blocks above parent, recompute the state with
+	// the whole chain for the rules looking further back, like evidence
+	if len(txs) > 0 && b.chain.HasBlock(parent.Hash(), parent.NumberU64()) {
+		statedb, err := b.chain.StateAt(parent.Root())
+		if err != nil {
+			b.t.Fatalf("failed to retrieve parent state: %v", err)
+		}
+		block := types.NewBlockWithHeader(header).WithBody(*blocks[0].Body())
+		if _, err := b.chain.Processor().Process(block, statedb, vm.Config{}); err != nil {
+			b.t.Fatalf("failed to process block: %v", err)
+		}
+		header.Root = statedb.IntermediateRoot(b.genesis.Config.IsEIP158(header.Number))
+	}
+	if modify != nil {
+		modify(header)
+	}
+	sig, err := crypto.Sign(SealHash(header).Bytes(), key)
+	if err != nil {
+		b.t.Fatalf("failed to sign header: %v", err)
+	}
+	copy(header.Extra[len(header.Extra)-extraSeal:], sig)
+	return blocks[0].WithSeal(header)
+}
+
+// stakingTx creates a transaction calling a method of the staking contract. The
+// transaction pays a tip of 1 gwei per gas.
+func (b *testBackend) stakingTx(key *ecdsa.PrivateKey, nonce uint64, value *big.Int, method string, args ...any) *types.Transaction {
+	return b.systemTx(key, nonce, params.PixelzxStakingAddress, staking.ABI, value, method, args...)
+}
+
+// governanceTx creates a transaction calling a method of the governance contract.
+func (b *testBackend) governanceTx(key *ecdsa.PrivateKey, nonce uint64, method string, args ...any) *types.Transaction {
+	return b.systemTx(key, nonce, params.PixelzxGovernanceAddress, governance.ABI, new(big.Int), method, args...)
+}
+
+// systemTx creates a transaction calling a method of a system contract.
+func (b *testBackend) systemTx(key *ecdsa.PrivateKey, nonce uint64, to common.Address, contract abi.ABI, value *big.Int, method string, args ...any) *types.Transaction {
+	input, err := contract.Pack(method, args...)
+	if err != nil {
+		b.t.Fatalf("failed to pack %s call: %v", method, err)
+	}
+	return types.MustSignNewTx(key, types.LatestSigner(b.genesis.Config), &types.DynamicFeeTx{
+		ChainID:   b.genesis.Config.ChainID,
+		Nonce:     nonce,
+		Gas:       200_000,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+		To:        &to,
+		Value:     value,
+		Data:      input,
+	})
+}
+
+// Tests that a chain sealed by the in-turn proposers can be imported across
+// epoch boundaries, and that proposers take turns according to their stake.
+func TestInTurnChainImport(t *testing.T) {
+	b := newTestBackend(t, 1, 2, 5)
+
+	var (
+		parent = b.chain.Genesis()
+		counts = make(map[common.Address]int)
+	)
+	for i := 0; i < 32; i++ {
+		block := b.makeBlock(parent, b.proposer(parent), 0, nil)
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+		author, err := b.engine.Author(block.Header())
+		if err != nil {
+			t.Fatalf("block %d: failed to recover author: %v", block.NumberU64(), err)
+		}
+		if author != block.Coinbase() {
+			t.Fatalf("block %d: author mismatch: have %x, want %x", block.NumberU64(), author, block.Coinbase())
+		}
+		counts[author]++
+		parent = block
+	}
+	if head := b.chain.CurrentBlock().Number.Uint64(); head != 32 {
+		t.Fatalf("chain head mismatch: have %d, want %d", head, 32)
+	}
+	// Every epoch of 8 blocks is exactly one full rotation of the 1:2:5 stakes
+	for i, power := range []int{1, 2, 5} {
+		addr := crypto.PubkeyToAddress(b.keys[i].PublicKey)
+		if counts[addr] != 4*power {
+			t.Errorf("validator %d: proposed blocks mismatch: have %d, want %d", i, counts[addr], 4*power)
+		}
+	}
+}
+
+// Tests that checkpoint blocks elect the validator set from the stakes bonded in
+// the staking contract, picking up delegations and dropping validators whose
+// self stake falls under the minimum.
+func TestValidatorSetFromStaking(t *testing.T) {
+	b := newTestBackend(t, 1, 1)
+
+	addrs := []common.Address{crypto.PubkeyToAddress(b.keys[0].PublicKey), crypto.PubkeyToAddress(b.keys[1].PublicKey)}
+	txs := []*types.Transaction{
+		b.stakingTx(b.keys[0], 0, pzx(3), "delegate", addrs[0]),
+		b.stakingTx(b.keys[1], 0, new(big.Int), "undelegate", addrs[1], pzx(1)),
+	}
+	parent := b.chain.Genesis()
+	for i := 0; i < 8; i++ {
+		var block *types.Block
+		if i == 0 {
+			block = b.makeBlockWithTxs(parent, b.proposer(parent), 0, txs, nil)
+		} else {
+			block = b.makeBlock(parent, b.proposer(parent), 0, nil)
+		}
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+		parent = block
+	}
+	receipts := b.chain.GetReceiptsByHash(b.chain.GetHeaderByNumber(1).Hash())
+	for i, receipt := range receipts {
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx %d: staking call failed", i)
+		}
+	}
+	have, err := parseValidators(parent.Header())
+	if err != nil {
+		t.Fatalf("failed to parse checkpoint validators: %v", err)
+	}
+	want := []Validator{{Address: addrs[0], Power: 4}}
+	if !slices.Equal(have, want) {
+		t.Fatalf("checkpoint validators mismatch: have %v, want %v", have, want)
+	}
+	snap, err := b.engine.snapshot(b.chain, parent.NumberU64(), parent.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve snapshot: %v", err)
+	}
+	if vals := snap.validators(); !slices.Equal(vals, want) {
+		t.Fatalf("snapshot validators mismatch: have %v, want %v", vals, want)
+	}
+}
+
+// Tests that checkpoint blocks only elect the validators with the highest stake
+// if more are eligible than the configured maximum, breaking ties by address.
+func TestValidatorSetRotation(t *testing.T) {
+	b := newCustomTestBackend(t, func(config *params.PixelzxConfig) {
+		config.MaxValidators = 2
+	}, 1, 1, 3)
+
+	var (
+		addrs  = []common.Address{crypto.PubkeyToAddress(b.keys[0].PublicKey), crypto.PubkeyToAddress(b.keys[1].PublicKey), crypto.PubkeyToAddress(b.keys[2].PublicKey)}
+		parent = b.chain.Genesis()
+		epoch  = b.genesis.Config.Pixelzx.Epoch
+	)
+	advance := func(txs []*types.Transaction) {
+		block := b.makeBlockWithTxs(parent, b.proposer(parent), 0, txs, nil)
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+		parent = block
+	}
+	check := func(want []Validator) {
+		t.Helper()
+		have, err := parseValidators(parent.Header())
+		if err != nil {
+			t.Fatalf("block %d: failed to parse checkpoint validators: %v", parent.NumberU64(), err)
+		}
+		if !slices.Equal(have, want) {
+			t.Fatalf("block %d: checkpoint validators mismatch: have %v, want %v", parent.NumberU64(), have, want)
+		}
+	}
+	// The two lightest validators are tied, the lower address wins
+	for parent.NumberU64() < epoch {
+		advance(nil)
+	}
+	check([]Validator{{Address: addrs[0], Power: 1}, {Address: addrs[2], Power: 3}})
+
+	// Bonding more stake to the inactive validator rotates it in
+	advance([]*types.Transaction{b.stakingTx(b.keys[1], 0, pzx(1), "delegate", addrs[1])})
+	for parent.NumberU64() < 2*epoch {
+		advance(nil)
+	}
+	check([]Validator{{Address: addrs[1], Power: 2}, {Address: addrs[2], Power: 3}})
+
+	snap, err := b.engine.snapshot(b.chain, parent.NumberU64(), parent.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve snapshot: %v", err)
+	}
+	if _, ok := snap.Validators[addrs[0]]; ok {
+		t.Fatalf("rotated out validator still active")
+	}
+}
+
+// Tests that validators registered with a consensus key are elected and seal
+// with it, while the rewards of their blocks go to the registering account, and
+// that rotating the key switches the sealer at the next epoch.
+func TestConsensusKeySealing(t *testing.T) {
+	b := newCustomTestBackend(t, func(config *params.PixelzxConfig) {
+		config.RewardSchedule = []params.PixelzxReward{{Block: new(big.Int), Reward: pzx(1)}}
+	}, 1)
+
+	var (
+		operator, _  = crypto.GenerateKey()
+		consensus, _ = crypto.GenerateKey()
+		operatorAddr = crypto.PubkeyToAddress(operator.PublicKey)
+		signerAddr   = crypto.PubkeyToAddress(consensus.PublicKey)
+		parent       = b.chain.Genesis()
+		epoch        = b.genesis.Config.Pixelzx.Epoch
+	)
+	b.keys = append(b.keys, consensus)
+
+	advance := func(key *ecdsa.PrivateKey, txs []*types.Transaction) {
+		t.Helper()
+		block := b.makeBlockWithTxs(parent, key, 0, txs, nil)
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+		parent = block
+	}
+	// Fund the operator and register it with the consensus key
+	fund := types.MustSignNewTx(b.keys[0], types.LatestSigner(b.genesis.Config), &types.DynamicFeeTx{
+		ChainID:   b.genesis.Config.ChainID,
+		Gas:       params.TxGas,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+		To:        &operatorAddr,
+		Value:     pzx(10),
+	})
+	advance(b.proposer(parent), []*types.Transaction{
+		fund,
+		b.stakingTx(operator, 0, pzx(2), "registerValidator", new(big.Int), "operator", crypto.FromECDSAPub(&consensus.PublicKey)),
+	})
+	for i, receipt := range b.chain.GetReceiptsByHash(parent.Hash()) {
+		if receipt.Status != types.ReceiptStatusSuccessful {
+			t.Fatalf("tx %d: failed", i)
+		}
+	}
+	for parent.NumberU64() < epoch {
+		advance(b.proposer(parent), nil)
+	}
+	// The validator is elected under the address of its consensus key
+	have, err := parseValidators(parent.Header())
+	if err != nil {
+		t.Fatalf("failed to parse checkpoint validators: %v", err)
+	}
+	want := []Validator{{Address: crypto.PubkeyToAddress(b.keys[0].PublicKey), Power: 1}, {Address: signerAddr, Power: 2}}
+	slices.SortFunc(want, func(a, b Validator) int { return a.Address.Cmp(b.Address) })
+	if !slices.Equal(have, want) {
+		t.Fatalf("checkpoint validators mismatch: have %v, want %v", have, want)
+	}
+	// Blocks sealed by the operator account itself are rejected
+	if _, err := b.chain.InsertChain(types.Blocks{b.makeBlock(parent, operator, 0, nil)}); !errors.Is(err, errUnauthorizedValidator) {
+		t.Fatalf("operator sealed block error mismatch: have %v, want %v", err, errUnauthorizedValidator)
+	}
+	// Blocks sealed by the consensus key credit the operator
+	for b.proposer(parent) != consensus {
+		advance(b.proposer(parent), nil)
+	}
+	before, _ := b.chain.StateAt(parent.Root())
+	advance(consensus, nil)
+	after, _ := b.chain.StateAt(parent.Root())
+
+	if have := new(big.Int).Sub(after.GetBalance(operatorAddr).ToBig(), before.GetBalance(operatorAddr).ToBig()); have.Cmp(pzx(1)) != 0 {
+		t.Fatalf("operator reward mismatch: have %v, want %v", have, pzx(1))
+	}
+	if have := after.GetBalance(signerAddr); !have.IsZero() {
+		t.Fatalf("consensus key credited with %v", have)
+	}
+	// Rotating the key takes effect at the next checkpoint only
+	rotated, _ := crypto.GenerateKey()
+	b.keys = append(b.keys, rotated)
+
+	advance(b.proposer(parent), []*types.Transaction{
+		b.stakingTx(operator, 1, new(big.Int), "rotateConsensusKey", crypto.FromECDSAPub(&rotated.PublicKey)),
+	})
+	if receipts := b.chain.GetReceiptsByHash(parent.Hash()); receipts[0].Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("key rotation failed")
+	}
+	for parent.NumberU64() < 2*epoch {
+		advance(b.proposer(parent), nil)
+	}
+	have, err = parseValidators(parent.Header())
+	if err != nil {
+		t.Fatalf("failed to parse checkpoint validators: %v", err)
+	}
+	want[slices.Index(want, Validator{Address: signerAddr, Power: 2})].Address = crypto.PubkeyToAddress(rotated.PublicKey)
+	slices.SortFunc(want, func(a, b Validator) int { return a.Address.Cmp(b.Address) })
+	if !slices.Equal(have, want) {
+		t.Fatalf("checkpoint validators mismatch after rotation: have %v, want %v", have, want)
+	}
+	if _, err := b.chain.InsertChain(types.Blocks{b.makeBlock(parent, consensus, 0, nil)}); !errors.Is(err, errUnauthorizedValidator) {
+		t.Fatalf("rotated out key sealed block error mismatch: have %v, want %v", err, errUnauthorizedValidator)
+	}
+}
+
+// Tests that blocks violating the sealing rules are rejected on import.
+func TestInvalidBlocks(t *testing.T) {
+	outsider, _ := crypto.GenerateKey()
+
+	tests := []struct {
+		name   string
+		make   func(b *testBackend, parent *types.Block) *types.Block
+		number uint64
+		err    error
+	}{
+		{
+			name: "unauthorized validator",
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, outsider, 0, nil)
+			},
+			err: errUnauthorizedValidator,
+		},
+		{
+			name: "coinbase not proposer",
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.proposer(parent), 0, func(header *types.Header) {
+					header.Coinbase = common.Address{0xff}
+				})
+			},
+			err: errInvalidCoinbase,
+		},
+		{
+			name: "backup proposer too early",
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.backup(parent), 0, nil)
+			},
+			err: errBackupTooEarly,
+		},
+		{
+			name: "backup proposer after in-turn slot",
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.backup(parent), 10, nil)
+			},
+		},
+		{
+			name: "invalid difficulty",
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.proposer(parent), 0, func(header *types.Header) {
+					header.Difficulty = big.NewInt(3)
+				})
+			},
+			err: errInvalidDifficulty,
+		},
+		{
+			name: "in-turn proposer with backup difficulty",
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.proposer(parent), 0, func(header *types.Header) {
+					header.Difficulty = new(big.Int).Set(diffNoTurn)
+				})
+			},
+			err: errWrongDifficulty,
+		},
+		{
+			name: "backup proposer with in-turn difficulty",
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.backup(parent), 10, func(header *types.Header) {
+					header.Difficulty = new(big.Int).Set(diffInTurn)
+				})
+			},
+			err: errWrongDifficulty,
+		},
+		{
+			name:   "backup proposer signed recently",
+			number: 1,
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.recent(parent), 10, nil)
+			},
+			err: errRecentlySigned,
+		},
+		{
+			name: "validators on non-checkpoint",
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.proposer(parent), 0, func(header *types.Header) {
+					header.Extra = bytes.Clone(b.genesis.ExtraData)
+				})
+			},
+			err: errExtraValidators,
+		},
+		{
+			name:   "mismatching checkpoint validators",
+			number: 7,
+			make: func(b *testBackend, parent *types.Block) *types.Block {
+				return b.makeBlock(parent, b.proposer(parent), 0, func(header *types.Header) {
+					validators, _ := parseValidators(header)
+					validators[0].Power++
+					header.Extra = makeExtra(validators)
+				})
+			},
+			err: errMismatchingCheckpointValidators,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := newTestBackend(t, 1, 2, 3)
+
+			// Build a valid prefix of the chain if requested
+			parent := b.chain.Genesis()
+			for i := uint64(0); i < tt.number; i++ {
+				block := b.makeBlock(parent, b.proposer(parent), 0, nil)
+				if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+					t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+				}
+				parent = block
+			}
+			_, err := b.chain.InsertChain(types.Blocks{tt.make(b, parent)})
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("import error mismatch: have %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+// backup returns the key of a validator which is not the in-turn proposer of the
+// block after parent.
+func (b *testBackend) backup(parent *types.Block) *ecdsa.PrivateKey {
+	proposer := b.proposer(parent)
+	for _, key := range b.keys {
+		if key != proposer {
+			return key
+		}
+	}
+	b.t.Fatalf("no backup proposer available")
+	return nil
+}
+
+// recent returns the key of the validator which sealed parent, failing the test
+// if it is also the in-turn proposer of the block after parent.
+func (b *testBackend) recent(parent *types.Block) *ecdsa.PrivateKey {
+	for _, key := range b.keys {
+		if crypto.PubkeyToAddress(key.PublicKey) == parent.Coinbase() {
+			if key == b.proposer(parent) {
+				b.t.Fatalf("sealer of block %d is in-turn again", parent.NumberU64())
+			}
+			return key
+		}
+	}
+	b.t.Fatalf("sealer of block %d not found", parent.NumberU64())
+	return nil
+}
+
+// Tests that the engine seals blocks with the authorized key, producing headers
+// passing its own verification.
+func TestSeal(t *testing.T) {
+	b := newTestBackend(t, 1, 1)
+
+	var (
+		parent = b.chain.Genesis()
+		key    = b.proposer(parent)
+		signer = crypto.PubkeyToAddress(key.PublicKey)
+	)
+	b.engine.Authorize(signer, func(account accounts.Account, mimeType string, message []byte) ([]byte, error) {
+		if mimeType != accounts.MimetypePixelzx {
+			t.Errorf("mime type mismatch: have %s, want %s", mimeType, accounts.MimetypePixelzx)
+		}
+		return crypto.Sign(crypto.Keccak256(message), key)
+	})
+	block := b.makeBlock(parent, key, 0, func(header *types.Header) {
+		header.Extra = make([]byte, extraVanity+extraSeal)
+	})
+	var (
+		results = make(chan *types.Block, 1)
+		stop    = make(chan struct{})
+	)
+	defer close(stop)
+
+	if err := b.engine.Seal(b.chain, block, results, stop); err != nil {
+		t.Fatalf("failed to seal block: %v", err)
+	}
+	select {
+	case sealed := <-results:
+		if err := b.engine.VerifyHeader(b.chain, sealed.Header()); err != nil {
+			t.Fatalf("failed to verify sealed header: %v", err)
+		}
+		if author, _ := b.engine.Author(sealed.Header()); author != signer {
+			t.Fatalf("author mismatch: have %x, want %x", author, signer)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("sealing timed out")
+	}
+}
+
+// Tests that sealing waits for the slot of the block before signing it, so an
+// aborted block is never signed.
+func TestSealSignsAfterDelay(t *testing.T) {
+	b := newTestBackend(t, 1, 1)
+
+	var (
+		parent = b.chain.Genesis()
+		key    = b.proposer(parent)
+		signed = make(chan struct{}, 1)
+	)
+	b.engine.Authorize(crypto.PubkeyToAddress(key.PublicKey), func(account accounts.Account, mimeType string, message []byte) ([]byte, error) {
+		signed <- struct{}{}
+		return crypto.Sign(crypto.Keccak256(message), key)
+	})
+	block := b.makeBlock(parent, key, 0, func(header *types.Header) {
+		header.Time = uint64(time.Now().Add(time.Hour).Unix())
+	})
+	var (
+		results = make(chan *types.Block, 1)
+		stop    = make(chan struct{})
+	)
+	if err := b.engine.Seal(b.chain, block, results, stop); err != nil {
+		t.Fatalf("failed to seal block: %v", err)
+	}
+	close(stop)
+
+	select {
+	case <-signed:
+		t.Fatalf("block signed before its slot")
+	case <-results:
+		t.Fatalf("sealing result delivered after abort")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// Tests that the seal hash covers the optional header fields.
+func TestSealHashOptionalFields(t *testing.T) {
+	header := &types.Header{
+		Difficulty: new(big.Int),
+		Number:     new(big.Int),
+		Extra:      make([]byte, extraVanity+extraSeal),
+		BaseFee:    new(big.Int),
+	}
+	prev := SealHash(header)
+
+	header.WithdrawalsHash = &types.EmptyWithdrawalsHash
+	header.BlobGasUsed, header.ExcessBlobGas = new(uint64), new(uint64)
+	header.ParentBeaconRoot = new(common.Hash)
+	if SealHash(header) == prev {
+		t.Fatalf("seal hash doesn't cover post-shanghai fields")
+	}
+	prev = SealHash(header)
+
+	header.RequestsHash = &types.EmptyRequestsHash
+	if SealHash(header) == prev {
+		t.Fatalf("seal hash doesn't cover requests hash")
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/governance"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// basisPoints is the denominator of the shares expressed in basis points.
+var basisPoints = uint256.NewInt(10_000)
+
+// FeeRecipient implements consensus.FeeCollector, collecting the transaction tips
+// into the reward pool instead of paying them to the proposer right away. They
+// are distributed together with the block reward in Finalize.
+func (p *Pixelzx) FeeRecipient(header *types.Header) common.Address {
+	return params.PixelzxRewardPoolAddress
+}
+
+// distributeRewards issues the block reward, as scheduled or as set by governance,
+// into the reward pool and distributes the pool (block reward and transaction
+// tips) between the community treasury, the block proposer and its delegators.
+func (p *Pixelzx) distributeRewards(header *types.Header, state vm.StateDB) {
+	pool := params.PixelzxRewardPoolAddress
+
+	reward := p.config.BlockReward(header.Number)
+	if governed, ok := p.governed(state, governance.BlockReward, header.Number.Uint64()); ok {
+		reward = governed
+	}
+	if reward := uint256.MustFromBig(reward); !reward.IsZero() {
+		state.AddBalance(pool, reward, tracing.BalanceIncreasePixelzxBlockReward)
+	}
+	total := state.GetBalance(pool).Clone()
+	if total.IsZero() {
+		return
+	}
+	state.SubBalance(pool, total, tracing.BalanceDecreasePixelzxRewardPool)
+
+	// Pay the community treasury its cut, if configured
+	remaining := total.Clone()
+	if p.config.Treasury != nil && p.config.TreasuryShare > 0 {
+		share := new(uint256.Int).Mul(total, uint256.NewInt(p.config.TreasuryShare))
+		share.Div(share, basisPoints)
+		if !share.IsZero() {
+			state.AddBalance(*p.config.Treasury, share, tracing.BalanceIncreasePixelzxTreasuryReward)
+			remaining.Sub(remaining, share)
+		}
+	}
+	// Split the rest between the proposing validator and its delegators. The
+	// proposer's share goes to the validator, not to the consensus key sealing
+	validator := staking.ValidatorOf(state, header.Coinbase)
+	proposer, delegators := staking.DistributeReward(state, validator, remaining)
+	if !delegators.IsZero() {
+		state.AddBalance(params.PixelzxStakingAddress, delegators, tracing.BalanceIncreasePixelzxDelegatorReward)
+	}
+	if !proposer.IsZero() {
+		state.AddBalance(validator, proposer, tracing.BalanceIncreasePixelzxProposerReward)
+	}
+}
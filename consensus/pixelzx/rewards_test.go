@@ -0,0 +1,107 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that the block reward and the transaction tips are split between the
+// treasury, the proposer and its delegators.
+func TestBlockRewards(t *testing.T) {
+	treasury := common.Address{0x7e}
+	b := newCustomTestBackend(t, func(config *params.PixelzxConfig) {
+		config.RewardSchedule = []params.PixelzxReward{{Block: new(big.Int), Reward: pzx(2)}}
+		config.Treasury = &treasury
+		config.TreasuryShare = 1000
+	}, 1, 1)
+
+	var (
+		validator = crypto.PubkeyToAddress(b.keys[0].PublicKey)
+		delegator = crypto.PubkeyToAddress(b.keys[1].PublicKey)
+	)
+	stateAt := func(block *types.Block) *state.StateDB {
+		statedb, err := b.chain.StateAt(block.Root())
+		if err != nil {
+			t.Fatalf("failed to retrieve state: %v", err)
+		}
+		return statedb
+	}
+	insert := func(block *types.Block) {
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+	}
+	// Delegate 4 PZX to the first validator, the tips go into the rewards too
+	parent := b.chain.Genesis()
+	block := b.makeBlockWithTxs(parent, b.proposer(parent), 0, []*types.Transaction{
+		b.stakingTx(b.keys[1], 0, pzx(4), "delegate", validator),
+	}, nil)
+	insert(block)
+
+	receipts := b.chain.GetReceiptsByHash(block.Hash())
+	if len(receipts) != 1 || receipts[0].Status != types.ReceiptStatusSuccessful {
+		t.Fatalf("delegation failed")
+	}
+	var (
+		tips = new(big.Int).Mul(new(big.Int).SetUint64(receipts[0].GasUsed), big.NewInt(params.GWei))
+		want = new(big.Int).Div(new(big.Int).Add(pzx(2), tips), big.NewInt(10))
+	)
+	if have := stateAt(block).GetBalance(treasury); have.ToBig().Cmp(want) != 0 {
+		t.Fatalf("treasury balance mismatch: have %v, want %v", have, want)
+	}
+	// Wait for a block proposed by the first validator and check the split:
+	// 10% of the 2 PZX reward to the treasury, the rest split 1:4 by stake.
+	for parent = block; crypto.PubkeyToAddress(b.proposer(parent).PublicKey) != validator; parent = block {
+		block = b.makeBlock(parent, b.proposer(parent), 0, nil)
+		insert(block)
+	}
+	block = b.makeBlock(parent, b.proposer(parent), 0, nil)
+	insert(block)
+
+	var (
+		before = stateAt(parent)
+		after  = stateAt(block)
+	)
+	for _, tt := range []struct {
+		name string
+		addr common.Address
+		want *big.Int
+	}{
+		{"treasury", treasury, big.NewInt(params.PZX / 5)},
+		{"proposer", validator, big.NewInt(params.PZX * 36 / 100)},
+		{"staking contract", params.PixelzxStakingAddress, big.NewInt(params.PZX * 144 / 100)},
+		{"reward pool", params.PixelzxRewardPoolAddress, new(big.Int)},
+	} {
+		have := new(big.Int).Sub(after.GetBalance(tt.addr).ToBig(), before.GetBalance(tt.addr).ToBig())
+		if have.Cmp(tt.want) != 0 {
+			t.Errorf("%s: balance change mismatch: have %v, want %v", tt.name, have, tt.want)
+		}
+	}
+	have := new(big.Int).Sub(staking.PendingRewards(after, delegator, validator), staking.PendingRewards(before, delegator, validator))
+	if want := big.NewInt(params.PZX * 144 / 100); have.Cmp(want) != 0 {
+		t.Errorf("pending delegator rewards mismatch: have %v, want %v", have, want)
+	}
+}
@@ -0,0 +1,280 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"encoding/json"
+	"maps"
+	"slices"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Validator is a single entry of the validator set, pairing a validator address
+// with the voting power (stake weight) it proposes blocks with.
+type Validator struct {
+	Address common.Address `json:"address"` // Address of the validator's signing key
+	Power   uint64         `json:"power"`   // Voting power, proportional to the total stake
+}
+
+type sigLRU = lru.Cache[common.Hash, common.Address]
+
+// Snapshot is the state of the validator set at a given point in time.
+type Snapshot struct {
+	config   *params.PixelzxConfig // Consensus engine parameters to fine tune behavior
+	sigcache *sigLRU               // Cache of recent block signatures to speed up ecrecover
+
+	Number     uint64                    `json:"number"`     // Block number where the snapshot was created
+	Hash       common.Hash               `json:"hash"`       // Block hash where the snapshot was created
+	Validators map[common.Address]uint64 `json:"validators"` // Set of active validators and their voting power
+	Priorities map[common.Address]int64  `json:"priorities"` // Proposer priorities of the stake weighted rotation
+	Recents    map[uint64]common.Address `json:"recents"`    // Set of recent proposers for spam protections
+}
+
+// newSnapshot creates a new snapshot with the specified startup parameters. The
+// proposer priorities are reset, so only ever use it for checkpoint blocks.
+func newSnapshot(config *params.PixelzxConfig, sigcache *sigLRU, number uint64, hash common.Hash, validators []Validator) *Snapshot {
+	snap := &Snapshot{
+		config:     config,
+		sigcache:   sigcache,
+		Number:     number,
+		Hash:       hash,
+		Validators: make(map[common.Address]uint64),
+		Priorities: make(map[common.Address]int64),
+		Recents:    make(map[uint64]common.Address),
+	}
+	snap.reset(validators)
+	return snap
+}
+
+// loadSnapshot loads an existing snapshot from the database.
+func loadSnapshot(config *params.PixelzxConfig, sigcache *sigLRU, db ethdb.Database, hash common.Hash) (*Snapshot, error) {
+	blob, err := db.Get(append(rawdb.PixelzxSnapshotPrefix, hash[:]...))
+	if err != nil {
+		return nil, err
+	}
+	snap := new(Snapshot)
+	if err := json.Unmarshal(blob, snap); err != nil {
+		return nil, err
+	}
+	snap.config = config
+	snap.sigcache = sigcache
+	if snap.Recents == nil {
+		snap.Recents = make(map[uint64]common.Address)
+	}
+
+	return snap, nil
+}
+
+// store inserts the snapshot into the database.
+func (s *Snapshot) store(db ethdb.Database) error {
+	blob, err := json.Marshal(s)
+	if err != nil {
+		return err
+	}
+	return db.Put(append(rawdb.PixelzxSnapshotPrefix, s.Hash[:]...), blob)
+}
+
+// copy creates a deep copy of the snapshot.
+func (s *Snapshot) copy() *Snapshot {
+	return &Snapshot{
+		config:     s.config,
+		sigcache:   s.sigcache,
+		Number:     s.Number,
+		Hash:       s.Hash,
+		Validators: maps.Clone(s.Validators),
+		Priorities: maps.Clone(s.Priorities),
+		Recents:    maps.Clone(s.Recents),
+	}
+}
+
+// reset replaces the validator set with the given one, zeroing out all proposer
+// priorities. This happens at every checkpoint, which allows any node to verify
+// the proposer rotation starting from a checkpoint header alone.
+func (s *Snapshot) reset(validators []Validator) {
+	s.Validators = make(map[common.Address]uint64, len(validators))
+	s.Priorities = make(map[common.Address]int64, len(validators))
+	for _, v := range validators {
+		s.Validators[v.Address] = v.Power
+		s.Priorities[v.Address] = 0
+	}
+}
+
+// apply creates a new validator snapshot by applying the given headers to the
+// original one.
+func (s *Snapshot) apply(headers []*types.Header) (*Snapshot, error) {
+	// Allow passing in no headers for cleaner code
+	if len(headers) == 0 {
+		return s, nil
+	}
+	// Sanity check that the headers can be applied
+	for i := 0; i < len(headers)-1; i++ {
+		if headers[i+1].Number.Uint64() != headers[i].Number.Uint64()+1 {
+			return nil, errInvalidValidatorChain
+		}
+	}
+	if headers[0].Number.Uint64() != s.Number+1 {
+		return nil, errInvalidValidatorChain
+	}
+	// Iterate through the headers and create a new snapshot
+	snap := s.copy()
+
+	var (
+		start  = time.Now()
+		logged = time.Now()
+	)
+	for i, header := range headers {
+		// Resolve the authorization key and check against validators
+		signer, err := ecrecover(header, s.sigcache)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := snap.Validators[signer]; !ok {
+			return nil, errUnauthorizedValidator
+		}
+		// Forget the proposers which left the recent window, allowing them to
+		// step in as backup proposers again
+		number := header.Number.Uint64()
+		snap.expireRecents(number)
+
+		if !snap.inturn(signer) && snap.recentlySigned(signer, number) {
+			return nil, errRecentlySigned
+		}
+		snap.Recents[number] = signer
+
+		// Advance the proposer rotation by one slot, regardless of whether the
+		// block was sealed by the in-turn proposer or a backup one.
+		snap.advance()
+
+		// Checkpoint blocks carry the validator set of the next epoch
+		if number%s.config.Epoch == 0 {
+			validators, err := parseValidators(header)
+			if err != nil {
+				return nil, err
+			}
+			snap.reset(validators)
+		}
+		// If we're taking too much time (ecrecover), notify the user once a while
+		if time.Since(logged) > 8*time.Second {
+			log.Info("Reconstructing validator history", "processed", i, "total", len(headers), "elapsed", common.PrettyDuration(time.Since(start)))
+			logged = time.Now()
+		}
+	}
+	if time.Since(start) > 8*time.Second {
+		log.Info("Reconstructed validator history", "processed", len(headers), "elapsed", common.PrettyDuration(time.Since(start)))
+	}
+	snap.Number += uint64(len(headers))
+	snap.Hash = headers[len(headers)-1].Hash()
+
+	return snap, nil
+}
+
+// validators retrieves the list of active validators in ascending order.
+func (s *Snapshot) validators() []Validator {
+	vals := make([]Validator, 0, len(s.Validators))
+	for addr, power := range s.Validators {
+		vals = append(vals, Validator{Address: addr, Power: power})
+	}
+	slices.SortFunc(vals, func(a, b Validator) int {
+		return a.Address.Cmp(b.Address)
+	})
+	return vals
+}
+
+// totalPower returns the sum of the voting power of all active validators.
+func (s *Snapshot) totalPower() int64 {
+	var total int64
+	for _, power := range s.Validators {
+		total += int64(power)
+	}
+	return total
+}
+
+// selectProposer runs a single round of the stake weighted proposer rotation on
+// the given priorities, returning the chosen proposer. Every validator gains its
+// voting power in priority, the one with the highest priority is elected and is
+// charged the total voting power. Over time, each validator proposes a share of
+// the blocks proportional to its stake. Ties are broken by the lower address.
+func (s *Snapshot) selectProposer(priorities map[common.Address]int64) common.Address {
+	var (
+		proposer common.Address
+		best     int64
+		found    bool
+	)
+	for _, v := range s.validators() {
+		priorities[v.Address] += int64(v.Power)
+		if !found || priorities[v.Address] > best {
+			proposer, best, found = v.Address, priorities[v.Address], true
+		}
+	}
+	priorities[proposer] -= s.totalPower()
+	return proposer
+}
+
+// advance moves the proposer rotation forward by one block.
+func (s *Snapshot) advance() {
+	s.selectProposer(s.Priorities)
+}
+
+// proposer returns the in-turn proposer of the block following the snapshot.
+func (s *Snapshot) proposer() common.Address {
+	return s.selectProposer(maps.Clone(s.Priorities))
+}
+
+// inturn returns if a validator is the in-turn proposer of the block following
+// the snapshot.
+func (s *Snapshot) inturn(validator common.Address) bool {
+	return s.proposer() == validator
+}
+
+// recentLimit returns the number of consecutive blocks a validator may seal at
+// most one of out-of-turn. Requiring a majority of the validators by count keeps
+// a minority from building a chain of its own from backup slots.
+func (s *Snapshot) recentLimit() uint64 {
+	return uint64(len(s.Validators)/2 + 1)
+}
+
+// expireRecents removes the proposers that fell out of the recent window as of
+// the block with the given number.
+func (s *Snapshot) expireRecents(number uint64) {
+	limit := s.recentLimit()
+	for seen := range s.Recents {
+		if seen+limit <= number {
+			delete(s.Recents, seen)
+		}
+	}
+}
+
+// recentlySigned returns whether a validator sealed one of the blocks of the
+// recent window preceding the block with the given number, barring it from
+// stepping in as a backup proposer of that block.
+func (s *Snapshot) recentlySigned(validator common.Address, number uint64) bool {
+	limit := s.recentLimit()
+	for seen, recent := range s.Recents {
+		if recent == validator && seen+limit > number {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,209 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/lru"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// Tests that the proposer rotation elects validators proportionally to their
+// voting power, spreading the turns of heavy validators across the cycle.
+func TestProposerRotation(t *testing.T) {
+	tests := []struct {
+		powers []uint64
+		order  []int // Expected proposer indices of one full rotation
+	}{
+		{powers: []uint64{1}, order: []int{0}},
+		{powers: []uint64{1, 1, 1}, order: []int{0, 1, 2}},
+		{powers: []uint64{1, 2}, order: []int{1, 0, 1}},
+		{powers: []uint64{3, 1, 1}, order: []int{0, 1, 0, 2, 0}},
+		{powers: []uint64{1, 2, 5}, order: []int{2, 1, 2, 0, 2, 2, 1, 2}},
+	}
+	for i, tt := range tests {
+		var (
+			validators = make([]Validator, len(tt.powers))
+			total      uint64
+		)
+		for j, power := range tt.powers {
+			validators[j] = Validator{Address: common.Address{byte(j + 1)}, Power: power}
+			total += power
+		}
+		snap := newSnapshot(&params.PixelzxConfig{Epoch: 100}, nil, 0, common.Hash{}, validators)
+
+		// Run the rotation for a few cycles, it must repeat itself
+		for cycle := 0; cycle < 3; cycle++ {
+			for j, want := range tt.order {
+				if have := snap.proposer(); have != validators[want].Address {
+					t.Fatalf("test %d, cycle %d, slot %d: proposer mismatch: have %x, want %x", i, cycle, j, have, validators[want].Address)
+				}
+				if !snap.inturn(validators[want].Address) {
+					t.Fatalf("test %d, cycle %d, slot %d: proposer not in-turn", i, cycle, j)
+				}
+				snap.advance()
+			}
+		}
+		if len(tt.order) != int(total) {
+			t.Fatalf("test %d: rotation length mismatch: have %d, want %d", i, len(tt.order), total)
+		}
+	}
+}
+
+// Tests that snapshots survive a round trip through the database.
+func TestSnapshotStoreLoad(t *testing.T) {
+	var (
+		db       = rawdb.NewMemoryDatabase()
+		config   = &params.PixelzxConfig{Period: 3, Epoch: 100}
+		sigcache = lru.NewCache[common.Hash, common.Address](inmemorySignatures)
+	)
+	snap := newSnapshot(config, sigcache, 1024, common.Hash{0x01}, []Validator{
+		{Address: common.Address{0x01}, Power: 10},
+		{Address: common.Address{0x02}, Power: 20},
+	})
+	snap.advance()
+
+	if err := snap.store(db); err != nil {
+		t.Fatalf("failed to store snapshot: %v", err)
+	}
+	loaded, err := loadSnapshot(config, sigcache, db, snap.Hash)
+	if err != nil {
+		t.Fatalf("failed to load snapshot: %v", err)
+	}
+	if loaded.Number != snap.Number || loaded.Hash != snap.Hash {
+		t.Errorf("position mismatch: have %d/%x, want %d/%x", loaded.Number, loaded.Hash, snap.Number, snap.Hash)
+	}
+	if !reflect.DeepEqual(loaded.Validators, snap.Validators) {
+		t.Errorf("validators mismatch: have %v, want %v", loaded.Validators, snap.Validators)
+	}
+	if !reflect.DeepEqual(loaded.Priorities, snap.Priorities) {
+		t.Errorf("priorities mismatch: have %v, want %v", loaded.Priorities, snap.Priorities)
+	}
+	if loaded.proposer() != snap.proposer() {
+		t.Errorf("proposer mismatch: have %x, want %x", loaded.proposer(), snap.proposer())
+	}
+}
+
+// checkpointChain is a header chain starting at a checkpoint block, mimicking
+// the view of a light or snap synced node which didn't download older headers.
+type checkpointChain struct {
+	config  *params.ChainConfig
+	headers map[uint64]*types.Header
+	head    *types.Header
+}
+
+func (c *checkpointChain) Config() *params.ChainConfig  { return c.config }
+func (c *checkpointChain) CurrentHeader() *types.Header { return c.head }
+
+func (c *checkpointChain) GetHeader(hash common.Hash, number uint64) *types.Header {
+	if header := c.headers[number]; header != nil && header.Hash() == hash {
+		return header
+	}
+	return nil
+}
+
+func (c *checkpointChain) GetHeaderByNumber(number uint64) *types.Header {
+	return c.headers[number]
+}
+
+func (c *checkpointChain) GetHeaderByHash(hash common.Hash) *types.Header {
+	for _, header := range c.headers {
+		if header.Hash() == hash {
+			return header
+		}
+	}
+	return nil
+}
+
+// Tests that headers can be verified starting from a checkpoint header alone, and
+// that the epoch snapshots are persisted, so a restarted node resumes from them.
+func TestCheckpointVerification(t *testing.T) {
+	b := newTestBackend(t, 1, 2, 3)
+
+	parent := b.chain.Genesis()
+	for i := 0; i < 20; i++ {
+		block := b.makeBlock(parent, b.proposer(parent), 0, nil)
+		if _, err := b.chain.InsertChain(types.Blocks{block}); err != nil {
+			t.Fatalf("block %d: failed to insert: %v", block.NumberU64(), err)
+		}
+		parent = block
+	}
+	// Verify the headers after the first checkpoint on a chain lacking the history
+	var (
+		db     = rawdb.NewMemoryDatabase()
+		engine = New(b.genesis.Config.Pixelzx, db)
+		chain  = &checkpointChain{config: b.genesis.Config, headers: make(map[uint64]*types.Header)}
+		epoch  = b.genesis.Config.Pixelzx.Epoch
+	)
+	for n := epoch; n <= parent.NumberU64(); n++ {
+		chain.headers[n] = b.chain.GetHeaderByNumber(n)
+	}
+	chain.head = chain.headers[parent.NumberU64()]
+
+	for n := epoch + 1; n <= parent.NumberU64(); n++ {
+		if err := engine.VerifyHeader(chain, chain.headers[n]); err != nil {
+			t.Fatalf("block %d: failed to verify from checkpoint: %v", n, err)
+		}
+	}
+	// A header sealed by a validator not in the checkpoint set must be rejected
+	forged := types.CopyHeader(chain.head)
+	forged.Number = new(big.Int).Add(forged.Number, common.Big1)
+	forged.ParentHash = chain.head.Hash()
+	forged.Time += 10
+	forged.BaseFee = eip1559.CalcBaseFee(chain.config, chain.head)
+	forged.Extra = make([]byte, extraVanity+extraSeal)
+
+	key, _ := crypto.GenerateKey()
+	forged.Coinbase = crypto.PubkeyToAddress(key.PublicKey)
+	sig, _ := crypto.Sign(SealHash(forged).Bytes(), key)
+	copy(forged.Extra[extraVanity:], sig)
+
+	if err := engine.VerifyHeader(chain, forged); err != errUnauthorizedValidator {
+		t.Fatalf("forged header error mismatch: have %v, want %v", err, errUnauthorizedValidator)
+	}
+	// The epoch snapshots must have been persisted along the way
+	for _, n := range []uint64{epoch, 2 * epoch} {
+		hash := chain.headers[n].Hash()
+		if _, err := loadSnapshot(engine.config, engine.signatures, db, hash); err != nil {
+			t.Fatalf("block %d: snapshot not persisted: %v", n, err)
+		}
+	}
+	// A restarted engine must resume from the persisted snapshot without needing
+	// the checkpoint header
+	delete(chain.headers, epoch)
+
+	restarted := New(b.genesis.Config.Pixelzx, db)
+	have, err := restarted.snapshot(chain, parent.NumberU64(), parent.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve snapshot after restart: %v", err)
+	}
+	want, err := b.engine.snapshot(b.chain, parent.NumberU64(), parent.Hash(), nil)
+	if err != nil {
+		t.Fatalf("failed to retrieve reference snapshot: %v", err)
+	}
+	if !reflect.DeepEqual(have.Validators, want.Validators) || !reflect.DeepEqual(have.Priorities, want.Priorities) {
+		t.Fatalf("restarted snapshot mismatch: have %v/%v, want %v/%v", have.Validators, have.Priorities, want.Validators, want.Priorities)
+	}
+}
@@ -0,0 +1,469 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package staking
+
+import (
+	"bytes"
+	_ "embed"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+const (
+	maxCommission    = 10_000 // Maximum commission rate, in basis points (100%)
+	maxMonikerLength = 70     // Maximum length of a validator moniker, in bytes
+	maxUnbondings    = 32     // Maximum number of pending unbondings per delegator
+)
+
+// Gas charged for the calls to the contract, beside the usual call costs.
+const (
+	registerGas   = 100_000
+	delegateGas   = 50_000
+	undelegateGas = 50_000
+	withdrawGas   = 50_000
+	commissionGas = 20_000
+	claimGas      = 50_000
+	unjailGas     = 20_000
+	rotateKeyGas  = 50_000
+	viewGas       = 10_000
+
+	// validatorGas is charged by getValidators per validator returned, each
+	// being read from a storage slot of its own.
+	validatorGas = params.ColdSloadCostEIP2929
+)
+
+//go:embed staking.abi
+var abiJSON string
+
+// ABI is the interface of the staking contract.
+var ABI abi.ABI
+
+// revertSelector is the selector of the Error(string) revert reason.
+var revertSelector = crypto.Keccak256([]byte("Error(string)"))[:4]
+
+func init() {
+	parsed, err := abi.JSON(strings.NewReader(abiJSON))
+	if err != nil {
+		panic(err)
+	}
+	ABI = parsed
+
+	vm.RegisterSystemContracts(func(config *params.ChainConfig, rules params.Rules) vm.PrecompiledContracts {
+		if config.Pixelzx == nil {
+			return nil
+		}
+		return vm.PrecompiledContracts{
+			params.PixelzxStakingAddress: &contract{config: config.Pixelzx},
+		}
+	})
+}
+
+// contract is the native implementation of the staking system contract.
+type contract struct {
+	config *params.PixelzxConfig
+}
+
+// Name implements vm.PrecompiledContract.
+func (c *contract) Name() string {
+	return "PIXELZX_STAKING"
+}
+
+// RequiredGas implements vm.PrecompiledContract, charging a flat fee per method.
+func (c *contract) RequiredGas(input []byte) uint64 {
+	if len(input) < 4 {
+		return 0
+	}
+	method, err := ABI.MethodById(input[:4])
+	if err != nil {
+		return 0
+	}
+	switch method.Name {
+	case "registerValidator":
+		return registerGas
+	case "delegate":
+		return delegateGas
+	case "undelegate":
+		return undelegateGas
+	case "withdraw":
+		return withdrawGas
+	case "setCommission":
+		return commissionGas
+	case "claimRewards":
+		return claimGas
+	case "unjail":
+		return unjailGas
+	case "rotateConsensusKey":
+		return rotateKeyGas
+	default:
+		return viewGas
+	}
+}
+
+// RequiredStatefulGas implements vm.StatefulGasContract, charging getValidators
+// per registered validator on top of its flat fee.
+func (c *contract) RequiredStatefulGas(evm *vm.EVM, input []byte) uint64 {
+	if len(input) < 4 || !bytes.Equal(input[:4], ABI.Methods["getValidators"].ID) {
+		return 0
+	}
+	return storage{evm.StateDB}.validatorCount() * validatorGas
+}
+
+// Run implements vm.PrecompiledContract. It is only reached when the contract is
+// invoked via CALLCODE or DELEGATECALL, which is not allowed.
+func (c *contract) Run(input []byte) ([]byte, error) {
+	return nil, vm.ErrSystemContractContext
+}
+
+// RunStateful implements vm.StatefulPrecompiledContract, dispatching the call to
+// the requested method.
+func (c *contract) RunStateful(evm *vm.EVM, caller common.Address, input []byte, value *uint256.Int, readOnly bool) ([]byte, error) {
+	if len(input) < 4 {
+		return revert("missing method selector")
+	}
+	method, err := ABI.MethodById(input[:4])
+	if err != nil {
+		return revert("unknown method")
+	}
+	args, err := method.Inputs.Unpack(input[4:])
+	if err != nil {
+		return revert("invalid arguments")
+	}
+	if readOnly && !method.IsConstant() {
+		return nil, vm.ErrWriteProtection
+	}
+	if !method.IsPayable() && !value.IsZero() {
+		return revert("method is not payable")
+	}
+	call := &call{
+		contract: c,
+		evm:      evm,
+		storage:  storage{evm.StateDB},
+		caller:   caller,
+		value:    value,
+		method:   method,
+	}
+	switch method.Name {
+	case "registerValidator":
+		return call.registerValidator(args[0].(*big.Int), args[1].(string), args[2].([]byte))
+	case "delegate":
+		return call.delegate(args[0].(common.Address))
+	case "undelegate":
+		return call.undelegate(args[0].(common.Address), args[1].(*big.Int))
+	case "withdraw":
+		return call.withdraw()
+	case "setCommission":
+		return call.setCommission(args[0].(*big.Int))
+	case "claimRewards":
+		return call.claimRewards(args[0].(common.Address))
+	case "unjail":
+		return call.unjail()
+	case "rotateConsensusKey":
+		return call.rotateConsensusKey(args[0].([]byte))
+	case "getValidators":
+		return method.Outputs.Pack(call.storage.validators())
+	case "getValidator":
+		return call.getValidator(args[0].(common.Address))
+	case "getDelegation":
+		return method.Outputs.Pack(call.storage.delegation(args[0].(common.Address), args[1].(common.Address)).ToBig())
+	case "getUnbonding":
+		return call.getUnbonding(args[0].(common.Address))
+	case "pendingRewards":
+		return method.Outputs.Pack(call.storage.pendingRewards(args[0].(common.Address), args[1].(common.Address)).ToBig())
+	case "getJailStatus":
+		return call.getJailStatus(args[0].(common.Address))
+	}
+	return revert("unknown method")
+}
+
+// call is the execution context of a single call to the contract.
+type call struct {
+	contract *contract
+	evm      *vm.EVM
+	storage  storage
+	caller   common.Address
+	value    *uint256.Int
+	method   *abi.Method
+}
+
+func (c *call) registerValidator(commission *big.Int, moniker string, key []byte) ([]byte, error) {
+	// Validators registering without a consensus key seal with their own address
+	signer := c.caller
+	if len(key) > 0 {
+		var ok bool
+		if signer, ok = consensusKeyAddress(key); !ok {
+			return revert("invalid consensus key")
+		}
+	}
+	switch {
+	case c.storage.registered(c.caller):
+		return revert("validator already registered")
+	case c.storage.signerValidator(c.caller) != (common.Address{}):
+		return revert("address registered as a consensus key")
+	case !c.signerAvailable(signer):
+		return revert("consensus key already in use")
+	case commission.Cmp(big.NewInt(maxCommission)) > 0:
+		return revert("commission too high")
+	case len(moniker) > maxMonikerLength:
+		return revert("moniker too long")
+	case c.contract.config.MinValidatorStake != nil && c.value.ToBig().Cmp(c.contract.config.MinValidatorStake) < 0:
+		return revert("stake below minimum")
+	}
+	c.storage.setStatus(c.caller, statusRegistered)
+	c.storage.setCommission(c.caller, commission.Uint64())
+	c.storage.setMoniker(c.caller, moniker)
+	if len(key) > 0 {
+		c.storage.setConsensusKey(c.caller, key)
+		c.storage.setSigner(c.caller, signer)
+	}
+	c.storage.addValidator(c.caller)
+	c.storage.setDelegation(c.caller, c.caller, c.value)
+	c.storage.setTotalStake(c.caller, c.value)
+
+	c.emit("ValidatorRegistered", []common.Address{c.caller}, c.value.ToBig(), commission, moniker)
+	return nil, nil
+}
+
+// signerAvailable reports whether the caller may seal blocks with the given
+// address: it must be neither another validator's own address nor a consensus
+// key ever registered by another validator.
+func (c *call) signerAvailable(signer common.Address) bool {
+	if validator := c.storage.signerValidator(signer); validator != (common.Address{}) {
+		return validator == c.caller
+	}
+	return signer == c.caller || !c.storage.registered(signer)
+}
+
+// consensusKeyAddress validates a consensus key as an uncompressed secp256k1
+// public key, returning the address the blocks sealed with it recover to.
+func consensusKeyAddress(key []byte) (common.Address, bool) {
+	pubkey, err := crypto.UnmarshalPubkey(key)
+	if err != nil {
+		return common.Address{}, false
+	}
+	return crypto.PubkeyToAddress(*pubkey), true
+}
+
+func (c *call) delegate(validator common.Address) ([]byte, error) {
+	if c.value.IsZero() {
+		return revert("zero delegation")
+	}
+	if !c.storage.registered(validator) {
+		return revert("validator not registered")
+	}
+	c.storage.settleRewards(c.caller, validator)
+
+	delegation := c.storage.delegation(c.caller, validator)
+	c.storage.setDelegation(c.caller, validator, delegation.Add(delegation, c.value))
+	c.storage.resetRewardDebt(c.caller, validator)
+
+	stake := c.storage.totalStake(validator)
+	c.storage.setTotalStake(validator, stake.Add(stake, c.value))
+
+	c.emit("Delegated", []common.Address{c.caller, validator}, c.value.ToBig())
+	return nil, nil
+}
+
+func (c *call) undelegate(validator common.Address, amount *big.Int) ([]byte, error) {
+	delegation := c.storage.delegation(c.caller, validator)
+	switch {
+	case amount.Sign() == 0:
+		return revert("zero amount")
+	case amount.Cmp(delegation.ToBig()) > 0:
+		return revert("amount exceeds delegation")
+	}
+	unbondings := c.storage.unbondings(c.caller)
+	if len(unbondings) >= maxUnbondings {
+		return revert("too many pending unbondings")
+	}
+	c.storage.settleRewards(c.caller, validator)
+
+	value := uint256.MustFromBig(amount)
+	c.storage.setDelegation(c.caller, validator, delegation.Sub(delegation, value))
+	c.storage.resetRewardDebt(c.caller, validator)
+
+	stake := c.storage.totalStake(validator)
+	c.storage.setTotalStake(validator, stake.Sub(stake, value))
+
+	number := c.evm.Context.BlockNumber.Uint64()
+	completion := number + c.contract.config.UnbondingPeriod
+	c.storage.setUnbondings(c.caller, append(unbondings, Unbonding{
+		Validator:  validator,
+		Amount:     amount,
+		Completion: completion,
+		Creation:   number,
+	}))
+	c.storage.enqueueUnbonding(c.caller, releaseNumber(c.contract.config.Epoch, completion))
+	c.emit("Undelegated", []common.Address{c.caller, validator}, amount, new(big.Int).SetUint64(completion))
+	return nil, nil
+}
+
+func (c *call) withdraw() ([]byte, error) {
+	var (
+		number  = c.evm.Context.BlockNumber.Uint64()
+		amount  = new(big.Int)
+		pending []Unbonding
+	)
+	for _, entry := range c.storage.unbondings(c.caller) {
+		if entry.Completion <= number {
+			amount.Add(amount, entry.Amount)
+		} else {
+			pending = append(pending, entry)
+		}
+	}
+	if amount.Sign() == 0 {
+		return revert("no matured unbondings")
+	}
+	c.storage.setUnbondings(c.caller, pending)
+	c.evm.Context.Transfer(c.evm.StateDB, params.PixelzxStakingAddress, c.caller, uint256.MustFromBig(amount))
+
+	c.emit("Withdrawn", []common.Address{c.caller}, amount)
+	return c.method.Outputs.Pack(amount)
+}
+
+func (c *call) setCommission(commission *big.Int) ([]byte, error) {
+	switch {
+	case !c.storage.registered(c.caller):
+		return revert("validator not registered")
+	case commission.Cmp(big.NewInt(maxCommission)) > 0:
+		return revert("commission too high")
+	}
+	c.storage.setCommission(c.caller, commission.Uint64())
+
+	c.emit("CommissionUpdated", []common.Address{c.caller}, commission)
+	return nil, nil
+}
+
+func (c *call) claimRewards(validator common.Address) ([]byte, error) {
+	c.storage.settleRewards(c.caller, validator)
+	c.storage.resetRewardDebt(c.caller, validator)
+
+	amount := c.storage.rewards(c.caller, validator)
+	if amount.IsZero() {
+		return revert("no rewards to claim")
+	}
+	c.storage.setRewards(c.caller, validator, new(uint256.Int))
+	c.evm.Context.Transfer(c.evm.StateDB, params.PixelzxStakingAddress, c.caller, amount)
+
+	c.emit("RewardsClaimed", []common.Address{c.caller, validator}, amount.ToBig())
+	return c.method.Outputs.Pack(amount.ToBig())
+}
+
+func (c *call) unjail() ([]byte, error) {
+	switch {
+	case !c.storage.registered(c.caller):
+		return revert("validator not registered")
+	case c.storage.jailReason(c.caller) == jailNone:
+		return revert("validator not jailed")
+	case c.storage.jailReason(c.caller) == jailDoubleSign:
+		return revert("validator tombstoned for double signing")
+	case c.contract.config.MinValidatorStake != nil && c.storage.delegation(c.caller, c.caller).ToBig().Cmp(c.contract.config.MinValidatorStake) < 0:
+		return revert("stake below minimum")
+	}
+	c.storage.setJailReason(c.caller, jailNone)
+
+	c.emit("Unjailed", []common.Address{c.caller})
+	return nil, nil
+}
+
+// rotateConsensusKey replaces the consensus key of the calling validator. The
+// validator seals with the new key from the next epoch on, once it is elected
+// under its address; the old key keeps being attributed to the validator.
+func (c *call) rotateConsensusKey(key []byte) ([]byte, error) {
+	signer, ok := consensusKeyAddress(key)
+	switch {
+	case !c.storage.registered(c.caller):
+		return revert("validator not registered")
+	case !ok:
+		return revert("invalid consensus key")
+	case !c.signerAvailable(signer):
+		return revert("consensus key already in use")
+	}
+	c.storage.setConsensusKey(c.caller, key)
+	c.storage.setSigner(c.caller, signer)
+
+	c.emit("ConsensusKeyRotated", []common.Address{c.caller}, signer)
+	return nil, nil
+}
+
+func (c *call) getValidator(validator common.Address) ([]byte, error) {
+	info := Validator(c.storage.db, validator)
+	if info == nil {
+		return revert("validator not registered")
+	}
+	return c.method.Outputs.Pack(info.SelfStake, info.TotalStake, new(big.Int).SetUint64(info.Commission), info.Moniker, info.ConsensusKey)
+}
+
+func (c *call) getJailStatus(validator common.Address) ([]byte, error) {
+	info := Validator(c.storage.db, validator)
+	if info == nil {
+		return revert("validator not registered")
+	}
+	return c.method.Outputs.Pack(info.Jailed, info.Tombstoned, new(big.Int).SetUint64(info.MissedSlots))
+}
+
+func (c *call) getUnbonding(delegator common.Address) ([]byte, error) {
+	var (
+		unbondings  = c.storage.unbondings(delegator)
+		validators  = make([]common.Address, len(unbondings))
+		amounts     = make([]*big.Int, len(unbondings))
+		completions = make([]*big.Int, len(unbondings))
+	)
+	for i, entry := range unbondings {
+		validators[i] = entry.Validator
+		amounts[i] = entry.Amount
+		completions[i] = new(big.Int).SetUint64(entry.Completion)
+	}
+	return c.method.Outputs.Pack(validators, amounts, completions)
+}
+
+// emit adds a contract event to the state, with the given addresses as indexed
+// topics and the remaining values as the log data.
+func (c *call) emit(name string, indexed []common.Address, values ...any) {
+	event := ABI.Events[name]
+	topics := []common.Hash{event.ID}
+	for _, addr := range indexed {
+		topics = append(topics, common.BytesToHash(addr.Bytes()))
+	}
+	data, err := event.Inputs.NonIndexed().Pack(values...)
+	if err != nil {
+		panic(err) // Only happens on a programming error
+	}
+	c.evm.StateDB.AddLog(&types.Log{
+		Address:     params.PixelzxStakingAddress,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: c.evm.Context.BlockNumber.Uint64(),
+	})
+}
+
+// revert aborts the call with an Error(string) revert reason.
+func revert(reason string) ([]byte, error) {
+	str, _ := abi.NewType("string", "", nil)
+	data, err := abi.Arguments{{Type: str}}.Pack(reason)
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]byte{}, revertSelector...), data...), vm.ErrExecutionReverted
+}
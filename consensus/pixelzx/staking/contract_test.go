@@ -0,0 +1,559 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package staking
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/state"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// pzx converts an amount of whole PZX into wei.
+func pzx(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(params.PZX))
+}
+
+// testEnv is a minimal execution environment to call the staking contract in.
+type testEnv struct {
+	t      *testing.T
+	config *params.ChainConfig
+	state  *state.StateDB
+	number uint64
+}
+
+func newTestEnv(t *testing.T, validators []GenesisValidator, funded ...common.Address) *testEnv {
+	config := *params.AllPixelzxProtocolChanges
+	config.Pixelzx = &params.PixelzxConfig{Period: 3, Epoch: 200, MinValidatorStake: pzx(10), UnbondingPeriod: 100}
+
+	statedb, _ := state.New(types.EmptyRootHash, state.NewDatabaseForTesting())
+	account := GenesisAccount(validators)
+	statedb.SetCode(params.PixelzxStakingAddress, account.Code)
+	statedb.SetNonce(params.PixelzxStakingAddress, account.Nonce, tracing.NonceChangeGenesis)
+	statedb.SetBalance(params.PixelzxStakingAddress, uint256.MustFromBig(account.Balance), tracing.BalanceIncreaseGenesisBalance)
+	for key, value := range account.Storage {
+		statedb.SetState(params.PixelzxStakingAddress, key, value)
+	}
+	for _, addr := range funded {
+		statedb.SetBalance(addr, uint256.MustFromBig(pzx(1000)), tracing.BalanceIncreaseGenesisBalance)
+	}
+	return &testEnv{t: t, config: &config, state: statedb, number: 1}
+}
+
+func (env *testEnv) evm() *vm.EVM {
+	ctx := vm.BlockContext{
+		CanTransfer: func(db vm.StateDB, addr common.Address, amount *uint256.Int) bool {
+			return db.GetBalance(addr).Cmp(amount) >= 0
+		},
+		Transfer: func(db vm.StateDB, sender, recipient common.Address, amount *uint256.Int) {
+			db.SubBalance(sender, amount, tracing.BalanceChangeTransfer)
+			db.AddBalance(recipient, amount, tracing.BalanceChangeTransfer)
+		},
+		GetHash:     func(uint64) common.Hash { return common.Hash{} },
+		BlockNumber: new(big.Int).SetUint64(env.number),
+		Time:        env.number * 3,
+		Difficulty:  new(big.Int),
+		BaseFee:     new(big.Int),
+		Random:      &common.Hash{},
+	}
+	return vm.NewEVM(ctx, env.state, env.config, vm.Config{})
+}
+
+// call invokes a contract method, returning the unpacked results or the revert
+// reason as an error.
+func (env *testEnv) call(from common.Address, value *big.Int, method string, args ...any) ([]any, error) {
+	input, err := ABI.Pack(method, args...)
+	if err != nil {
+		env.t.Fatalf("failed to pack %s call: %v", method, err)
+	}
+	ret, _, err := env.evm().Call(from, params.PixelzxStakingAddress, input, 1_000_000, uint256.MustFromBig(value))
+	if errors.Is(err, vm.ErrExecutionReverted) {
+		reason, _ := abi.UnpackRevert(ret)
+		return nil, errors.New(reason)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ABI.Unpack(method, ret)
+}
+
+// mustCall invokes a contract method, failing the test if it reverts.
+func (env *testEnv) mustCall(from common.Address, value *big.Int, method string, args ...any) []any {
+	out, err := env.call(from, value, method, args...)
+	if err != nil {
+		env.t.Fatalf("%s call failed: %v", method, err)
+	}
+	return out
+}
+
+// Tests that the genesis allocation registers the validators with their stakes.
+func TestGenesisAccount(t *testing.T) {
+	validators := []GenesisValidator{
+		{Address: common.Address{0x01}, Stake: pzx(10), Commission: 500, Moniker: "first validator with a moniker longer than a slot"},
+		{Address: common.Address{0x02}, Stake: pzx(20)},
+	}
+	account := GenesisAccount(validators)
+	if account.Balance.Cmp(pzx(30)) != 0 {
+		t.Fatalf("balance mismatch: have %v, want %v", account.Balance, pzx(30))
+	}
+	db := genesisStorage(account.Storage)
+	if have := Validators(db); len(have) != 2 || have[0] != validators[0].Address || have[1] != validators[1].Address {
+		t.Fatalf("validator list mismatch: have %v", have)
+	}
+	for _, v := range validators {
+		info := Validator(db, v.Address)
+		if info == nil {
+			t.Fatalf("validator %x not registered", v.Address)
+		}
+		if info.SelfStake.Cmp(v.Stake) != 0 || info.TotalStake.Cmp(v.Stake) != 0 {
+			t.Errorf("validator %x: stake mismatch: have %v/%v, want %v", v.Address, info.SelfStake, info.TotalStake, v.Stake)
+		}
+		if info.Commission != v.Commission || info.Moniker != v.Moniker {
+			t.Errorf("validator %x: metadata mismatch: have %d/%q, want %d/%q", v.Address, info.Commission, info.Moniker, v.Commission, v.Moniker)
+		}
+	}
+	if info := Validator(db, common.Address{0x03}); info != nil {
+		t.Fatalf("unknown validator reported as registered")
+	}
+}
+
+// Tests the full lifecycle of a stake: registering a validator, delegating to
+// it, unbonding and withdrawing after the unbonding period.
+func TestStakingLifecycle(t *testing.T) {
+	var (
+		validator = common.Address{0xaa}
+		delegator = common.Address{0xbb}
+		env       = newTestEnv(t, nil, validator, delegator)
+		key       = testConsensusKey(t)
+	)
+	// Registration requires the minimum stake and a sane commission
+	if _, err := env.call(validator, pzx(5), "registerValidator", big.NewInt(1000), "val", key); err == nil || err.Error() != "stake below minimum" {
+		t.Fatalf("registration error mismatch: have %v, want stake below minimum", err)
+	}
+	if _, err := env.call(validator, pzx(10), "registerValidator", big.NewInt(10001), "val", key); err == nil || err.Error() != "commission too high" {
+		t.Fatalf("registration error mismatch: have %v, want commission too high", err)
+	}
+	env.mustCall(validator, pzx(10), "registerValidator", big.NewInt(1000), "val", key)
+	if _, err := env.call(validator, pzx(10), "registerValidator", big.NewInt(1000), "val", key); err == nil {
+		t.Fatalf("double registration accepted")
+	}
+	// Delegations add up to the total stake of the validator
+	if _, err := env.call(delegator, pzx(5), "delegate", common.Address{0xcc}); err == nil {
+		t.Fatalf("delegation to unknown validator accepted")
+	}
+	env.mustCall(delegator, pzx(5), "delegate", validator)
+	env.mustCall(validator, new(big.Int), "setCommission", big.NewInt(2000))
+
+	out := env.mustCall(delegator, new(big.Int), "getValidator", validator)
+	if out[0].(*big.Int).Cmp(pzx(10)) != 0 || out[1].(*big.Int).Cmp(pzx(15)) != 0 || out[2].(*big.Int).Uint64() != 2000 {
+		t.Fatalf("validator record mismatch: have %v", out)
+	}
+	if !bytes.Equal(out[4].([]byte), key) {
+		t.Fatalf("consensus key mismatch: have %x", out[4])
+	}
+	if bal := env.state.GetBalance(params.PixelzxStakingAddress); bal.ToBig().Cmp(pzx(15)) != 0 {
+		t.Fatalf("contract balance mismatch: have %v, want %v", bal, pzx(15))
+	}
+	// Unbonding releases the stake only after the unbonding period
+	if _, err := env.call(delegator, new(big.Int), "undelegate", validator, pzx(6)); err == nil {
+		t.Fatalf("undelegation above the delegated amount accepted")
+	}
+	env.mustCall(delegator, new(big.Int), "undelegate", validator, pzx(5))
+	if stake := Validator(env.state, validator).TotalStake; stake.Cmp(pzx(10)) != 0 {
+		t.Fatalf("total stake mismatch after undelegation: have %v, want %v", stake, pzx(10))
+	}
+	out = env.mustCall(delegator, new(big.Int), "getUnbonding", delegator)
+	if completions := out[2].([]*big.Int); len(completions) != 1 || completions[0].Uint64() != 101 {
+		t.Fatalf("unbonding completion mismatch: have %v, want [101]", completions)
+	}
+	env.number = 100
+	if _, err := env.call(delegator, new(big.Int), "withdraw"); err == nil || err.Error() != "no matured unbondings" {
+		t.Fatalf("early withdrawal error mismatch: have %v", err)
+	}
+	env.number = 101
+	out = env.mustCall(delegator, new(big.Int), "withdraw")
+	if amount := out[0].(*big.Int); amount.Cmp(pzx(5)) != 0 {
+		t.Fatalf("withdrawn amount mismatch: have %v, want %v", amount, pzx(5))
+	}
+	if bal := env.state.GetBalance(delegator); bal.ToBig().Cmp(pzx(1000)) != 0 {
+		t.Fatalf("delegator balance mismatch: have %v, want %v", bal, pzx(1000))
+	}
+	if len(Unbondings(env.state, delegator)) != 0 {
+		t.Fatalf("withdrawn unbonding not removed")
+	}
+	if logs := env.state.Logs(); len(logs) != 5 {
+		t.Fatalf("event count mismatch: have %d, want 5", len(logs))
+	}
+}
+
+// testConsensusKey generates a consensus key, returning its public key as
+// registered with the contract.
+func testConsensusKey(t *testing.T) []byte {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		t.Fatalf("failed to generate consensus key: %v", err)
+	}
+	return crypto.FromECDSAPub(&key.PublicKey)
+}
+
+// Tests that consensus keys must be valid public keys, that each may only be
+// registered by a single validator and that they map back to it.
+func TestConsensusKeyRegistration(t *testing.T) {
+	var (
+		genesis = testConsensusKey(t)
+		first   = common.Address{0xaa}
+		second  = common.Address{0xbb}
+		key     = testConsensusKey(t)
+		signer  = crypto.PubkeyToAddress(*mustUnmarshalPubkey(t, key))
+		env     = newTestEnv(t, []GenesisValidator{{Address: crypto.PubkeyToAddress(*mustUnmarshalPubkey(t, genesis)), Stake: pzx(10)}}, first, second, signer)
+	)
+	for _, invalid := range [][]byte{{0x01}, key[:33], append(slices.Clone(key), 0x00), append([]byte{0x04}, make([]byte, 64)...)} {
+		if _, err := env.call(first, pzx(10), "registerValidator", big.NewInt(0), "val", invalid); err == nil || err.Error() != "invalid consensus key" {
+			t.Fatalf("key %x: registration error mismatch: have %v, want invalid consensus key", invalid, err)
+		}
+	}
+	// Keys of other validators can't be registered, neither as their own address
+	if _, err := env.call(first, pzx(10), "registerValidator", big.NewInt(0), "val", genesis); err == nil || err.Error() != "consensus key already in use" {
+		t.Fatalf("registration error mismatch: have %v, want consensus key already in use", err)
+	}
+	env.mustCall(first, pzx(10), "registerValidator", big.NewInt(0), "val", key)
+	if _, err := env.call(second, pzx(10), "registerValidator", big.NewInt(0), "val", key); err == nil || err.Error() != "consensus key already in use" {
+		t.Fatalf("registration error mismatch: have %v, want consensus key already in use", err)
+	}
+	if _, err := env.call(signer, pzx(10), "registerValidator", big.NewInt(0), "val", []byte{}); err == nil || err.Error() != "address registered as a consensus key" {
+		t.Fatalf("registration error mismatch: have %v, want address registered as a consensus key", err)
+	}
+	// Blocks sealed by the key are attributed to the validator
+	if have := Signer(env.state, first); have != signer {
+		t.Fatalf("signer mismatch: have %x, want %x", have, signer)
+	}
+	if have := ValidatorOf(env.state, signer); have != first {
+		t.Fatalf("validator of signer mismatch: have %x, want %x", have, first)
+	}
+	env.mustCall(second, pzx(10), "registerValidator", big.NewInt(0), "val", []byte{})
+	if have := Signer(env.state, second); have != second {
+		t.Fatalf("signer without consensus key mismatch: have %x, want %x", have, second)
+	}
+	if have := ValidatorOf(env.state, second); have != second {
+		t.Fatalf("validator of own address mismatch: have %x, want %x", have, second)
+	}
+}
+
+// Tests that validators replace their consensus key, and that rotated out keys
+// keep mapping to their validator without being registrable by anyone else.
+func TestConsensusKeyRotation(t *testing.T) {
+	var (
+		first  = common.Address{0xaa}
+		second = common.Address{0xbb}
+		oldKey = testConsensusKey(t)
+		newKey = testConsensusKey(t)
+		env    = newTestEnv(t, nil, first, second)
+	)
+	if _, err := env.call(first, new(big.Int), "rotateConsensusKey", newKey); err == nil || err.Error() != "validator not registered" {
+		t.Fatalf("rotation error mismatch: have %v, want validator not registered", err)
+	}
+	env.mustCall(first, pzx(10), "registerValidator", big.NewInt(0), "first", oldKey)
+	env.mustCall(second, pzx(10), "registerValidator", big.NewInt(0), "second", []byte{})
+
+	if _, err := env.call(first, new(big.Int), "rotateConsensusKey", newKey[1:]); err == nil || err.Error() != "invalid consensus key" {
+		t.Fatalf("rotation error mismatch: have %v, want invalid consensus key", err)
+	}
+	env.mustCall(first, new(big.Int), "rotateConsensusKey", newKey)
+
+	var (
+		oldSigner = crypto.PubkeyToAddress(*mustUnmarshalPubkey(t, oldKey))
+		newSigner = crypto.PubkeyToAddress(*mustUnmarshalPubkey(t, newKey))
+	)
+	if have := Validator(env.state, first); !bytes.Equal(have.ConsensusKey, newKey) || have.Signer != newSigner {
+		t.Fatalf("rotated key mismatch: have %x/%x, want %x/%x", have.ConsensusKey, have.Signer, newKey, newSigner)
+	}
+	for _, signer := range []common.Address{oldSigner, newSigner} {
+		if have := ValidatorOf(env.state, signer); have != first {
+			t.Fatalf("validator of %x mismatch: have %x, want %x", signer, have, first)
+		}
+	}
+	// Neither key may be taken over, but the validator may rotate back
+	for _, key := range [][]byte{oldKey, newKey} {
+		if _, err := env.call(second, new(big.Int), "rotateConsensusKey", key); err == nil || err.Error() != "consensus key already in use" {
+			t.Fatalf("rotation error mismatch: have %v, want consensus key already in use", err)
+		}
+	}
+	env.mustCall(first, new(big.Int), "rotateConsensusKey", oldKey)
+	if have := Signer(env.state, first); have != oldSigner {
+		t.Fatalf("signer mismatch after rotating back: have %x, want %x", have, oldSigner)
+	}
+	if logs := env.state.Logs(); len(logs) != 4 || logs[3].Topics[0] != ABI.Events["ConsensusKeyRotated"].ID {
+		t.Fatalf("rotation events mismatch: have %d logs", len(logs))
+	}
+}
+
+// mustUnmarshalPubkey decodes a public key, failing the test if it is invalid.
+func mustUnmarshalPubkey(t *testing.T, key []byte) *ecdsa.PublicKey {
+	pubkey, err := crypto.UnmarshalPubkey(key)
+	if err != nil {
+		t.Fatalf("invalid public key: %v", err)
+	}
+	return pubkey
+}
+
+// Tests that validators register with any stake on networks configured without a
+// minimum validator stake.
+func TestRegisterWithoutMinimum(t *testing.T) {
+	validator := common.Address{0xaa}
+	env := newTestEnv(t, nil, validator)
+	env.config.Pixelzx.MinValidatorStake = nil
+
+	env.mustCall(validator, pzx(1), "registerValidator", big.NewInt(1000), "val", []byte{})
+	if info := Validator(env.state, validator); info == nil || info.SelfStake.Cmp(pzx(1)) != 0 {
+		t.Fatalf("validator not registered with its stake: %v", info)
+	}
+}
+
+// Tests that state modifying methods are rejected in a static context, that the
+// validator list is charged per validator and that the contract can't be invoked
+// via delegate call.
+func TestStakingCallContext(t *testing.T) {
+	var (
+		validator = common.Address{0xaa}
+		env       = newTestEnv(t, []GenesisValidator{{Address: validator, Stake: pzx(10)}}, validator)
+	)
+	input, _ := ABI.Pack("setCommission", big.NewInt(100))
+	if _, _, err := env.evm().StaticCall(validator, params.PixelzxStakingAddress, input, 1_000_000); !errors.Is(err, vm.ErrWriteProtection) {
+		t.Fatalf("static call error mismatch: have %v, want %v", err, vm.ErrWriteProtection)
+	}
+	input, _ = ABI.Pack("getValidators")
+	ret, left, err := env.evm().StaticCall(validator, params.PixelzxStakingAddress, input, 1_000_000)
+	if err != nil {
+		t.Fatalf("static view call failed: %v", err)
+	}
+	if used, want := 1_000_000-left, uint64(viewGas+validatorGas); used != want {
+		t.Fatalf("gas used mismatch: have %d, want %d", used, want)
+	}
+	out, _ := ABI.Unpack("getValidators", ret)
+	if list := out[0].([]common.Address); len(list) != 1 || list[0] != validator {
+		t.Fatalf("validator list mismatch: have %v", list)
+	}
+	if _, _, err := env.evm().DelegateCall(validator, validator, params.PixelzxStakingAddress, input, 1_000_000, new(uint256.Int)); !errors.Is(err, vm.ErrSystemContractContext) {
+		t.Fatalf("delegate call error mismatch: have %v, want %v", err, vm.ErrSystemContractContext)
+	}
+}
+
+// Tests that double signing slashes a share of the self stake only, jails the
+// validator and is punished once per height.
+func TestSlashDoubleSign(t *testing.T) {
+	var (
+		validator = common.Address{0xaa}
+		delegator = common.Address{0xbb}
+		env       = newTestEnv(t, []GenesisValidator{{Address: validator, Stake: pzx(10)}}, delegator)
+	)
+	env.mustCall(delegator, pzx(30), "delegate", validator)
+
+	if slashed := SlashDoubleSign(env.state, validator, 5, 1000); slashed.ToBig().Cmp(pzx(1)) != 0 {
+		t.Fatalf("slashed amount mismatch: have %v, want %v", slashed, pzx(1))
+	}
+	info := Validator(env.state, validator)
+	if !info.Jailed {
+		t.Errorf("validator not jailed")
+	}
+	if info.SelfStake.Cmp(pzx(9)) != 0 || info.TotalStake.Cmp(pzx(39)) != 0 {
+		t.Errorf("stake mismatch: have self %v total %v, want self %v total %v", info.SelfStake, info.TotalStake, pzx(9), pzx(39))
+	}
+	if have := Delegation(env.state, delegator, validator); have.Cmp(pzx(30)) != 0 {
+		t.Errorf("delegation mismatch: have %v, want %v", have, pzx(30))
+	}
+	if !DoubleSigned(env.state, validator, 5) || DoubleSigned(env.state, validator, 6) {
+		t.Errorf("double sign markers mismatch")
+	}
+	if slashed := SlashDoubleSign(env.state, validator, 5, 1000); !slashed.IsZero() {
+		t.Errorf("height slashed twice: %v", slashed)
+	}
+	if slashed := SlashDoubleSign(env.state, delegator, 5, 1000); !slashed.IsZero() {
+		t.Errorf("non-validator slashed: %v", slashed)
+	}
+}
+
+// Tests that self stake undelegated after a double sign is still slashed while
+// unbonding, but stake undelegated before the offence is not.
+func TestSlashUnbondingStake(t *testing.T) {
+	var (
+		validator = common.Address{0xaa}
+		env       = newTestEnv(t, []GenesisValidator{{Address: validator, Stake: pzx(30)}}, validator)
+	)
+	env.number = 10
+	env.mustCall(validator, new(big.Int), "undelegate", validator, pzx(10))
+	env.number = 20
+	env.mustCall(validator, new(big.Int), "undelegate", validator, pzx(10))
+
+	// Slashing the offence at height 15 must spare the first unbonding only
+	if slashed := SlashDoubleSign(env.state, validator, 15, 1000); slashed.ToBig().Cmp(pzx(2)) != 0 {
+		t.Fatalf("slashed amount mismatch: have %v, want %v", slashed, pzx(2))
+	}
+	unbondings := Unbondings(env.state, validator)
+	if len(unbondings) != 2 {
+		t.Fatalf("unbonding count mismatch: have %d, want 2", len(unbondings))
+	}
+	if unbondings[0].Amount.Cmp(pzx(10)) != 0 || unbondings[0].Creation != 10 {
+		t.Errorf("unbonding before offence mismatch: have %v at %d, want %v at 10", unbondings[0].Amount, unbondings[0].Creation, pzx(10))
+	}
+	if unbondings[1].Amount.Cmp(pzx(9)) != 0 || unbondings[1].Creation != 20 {
+		t.Errorf("unbonding after offence mismatch: have %v at %d, want %v at 20", unbondings[1].Amount, unbondings[1].Creation, pzx(9))
+	}
+}
+
+// Tests that unbondings are queued for the first epoch block after they mature,
+// and that releasing them only visits the delegators queued for that block,
+// skipping withdrawn ones.
+func TestReleaseUnbondings(t *testing.T) {
+	var (
+		validator = common.Address{0xaa}
+		first     = common.Address{0xb1}
+		second    = common.Address{0xb2}
+		third     = common.Address{0xb3}
+		env       = newTestEnv(t, []GenesisValidator{{Address: validator, Stake: pzx(10)}}, first, second, third)
+		s         = storage{env.state}
+	)
+	for _, delegator := range []common.Address{first, second, third} {
+		env.mustCall(delegator, pzx(10), "delegate", validator)
+	}
+	// Unbondings completing at 110 are released at the epoch block 200, the one
+	// completing at 250 at the epoch block 400
+	env.number = 10
+	env.mustCall(first, new(big.Int), "undelegate", validator, pzx(4))
+	env.mustCall(second, new(big.Int), "undelegate", validator, pzx(3))
+	env.mustCall(third, new(big.Int), "undelegate", validator, pzx(2))
+	env.number = 150
+	env.mustCall(first, new(big.Int), "undelegate", validator, pzx(1))
+
+	if queue := s.unbondingQueue(200); !slices.Equal(queue, []common.Address{first, second, third}) {
+		t.Fatalf("queue mismatch at 200: have %v", queue)
+	}
+	if queue := s.unbondingQueue(400); !slices.Equal(queue, []common.Address{first}) {
+		t.Fatalf("queue mismatch at 400: have %v", queue)
+	}
+	// The third delegator withdraws before the epoch, the others get paid back
+	env.mustCall(third, new(big.Int), "withdraw")
+
+	releases := ReleaseUnbondings(env.state, 200)
+	if len(releases) != 2 {
+		t.Fatalf("release count mismatch: have %d, want 2", len(releases))
+	}
+	for i, want := range []Release{{Delegator: first, Amount: uint256.MustFromBig(pzx(4))}, {Delegator: second, Amount: uint256.MustFromBig(pzx(3))}} {
+		if releases[i].Delegator != want.Delegator || releases[i].Amount.Cmp(want.Amount) != 0 {
+			t.Errorf("release %d mismatch: have %x/%v, want %x/%v", i, releases[i].Delegator, releases[i].Amount, want.Delegator, want.Amount)
+		}
+	}
+	if queue := s.unbondingQueue(200); len(queue) != 0 {
+		t.Fatalf("queue not cleared after release: have %v", queue)
+	}
+	if unbondings := Unbondings(env.state, first); len(unbondings) != 1 || unbondings[0].Completion != 250 {
+		t.Fatalf("pending unbondings mismatch: have %v", unbondings)
+	}
+	// Requeueing a released delegator must work, queueing one pending for the
+	// same epoch block again must not duplicate it
+	env.number = 260
+	env.mustCall(second, new(big.Int), "undelegate", validator, pzx(1))
+	env.mustCall(first, new(big.Int), "undelegate", validator, pzx(1))
+	if queue := s.unbondingQueue(400); !slices.Equal(queue, []common.Address{first, second}) {
+		t.Fatalf("queue mismatch at 400: have %v", queue)
+	}
+	releases = ReleaseUnbondings(env.state, 400)
+	for i, want := range []Release{{Delegator: first, Amount: uint256.MustFromBig(pzx(2))}, {Delegator: second, Amount: uint256.MustFromBig(pzx(1))}} {
+		if i >= len(releases) || releases[i].Delegator != want.Delegator || releases[i].Amount.Cmp(want.Amount) != 0 {
+			t.Fatalf("release %d mismatch: have %v, want %x/%v", i, releases, want.Delegator, want.Amount)
+		}
+	}
+	if len(Unbondings(env.state, first)) != 0 || len(Unbondings(env.state, second)) != 0 {
+		t.Fatalf("released unbondings not removed")
+	}
+}
+
+// Tests that the missed slot counters cover a sliding window of blocks, and that
+// validators jailed for downtime may unjail themselves while double signers can't.
+func TestLivenessAndUnjail(t *testing.T) {
+	var (
+		offline = common.Address{0xaa}
+		signer  = common.Address{0xbb}
+		env     = newTestEnv(t, []GenesisValidator{{Address: offline, Stake: pzx(10)}, {Address: signer, Stake: pzx(10)}}, offline, signer)
+	)
+	for number, want := range []uint64{1, 2, 2, 1, 1} {
+		var missed common.Address
+		if number < 2 || number == 4 {
+			missed = offline
+		}
+		RecordSlot(env.state, 3, uint64(number), missed)
+		if have := MissedSlots(env.state, offline); have != want {
+			t.Fatalf("block %d: missed slots mismatch: have %d, want %d", number, have, want)
+		}
+	}
+	if _, err := env.call(offline, new(big.Int), "unjail"); err == nil || err.Error() != "validator not jailed" {
+		t.Fatalf("unjail of active validator: have %v", err)
+	}
+	if !JailForDowntime(env.state, 3, offline) || JailForDowntime(env.state, 3, offline) {
+		t.Fatalf("jailing result mismatch")
+	}
+	if have := MissedSlots(env.state, offline); have != 0 {
+		t.Fatalf("missed slots not cleared: %d", have)
+	}
+	RecordSlot(env.state, 3, 5, common.Address{}) // Evicts a cleared record
+	if have := MissedSlots(env.state, offline); have != 0 {
+		t.Fatalf("cleared record evicted: %d", have)
+	}
+	env.mustCall(offline, new(big.Int), "unjail")
+	if Validator(env.state, offline).Jailed {
+		t.Fatalf("validator still jailed")
+	}
+	SlashDoubleSign(env.state, signer, 1, 0)
+	if _, err := env.call(signer, new(big.Int), "unjail"); err == nil || err.Error() != "validator tombstoned for double signing" {
+		t.Fatalf("unjail of tombstoned validator: have %v", err)
+	}
+	out := env.mustCall(signer, new(big.Int), "getJailStatus", signer)
+	if !out[0].(bool) || !out[1].(bool) {
+		t.Fatalf("jail status mismatch: have %v", out)
+	}
+}
+
+// Tests that shrinking a dynamic byte array clears the chunks of the old value.
+func TestBytesStorage(t *testing.T) {
+	var (
+		db = make(genesisStorage)
+		s  = storage{db}
+		at = slot("test")
+	)
+	s.setBytes(at, bytes.Repeat([]byte{0xff}, 70))
+	if have := s.getBytes(at); !bytes.Equal(have, bytes.Repeat([]byte{0xff}, 70)) {
+		t.Fatalf("bytes mismatch: have %x", have)
+	}
+	s.setBytes(at, []byte{0x01})
+	if have := s.getBytes(at); !bytes.Equal(have, []byte{0x01}) {
+		t.Fatalf("bytes mismatch: have %x", have)
+	}
+	if len(db) != 2 {
+		t.Fatalf("stale chunks left in storage: have %d slots, want 2", len(db))
+	}
+}
@@ -0,0 +1,331 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package staking implements the PIXELZX staking system contract.
+//
+// The contract lives at params.PixelzxStakingAddress and is pre-allocated in the
+// genesis block of every PIXELZX network. Its logic is implemented natively by
+// the client rather than in EVM bytecode, but it is called like any other
+// contract, with the ABI in staking.abi. The consensus engine reads the bonded
+// stakes straight from the contract's storage to elect the validator set.
+package staking
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// ValidatorInfo is the staking record of a registered validator.
+type ValidatorInfo struct {
+	Address      common.Address // Address of the validator
+	SelfStake    *big.Int       // Stake bonded by the validator itself
+	TotalStake   *big.Int       // Stake bonded by the validator and its delegators
+	Commission   uint64         // Commission charged to delegators, in basis points
+	Moniker      string         // Human readable name of the validator
+	ConsensusKey []byte         // Public key the validator signs consensus messages with, if any
+	Signer       common.Address // Address the validator seals blocks with
+	Jailed       bool           // Whether the validator is barred from the validator set
+	Tombstoned   bool           // Whether the validator is jailed for good, for double signing
+	MissedSlots  uint64         // Number of proposal slots missed within the liveness window
+}
+
+// Validators returns the addresses of all registered validators, in order of
+// registration.
+func Validators(db StateDB) []common.Address {
+	return storage{db}.validators()
+}
+
+// Validator returns the staking record of a validator, or nil if the address is
+// not registered as one.
+func Validator(db StateDB, validator common.Address) *ValidatorInfo {
+	s := storage{db}
+	if !s.registered(validator) {
+		return nil
+	}
+	return &ValidatorInfo{
+		Address:      validator,
+		SelfStake:    s.delegation(validator, validator).ToBig(),
+		TotalStake:   s.totalStake(validator).ToBig(),
+		Commission:   s.commission(validator),
+		Moniker:      s.moniker(validator),
+		ConsensusKey: s.consensusKey(validator),
+		Signer:       s.signer(validator),
+		Jailed:       s.jailReason(validator) != jailNone,
+		Tombstoned:   s.jailReason(validator) == jailDoubleSign,
+		MissedSlots:  s.missedSlots(validator),
+	}
+}
+
+// Signer returns the address a validator seals blocks with: the one of its
+// registered consensus key, or the validator's own if it registered none.
+func Signer(db StateDB, validator common.Address) common.Address {
+	return storage{db}.signer(validator)
+}
+
+// ValidatorOf returns the validator a block sealing address signs for. Consensus
+// keys keep mapping to their validator after being rotated out, and addresses
+// never registered as a consensus key sign for themselves.
+func ValidatorOf(db StateDB, signer common.Address) common.Address {
+	if validator := (storage{db}).signerValidator(signer); validator != (common.Address{}) {
+		return validator
+	}
+	return signer
+}
+
+// Delegation returns the stake bonded by a delegator to a validator.
+func Delegation(db StateDB, delegator, validator common.Address) *big.Int {
+	return storage{db}.delegation(delegator, validator).ToBig()
+}
+
+// TotalStake returns the stake bonded to a validator by itself and its
+// delegators.
+func TotalStake(db StateDB, validator common.Address) *big.Int {
+	return storage{db}.totalStake(validator).ToBig()
+}
+
+// PendingRewards returns the rewards a delegator may claim from a validator.
+func PendingRewards(db StateDB, delegator, validator common.Address) *big.Int {
+	return storage{db}.pendingRewards(delegator, validator).ToBig()
+}
+
+// DistributeReward splits the reward of a block proposed by a validator between
+// the validator and its delegators. The validator receives its commission and
+// the share of its self stake, the delegators accrue the rest in proportion to
+// their stakes and claim it from the contract later on.
+//
+// The method only updates the contract's bookkeeping: the caller is expected to
+// pay the validator's share and credit the delegators' one to the contract.
+func DistributeReward(db StateDB, validator common.Address, amount *uint256.Int) (proposer, delegators *uint256.Int) {
+	s := storage{db}
+	if !s.registered(validator) {
+		return amount.Clone(), new(uint256.Int)
+	}
+	var (
+		total     = s.totalStake(validator)
+		delegated = new(uint256.Int).Sub(total, s.delegation(validator, validator))
+	)
+	if delegated.IsZero() {
+		return amount.Clone(), new(uint256.Int)
+	}
+	// Deduct the validator's commission, and split the rest by stake
+	commission := new(uint256.Int).Mul(amount, uint256.NewInt(s.commission(validator)))
+	commission.Div(commission, uint256.NewInt(maxCommission))
+
+	delegators = new(uint256.Int).Sub(amount, commission)
+	delegators.Mul(delegators, delegated)
+	delegators.Div(delegators, total)
+
+	// Accumulate the delegators' share per unit of delegated stake
+	perStake := new(uint256.Int).Mul(delegators, rewardPrecision)
+	perStake.Div(perStake, delegated)
+	s.setRewardPerStake(validator, perStake.Add(perStake, s.rewardPerStake(validator)))
+
+	return new(uint256.Int).Sub(amount, delegators), delegators
+}
+
+// DoubleSigned returns whether a validator was already slashed for double signing
+// at the given block height.
+func DoubleSigned(db StateDB, validator common.Address, number uint64) bool {
+	return storage{db}.doubleSigned(validator, number)
+}
+
+// SlashDoubleSign punishes a validator for double signing at the given height,
+// slashing the given share (in basis points) of its self stake and jailing it for
+// good. Every height is only punished once, later calls are no-ops.
+//
+// Self stake undelegated at or after the offending height is still slashable
+// while unbonding, so the same share is slashed from those unbonding entries.
+//
+// The method only updates the contract's bookkeeping and returns the slashed
+// amount: the caller is expected to move it out of the contract's balance.
+func SlashDoubleSign(db StateDB, validator common.Address, number uint64, share uint64) *uint256.Int {
+	s := storage{db}
+	if !s.registered(validator) || s.doubleSigned(validator, number) {
+		return new(uint256.Int)
+	}
+	s.setDoubleSigned(validator, number)
+	s.setJailReason(validator, jailDoubleSign)
+
+	stake := s.delegation(validator, validator)
+	slashed := new(uint256.Int).Mul(stake, uint256.NewInt(share))
+	slashed.Div(slashed, uint256.NewInt(maxCommission))
+	if !slashed.IsZero() {
+		s.setDelegation(validator, validator, stake.Sub(stake, slashed))
+
+		total := s.totalStake(validator)
+		s.setTotalStake(validator, total.Sub(total, slashed))
+	}
+	var (
+		unbondings = s.unbondings(validator)
+		cuts       bool
+	)
+	for i, entry := range unbondings {
+		if entry.Validator != validator || entry.Creation < number {
+			continue
+		}
+		amount := uint256.MustFromBig(entry.Amount)
+		cut := new(uint256.Int).Mul(amount, uint256.NewInt(share))
+		cut.Div(cut, uint256.NewInt(maxCommission))
+
+		unbondings[i].Amount = amount.Sub(amount, cut).ToBig()
+		slashed.Add(slashed, cut)
+		cuts = true
+	}
+	if cuts {
+		s.setUnbondings(validator, unbondings)
+	}
+	return slashed
+}
+
+// RecordSlot records the liveness of the in-turn proposer of a block, the zero
+// address meaning that it proposed in time, otherwise the validator that missed
+// its slot. The records are kept in a ring buffer of the given window length, so
+// the missed slot counters cover the most recent window of blocks.
+func RecordSlot(db StateDB, window, number uint64, missed common.Address) {
+	storage{db}.recordSlot(window, number, missed)
+}
+
+// MissedSlots returns the number of proposal slots a validator missed within the
+// liveness window.
+func MissedSlots(db StateDB, validator common.Address) uint64 {
+	return storage{db}.missedSlots(validator)
+}
+
+// JailForDowntime jails a validator which missed too many slots, clearing its
+// liveness record so it starts afresh once unjailed. It returns false if the
+// validator is not registered or jailed already.
+func JailForDowntime(db StateDB, window uint64, validator common.Address) bool {
+	s := storage{db}
+	if !s.registered(validator) || s.jailReason(validator) != jailNone {
+		return false
+	}
+	s.setJailReason(validator, jailDowntime)
+	s.clearMissedSlots(window, validator)
+	return true
+}
+
+// Unbondings returns the pending unbonding entries of a delegator.
+func Unbondings(db StateDB, delegator common.Address) []Unbonding {
+	return storage{db}.unbondings(delegator)
+}
+
+// Release is a matured unbonding released back to its delegator.
+type Release struct {
+	Delegator common.Address // Delegator the stake is paid back to
+	Amount    *uint256.Int   // Amount of matured stake released
+}
+
+// ReleaseUnbondings removes the unbonding entries matured at the given epoch
+// block, returning the amounts to pay back to each delegator. Only the delegators
+// queued for the block are visited, the queue being indexed by the epoch block
+// their unbondings mature at.
+//
+// The method only updates the contract's bookkeeping: the caller is expected to
+// move the released stake out of the contract's balance.
+func ReleaseUnbondings(db StateDB, number uint64) []Release {
+	var (
+		s        = storage{db}
+		queue    = s.unbondingQueue(number)
+		releases []Release
+	)
+	for _, delegator := range queue {
+		var (
+			amount  = new(uint256.Int)
+			pending []Unbonding
+		)
+		unbondings := s.unbondings(delegator)
+		for _, entry := range unbondings {
+			if entry.Completion <= number {
+				amount.Add(amount, uint256.MustFromBig(entry.Amount))
+			} else {
+				pending = append(pending, entry)
+			}
+		}
+		if len(pending) != len(unbondings) {
+			s.setUnbondings(delegator, pending)
+		}
+		if !amount.IsZero() {
+			releases = append(releases, Release{Delegator: delegator, Amount: amount})
+		}
+	}
+	s.clearUnbondingQueue(number, queue)
+	return releases
+}
+
+// releaseNumber returns the epoch block releasing an unbonding completing at the
+// given block: the first epoch block at or after it.
+func releaseNumber(epoch, completion uint64) uint64 {
+	return (completion + epoch - 1) / epoch * epoch
+}
+
+// GenesisValidator is a validator registered in the genesis block.
+type GenesisValidator struct {
+	Address    common.Address // Address of the validator
+	Stake      *big.Int       // Self stake bonded at genesis
+	Commission uint64         // Commission charged to delegators, in basis points
+	Moniker    string         // Human readable name of the validator
+}
+
+// genesisStorage is a map backed StateDB used to assemble the genesis storage of
+// the contract.
+type genesisStorage map[common.Hash]common.Hash
+
+func (s genesisStorage) GetState(addr common.Address, key common.Hash) common.Hash {
+	return s[key]
+}
+
+func (s genesisStorage) SetState(addr common.Address, key common.Hash, value common.Hash) common.Hash {
+	prev := s[key]
+	if value == (common.Hash{}) {
+		delete(s, key)
+	} else {
+		s[key] = value
+	}
+	return prev
+}
+
+// GenesisAccount creates the genesis allocation of the staking contract with the
+// given validators registered and their self stakes bonded. The contract holds
+// the bonded funds, so its balance is the sum of the stakes.
+func GenesisAccount(validators []GenesisValidator) types.Account {
+	var (
+		db      = make(genesisStorage)
+		s       = storage{db}
+		balance = new(big.Int)
+	)
+	for _, v := range validators {
+		stake := uint256.MustFromBig(v.Stake)
+
+		s.setStatus(v.Address, statusRegistered)
+		s.setCommission(v.Address, v.Commission)
+		s.setMoniker(v.Address, v.Moniker)
+		s.addValidator(v.Address)
+		s.setDelegation(v.Address, v.Address, stake)
+		s.setTotalStake(v.Address, stake)
+
+		balance.Add(balance, v.Stake)
+	}
+	return types.Account{
+		Code:    params.PixelzxStakingCode,
+		Storage: db,
+		Balance: balance,
+		Nonce:   1,
+	}
+}
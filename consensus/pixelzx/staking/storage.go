@@ -0,0 +1,490 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package staking
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// StateDB is the subset of the state database the staking contract storage is
+// accessed through. It is satisfied by both the live state and the genesis
+// builder, so the same layout code is shared by all of them.
+type StateDB interface {
+	GetState(common.Address, common.Hash) common.Hash
+	SetState(common.Address, common.Hash, common.Hash) common.Hash
+}
+
+// Storage layout of the staking contract. Every record is namespaced by hashing
+// a tag together with its keys, leaving no chance for collisions:
+//
+//	keccak("validators")                                   number of registered validators
+//	keccak(keccak("validators")) + i                       address of the i-th validator
+//	keccak("validator" ‖ validator) + offset               validator record fields
+//	keccak("signer" ‖ signer)                              validator a consensus key address ever signed for
+//	keccak("delegation" ‖ delegator ‖ validator)           amount staked by a delegator
+//	keccak("rewardDebt" ‖ delegator ‖ validator)           rewards accounted for up front
+//	keccak("rewards" ‖ delegator ‖ validator)              settled rewards left to claim
+//	keccak("unbonding" ‖ delegator)                        number of pending unbondings
+//	keccak(keccak("unbonding" ‖ delegator)) + 4i + offset  unbonding entry fields
+//	keccak("unbondingQueue") + number                      number of delegators with unbondings released at a block
+//	keccak(keccak("unbondingQueue") + number) + i          address of the i-th delegator released at a block
+//	keccak("unbondingQueued" ‖ delegator) + number         set if the delegator is released at a block
+//	keccak("doubleSign" ‖ validator) + number              set if slashed for double signing at a height
+//	keccak("missedSlots") + number % window                validator that missed the slot of a block
+var validatorsSlot = slot("validators")
+
+// unbondingQueueSlot is the base of the lists of delegators with unbondings to be
+// released by the consensus engine, indexed by the epoch block releasing them.
+var unbondingQueueSlot = slot("unbondingQueue")
+
+// Field offsets within a validator record.
+const (
+	validatorStatusOffset     = iota // Registration status of the validator
+	validatorStakeOffset             // Total stake bonded to the validator
+	validatorCommissionOffset        // Commission rate in basis points
+	validatorMonikerOffset           // Human readable name of the validator
+	validatorKeyOffset               // Consensus key of the validator
+	validatorRewardOffset            // Accumulated delegator reward per unit of stake
+	validatorJailedOffset            // Reason the validator is barred from the validator set, if any
+	validatorMissedOffset            // Number of slots missed within the liveness window
+	validatorSignerOffset            // Address of the consensus key, if one is registered
+)
+
+// missedSlotsSlot is the base of the ring buffer recording the validators that
+// missed their proposal slot within the liveness window.
+var missedSlotsSlot = slot("missedSlots")
+
+// rewardPrecision is the fixed point scale of the accumulated delegator rewards
+// per unit of stake.
+var rewardPrecision = new(uint256.Int).Exp(uint256.NewInt(10), uint256.NewInt(30))
+
+// Field offsets within an unbonding entry.
+const (
+	unbondingValidatorOffset  = iota // Validator the stake was bonded to
+	unbondingAmountOffset            // Amount of stake being released
+	unbondingCompletionOffset        // Block number from which it can be withdrawn
+	unbondingCreationOffset          // Block number the stake was undelegated at
+	unbondingEntrySize
+)
+
+// Reasons a validator is jailed for.
+const (
+	jailNone       = iota // Validator not jailed
+	jailDowntime          // Jailed for missing too many slots, may unjail itself
+	jailDoubleSign        // Jailed for double signing, permanently
+)
+
+// Registration statuses of a validator.
+const (
+	statusNone       = iota // Address never registered as a validator
+	statusRegistered        // Validator registered and bonding stake
+)
+
+// slot derives a storage slot from a tag and a list of keys.
+func slot(tag string, keys ...common.Address) common.Hash {
+	data := []byte(tag)
+	for _, key := range keys {
+		data = append(data, key.Bytes()...)
+	}
+	return crypto.Keccak256Hash(data)
+}
+
+// offset returns the storage slot n positions after the given one.
+func offset(slot common.Hash, n uint64) common.Hash {
+	pos := new(uint256.Int).SetBytes32(slot[:])
+	return pos.AddUint64(pos, n).Bytes32()
+}
+
+// storage is a typed accessor over the staking contract's storage.
+type storage struct {
+	db StateDB
+}
+
+func (s storage) get(slot common.Hash) common.Hash {
+	return s.db.GetState(params.PixelzxStakingAddress, slot)
+}
+
+func (s storage) set(slot common.Hash, value common.Hash) {
+	s.db.SetState(params.PixelzxStakingAddress, slot, value)
+}
+
+func (s storage) getUint(slot common.Hash) *uint256.Int {
+	value := s.get(slot)
+	return new(uint256.Int).SetBytes32(value[:])
+}
+
+func (s storage) setUint(slot common.Hash, value *uint256.Int) {
+	s.set(slot, value.Bytes32())
+}
+
+func (s storage) getAddress(slot common.Hash) common.Address {
+	return common.BytesToAddress(s.get(slot).Bytes())
+}
+
+func (s storage) setAddress(slot common.Hash, addr common.Address) {
+	s.set(slot, common.BytesToHash(addr.Bytes()))
+}
+
+// getBytes reads a dynamic byte array, stored as its length followed by 32 byte
+// chunks starting at the hash of the slot.
+func (s storage) getBytes(slot common.Hash) []byte {
+	size := s.getUint(slot).Uint64()
+	if size == 0 {
+		return nil
+	}
+	var (
+		data = make([]byte, 0, size+31)
+		base = crypto.Keccak256Hash(slot[:])
+	)
+	for i := uint64(0); uint64(len(data)) < size; i++ {
+		chunk := s.get(offset(base, i))
+		data = append(data, chunk[:]...)
+	}
+	return data[:size]
+}
+
+// setBytes writes a dynamic byte array, clearing any chunks of a longer value
+// that was stored previously.
+func (s storage) setBytes(slot common.Hash, data []byte) {
+	var (
+		prev = s.getUint(slot).Uint64()
+		base = crypto.Keccak256Hash(slot[:])
+	)
+	s.setUint(slot, uint256.NewInt(uint64(len(data))))
+	for i := uint64(0); i*32 < uint64(len(data)) || i*32 < prev; i++ {
+		var chunk common.Hash
+		if i*32 < uint64(len(data)) {
+			copy(chunk[:], data[i*32:])
+		}
+		s.set(offset(base, i), chunk)
+	}
+}
+
+// validatorCount returns the number of registered validators.
+func (s storage) validatorCount() uint64 {
+	return s.getUint(validatorsSlot).Uint64()
+}
+
+// validators returns the addresses of all registered validators in order of
+// registration.
+func (s storage) validators() []common.Address {
+	var (
+		count = s.validatorCount()
+		base  = crypto.Keccak256Hash(validatorsSlot[:])
+		list  = make([]common.Address, count)
+	)
+	for i := uint64(0); i < count; i++ {
+		list[i] = s.getAddress(offset(base, i))
+	}
+	return list
+}
+
+// addValidator appends a validator to the list of registered ones.
+func (s storage) addValidator(validator common.Address) {
+	count := s.validatorCount()
+	s.setAddress(offset(crypto.Keccak256Hash(validatorsSlot[:]), count), validator)
+	s.setUint(validatorsSlot, uint256.NewInt(count+1))
+}
+
+func (s storage) validatorField(validator common.Address, field uint64) common.Hash {
+	return offset(slot("validator", validator), field)
+}
+
+func (s storage) registered(validator common.Address) bool {
+	return s.getUint(s.validatorField(validator, validatorStatusOffset)).Uint64() != statusNone
+}
+
+func (s storage) setStatus(validator common.Address, status uint64) {
+	s.setUint(s.validatorField(validator, validatorStatusOffset), uint256.NewInt(status))
+}
+
+func (s storage) jailReason(validator common.Address) uint64 {
+	return s.getUint(s.validatorField(validator, validatorJailedOffset)).Uint64()
+}
+
+func (s storage) setJailReason(validator common.Address, reason uint64) {
+	s.setUint(s.validatorField(validator, validatorJailedOffset), uint256.NewInt(reason))
+}
+
+func (s storage) missedSlots(validator common.Address) uint64 {
+	return s.getUint(s.validatorField(validator, validatorMissedOffset)).Uint64()
+}
+
+func (s storage) setMissedSlots(validator common.Address, missed uint64) {
+	s.setUint(s.validatorField(validator, validatorMissedOffset), uint256.NewInt(missed))
+}
+
+// recordSlot records whether the in-turn validator missed the slot of a block,
+// evicting the record of the block leaving the liveness window.
+func (s storage) recordSlot(window, number uint64, missed common.Address) {
+	at := offset(missedSlotsSlot, number%window)
+	if evicted := s.getAddress(at); evicted != (common.Address{}) {
+		if count := s.missedSlots(evicted); count > 0 {
+			s.setMissedSlots(evicted, count-1)
+		}
+	}
+	s.setAddress(at, missed)
+	if missed != (common.Address{}) {
+		s.setMissedSlots(missed, s.missedSlots(missed)+1)
+	}
+}
+
+// clearMissedSlots forgets the slots a validator missed within the liveness
+// window, giving it a clean record.
+func (s storage) clearMissedSlots(window uint64, validator common.Address) {
+	if s.missedSlots(validator) == 0 {
+		return
+	}
+	for i := uint64(0); i < window; i++ {
+		if at := offset(missedSlotsSlot, i); s.getAddress(at) == validator {
+			s.setAddress(at, common.Address{})
+		}
+	}
+	s.setMissedSlots(validator, 0)
+}
+
+func (s storage) totalStake(validator common.Address) *uint256.Int {
+	return s.getUint(s.validatorField(validator, validatorStakeOffset))
+}
+
+func (s storage) setTotalStake(validator common.Address, stake *uint256.Int) {
+	s.setUint(s.validatorField(validator, validatorStakeOffset), stake)
+}
+
+func (s storage) commission(validator common.Address) uint64 {
+	return s.getUint(s.validatorField(validator, validatorCommissionOffset)).Uint64()
+}
+
+func (s storage) setCommission(validator common.Address, commission uint64) {
+	s.setUint(s.validatorField(validator, validatorCommissionOffset), uint256.NewInt(commission))
+}
+
+func (s storage) moniker(validator common.Address) string {
+	return string(s.getBytes(s.validatorField(validator, validatorMonikerOffset)))
+}
+
+func (s storage) setMoniker(validator common.Address, moniker string) {
+	s.setBytes(s.validatorField(validator, validatorMonikerOffset), []byte(moniker))
+}
+
+func (s storage) consensusKey(validator common.Address) []byte {
+	return s.getBytes(s.validatorField(validator, validatorKeyOffset))
+}
+
+func (s storage) setConsensusKey(validator common.Address, key []byte) {
+	s.setBytes(s.validatorField(validator, validatorKeyOffset), key)
+}
+
+// signer returns the address a validator seals blocks with: the one of its
+// consensus key, or its own if it never registered one.
+func (s storage) signer(validator common.Address) common.Address {
+	if signer := s.getAddress(s.validatorField(validator, validatorSignerOffset)); signer != (common.Address{}) {
+		return signer
+	}
+	return validator
+}
+
+// setSigner records the address of the consensus key of a validator, mapping it
+// back to the validator for good: blocks sealed and evidence signed by a key are
+// accounted to its validator even after it is rotated out.
+func (s storage) setSigner(validator, signer common.Address) {
+	s.setAddress(s.validatorField(validator, validatorSignerOffset), signer)
+	s.setAddress(slot("signer", signer), validator)
+}
+
+// signerValidator returns the validator a consensus key address signs for, or
+// the zero address if it was never registered as one.
+func (s storage) signerValidator(signer common.Address) common.Address {
+	return s.getAddress(slot("signer", signer))
+}
+
+func (s storage) delegation(delegator, validator common.Address) *uint256.Int {
+	return s.getUint(slot("delegation", delegator, validator))
+}
+
+func (s storage) setDelegation(delegator, validator common.Address, amount *uint256.Int) {
+	s.setUint(slot("delegation", delegator, validator), amount)
+}
+
+func (s storage) doubleSigned(validator common.Address, number uint64) bool {
+	return !s.getUint(offset(slot("doubleSign", validator), number)).IsZero()
+}
+
+func (s storage) setDoubleSigned(validator common.Address, number uint64) {
+	s.setUint(offset(slot("doubleSign", validator), number), uint256.NewInt(1))
+}
+
+func (s storage) rewardPerStake(validator common.Address) *uint256.Int {
+	return s.getUint(s.validatorField(validator, validatorRewardOffset))
+}
+
+func (s storage) setRewardPerStake(validator common.Address, acc *uint256.Int) {
+	s.setUint(s.validatorField(validator, validatorRewardOffset), acc)
+}
+
+func (s storage) rewards(delegator, validator common.Address) *uint256.Int {
+	return s.getUint(slot("rewards", delegator, validator))
+}
+
+func (s storage) setRewards(delegator, validator common.Address, amount *uint256.Int) {
+	s.setUint(slot("rewards", delegator, validator), amount)
+}
+
+// accrued returns the rewards a delegation earned since they were last settled.
+// Validators are paid for their self stake directly, so they never accrue any.
+func (s storage) accrued(delegator, validator common.Address) *uint256.Int {
+	if delegator == validator {
+		return new(uint256.Int)
+	}
+	earned := new(uint256.Int).Mul(s.delegation(delegator, validator), s.rewardPerStake(validator))
+	earned.Div(earned, rewardPrecision)
+
+	debt := s.getUint(slot("rewardDebt", delegator, validator))
+	if earned.Lt(debt) {
+		return new(uint256.Int)
+	}
+	return earned.Sub(earned, debt)
+}
+
+// pendingRewards returns the rewards a delegator may claim from a validator.
+func (s storage) pendingRewards(delegator, validator common.Address) *uint256.Int {
+	pending := s.rewards(delegator, validator)
+	return pending.Add(pending, s.accrued(delegator, validator))
+}
+
+// settleRewards moves the accrued rewards of a delegation into its claimable
+// balance. It must be called before the delegated amount changes, followed by
+// resetRewardDebt after the change.
+func (s storage) settleRewards(delegator, validator common.Address) {
+	if accrued := s.accrued(delegator, validator); !accrued.IsZero() {
+		rewards := s.rewards(delegator, validator)
+		s.setRewards(delegator, validator, rewards.Add(rewards, accrued))
+	}
+}
+
+// resetRewardDebt marks all rewards accumulated so far as accounted for in the
+// current delegated amount.
+func (s storage) resetRewardDebt(delegator, validator common.Address) {
+	debt := new(uint256.Int).Mul(s.delegation(delegator, validator), s.rewardPerStake(validator))
+	s.setUint(slot("rewardDebt", delegator, validator), debt.Div(debt, rewardPrecision))
+}
+
+// Unbonding is a chunk of stake released by a delegator, which becomes
+// withdrawable once the unbonding period elapses.
+type Unbonding struct {
+	Validator  common.Address // Validator the stake was bonded to
+	Amount     *big.Int       // Amount of stake being released
+	Completion uint64         // Block number from which the stake can be withdrawn
+	Creation   uint64         // Block number the stake was undelegated at
+}
+
+// unbondings returns the pending unbonding entries of a delegator.
+func (s storage) unbondings(delegator common.Address) []Unbonding {
+	var (
+		head  = slot("unbonding", delegator)
+		base  = crypto.Keccak256Hash(head[:])
+		count = s.getUint(head).Uint64()
+		list  = make([]Unbonding, count)
+	)
+	for i := uint64(0); i < count; i++ {
+		entry := offset(base, i*unbondingEntrySize)
+		list[i] = Unbonding{
+			Validator:  s.getAddress(offset(entry, unbondingValidatorOffset)),
+			Amount:     s.getUint(offset(entry, unbondingAmountOffset)).ToBig(),
+			Completion: s.getUint(offset(entry, unbondingCompletionOffset)).Uint64(),
+			Creation:   s.getUint(offset(entry, unbondingCreationOffset)).Uint64(),
+		}
+	}
+	return list
+}
+
+// setUnbondings overwrites the pending unbonding entries of a delegator,
+// clearing the slots of any entries beyond the new list.
+func (s storage) setUnbondings(delegator common.Address, list []Unbonding) {
+	var (
+		head = slot("unbonding", delegator)
+		base = crypto.Keccak256Hash(head[:])
+		prev = s.getUint(head).Uint64()
+	)
+	for i := uint64(0); i < uint64(len(list)) || i < prev; i++ {
+		var (
+			entry     = offset(base, i*unbondingEntrySize)
+			validator common.Address
+			amount    = new(uint256.Int)
+			complete  uint64
+			create    uint64
+		)
+		if i < uint64(len(list)) {
+			validator, complete, create = list[i].Validator, list[i].Completion, list[i].Creation
+			amount.SetFromBig(list[i].Amount)
+		}
+		s.setAddress(offset(entry, unbondingValidatorOffset), validator)
+		s.setUint(offset(entry, unbondingAmountOffset), amount)
+		s.setUint(offset(entry, unbondingCompletionOffset), uint256.NewInt(complete))
+		s.setUint(offset(entry, unbondingCreationOffset), uint256.NewInt(create))
+	}
+	s.setUint(head, uint256.NewInt(uint64(len(list))))
+}
+
+// unbondingQueue returns the delegators with unbondings released at the given
+// epoch block, in the order they were queued.
+func (s storage) unbondingQueue(number uint64) []common.Address {
+	var (
+		head  = offset(unbondingQueueSlot, number)
+		base  = crypto.Keccak256Hash(head[:])
+		count = s.getUint(head).Uint64()
+		list  = make([]common.Address, count)
+	)
+	for i := uint64(0); i < count; i++ {
+		list[i] = s.getAddress(offset(base, i))
+	}
+	return list
+}
+
+// enqueueUnbonding adds a delegator to the unbondings released at the given epoch
+// block, unless it's queued for that block already.
+func (s storage) enqueueUnbonding(delegator common.Address, number uint64) {
+	flag := offset(slot("unbondingQueued", delegator), number)
+	if s.get(flag) != (common.Hash{}) {
+		return
+	}
+	s.set(flag, common.BytesToHash([]byte{1}))
+
+	head := offset(unbondingQueueSlot, number)
+	count := s.getUint(head).Uint64()
+	s.setAddress(offset(crypto.Keccak256Hash(head[:]), count), delegator)
+	s.setUint(head, uint256.NewInt(count+1))
+}
+
+// clearUnbondingQueue drops the list of delegators released at the given epoch
+// block, unflagging them.
+func (s storage) clearUnbondingQueue(number uint64, list []common.Address) {
+	var (
+		head = offset(unbondingQueueSlot, number)
+		base = crypto.Keccak256Hash(head[:])
+	)
+	for i, delegator := range list {
+		s.set(offset(slot("unbondingQueued", delegator), number), common.Hash{})
+		s.setAddress(offset(base, uint64(i)), common.Address{})
+	}
+	s.setUint(head, new(uint256.Int))
+}
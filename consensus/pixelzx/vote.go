@@ -0,0 +1,91 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pixelzx
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// errInvalidVoteSignature is returned if the signature of a vote is malformed.
+var errInvalidVoteSignature = errors.New("invalid vote signature")
+
+// Checkpoint is a block referenced by a finality vote.
+type Checkpoint struct {
+	Number uint64      // Number of the block
+	Hash   common.Hash // Hash of the block
+}
+
+// Vote is a validator's attestation of a block, linking it to the latest block
+// the validator saw justified among its ancestors. Once validators with more than
+// two thirds of the voting power attested a block from justified sources, it is
+// justified too, and a justified block attested by its direct child is final.
+type Vote struct {
+	Source    Checkpoint // Latest justified ancestor of the attested block
+	Target    Checkpoint // Attested block
+	Signature []byte     // Signature of the validator over the vote's RLP
+}
+
+// ID returns the unique identifier of the vote, covering its signature.
+func (v *Vote) ID() common.Hash {
+	blob, err := rlp.EncodeToBytes(v)
+	if err != nil {
+		panic("can't encode: " + err.Error())
+	}
+	return crypto.Keccak256Hash(blob)
+}
+
+// Signer recovers the address of the validator that signed the vote.
+func (v *Vote) Signer() (common.Address, error) {
+	if len(v.Signature) != crypto.SignatureLength {
+		return common.Address{}, errInvalidVoteSignature
+	}
+	pubkey, err := crypto.SigToPub(crypto.Keccak256(VoteRLP(v.Source, v.Target)), v.Signature)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pubkey), nil
+}
+
+// VoteRLP returns the rlp bytes a validator signs to attest a block from a source.
+func VoteRLP(source, target Checkpoint) []byte {
+	blob, err := rlp.EncodeToBytes([]interface{}{source, target})
+	if err != nil {
+		panic("can't encode: " + err.Error())
+	}
+	return blob
+}
+
+// SignVote attests a block from a source with the local signing credentials.
+func (p *Pixelzx) SignVote(source, target Checkpoint) (*Vote, error) {
+	p.lock.RLock()
+	signer, signFn := p.signer, p.signFn
+	p.lock.RUnlock()
+
+	if signFn == nil {
+		return nil, errors.New("pixelzx signer not authorized")
+	}
+	sig, err := signFn(accounts.Account{Address: signer}, accounts.MimetypePixelzxVote, VoteRLP(source, target))
+	if err != nil {
+		return nil, err
+	}
+	return &Vote{Source: source, Target: target, Signature: sig}, nil
+}
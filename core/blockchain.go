@@ -112,6 +112,7 @@ var (
 	errChainStopped         = errors.New("blockchain is stopped")
 	errInvalidOldChain      = errors.New("invalid old chain")
 	errInvalidNewChain      = errors.New("invalid new chain")
+	errReorgFinalized       = errors.New("reorg would drop finalized block")
 )
 
 var (
@@ -298,6 +299,7 @@ type BlockChain struct {
 	rmLogsFeed       event.Feed
 	chainFeed        event.Feed
 	chainHeadFeed    event.Feed
+	chainSideFeed    event.Feed
 	logsFeed         event.Feed
 	blockProcFeed    event.Feed
 	blockProcCounter int32
@@ -1557,6 +1559,16 @@ func (bc *BlockChain) writeBlockWithoutState(block *types.Block) (err error) {
 func (bc *BlockChain) writeKnownBlock(block *types.Block) error {
 	current := bc.CurrentBlock()
 	if block.ParentHash() != current.Hash() {
+		reorg, err := bc.reorgNeeded(current, block.Header())
+		if err != nil {
+			return err
+		}
+		if !reorg {
+			return nil
+		}
+		if bc.dropsFinalized(block.Header()) {
+			return errReorgFinalized
+		}
 		if err := bc.reorg(current, block.Header()); err != nil {
 			return err
 		}
@@ -1565,6 +1577,49 @@ func (bc *BlockChain) writeKnownBlock(block *types.Block) error {
 	return nil
 }
 
+// reorgNeeded reports whether the chain headed by the given header, which doesn't
+// extend the current head, should become the canonical one. Unless the engine
+// chooses between forks itself, the latest imported block always does.
+func (bc *BlockChain) reorgNeeded(current *types.Header, head *types.Header) (bool, error) {
+	chooser, ok := bc.engine.(consensus.ForkChooser)
+	if !ok {
+		return true, nil
+	}
+	return chooser.ReorgNeeded(bc, current, head)
+}
+
+// postSideEvents notifies the subscribers of blocks dropped from, or not making
+// it into, the canonical chain. The events are delivered in the background, as
+// blocks are imported with the chain mutex held, which slow subscribers must
+// never hold up.
+func (bc *BlockChain) postSideEvents(headers []*types.Header) {
+	if len(headers) == 0 {
+		return
+	}
+	go func() {
+		for _, header := range headers {
+			bc.chainSideFeed.Send(ChainSideEvent{Header: header})
+		}
+	}()
+}
+
+// dropsFinalized reports whether making the given header the chain head would
+// reorg the finalized block out of the canonical chain. Block imports may never
+// do so, only the consensus layer may via an explicit SetCanonical.
+func (bc *BlockChain) dropsFinalized(head *types.Header) bool {
+	final := bc.CurrentFinalBlock()
+	if final == nil {
+		return false
+	}
+	number := head.Number.Uint64()
+	if number < final.Number.Uint64() {
+		return true
+	}
+	maxNonCanonical := uint64(math.MaxUint64)
+	hash, _ := bc.GetAncestor(head.Hash(), number, number-final.Number.Uint64(), &maxNonCanonical)
+	return hash != final.Hash()
+}
+
 // writeBlockWithState writes block, metadata and corresponding state data to the
 // database.
 func (bc *BlockChain) writeBlockWithState(block *types.Block, receipts []*types.Receipt, statedb *state.StateDB) error {
@@ -1657,6 +1712,16 @@ func (bc *BlockChain) writeBlockAndSetHead(block *types.Block, receipts []*types
 
 	// Reorganise the chain if the parent is not the head block
 	if block.ParentHash() != currentBlock.Hash() {
+		reorg, err := bc.reorgNeeded(currentBlock, block.Header())
+		if err != nil {
+			return NonStatTy, err
+		}
+		if !reorg {
+			return SideStatTy, nil
+		}
+		if bc.dropsFinalized(block.Header()) {
+			return NonStatTy, errReorgFinalized
+		}
 		if err := bc.reorg(currentBlock, block.Header()); err != nil {
 			return NonStatTy, err
 		}
@@ -1904,6 +1969,7 @@ func (bc *BlockChain) insertChain(chain types.Blocks, setHead bool, makeWitness
 			// After merge we expect few side chains. Simply count
 			// all blocks the CL gives us for GC processing time
 			bc.gcproc += res.procTime
+			bc.postSideEvents([]*types.Header{block.Header()})
 			return witness, it.index, nil // Direct block insertion of a single block
 		}
 		switch res.status {
@@ -1924,6 +1990,8 @@ func (bc *BlockChain) insertChain(chain types.Blocks, setHead bool, makeWitness
 				"txs", len(block.Transactions()), "gas", block.GasUsed(), "uncles", len(block.Uncles()),
 				"root", block.Root())
 
+			bc.postSideEvents([]*types.Header{block.Header()})
+
 		default:
 			// This in theory is impossible, but lets be nice to our future selves and leave
 			// a log, instead of trying to track down blocks imports that don't emit logs.
@@ -2462,6 +2530,8 @@ func (bc *BlockChain) reorg(oldHead *types.Header, newHead *types.Header) error
 			// TODO(karalabe): Hook into the reverse emission part
 		}
 	}
+	bc.postSideEvents(oldChain)
+
 	// Apply new blocks in forward order
 	for i := len(newChain) - 1; i >= 1; i-- {
 		// Collect all the included transactions
@@ -622,6 +622,49 @@ func testReorg(t *testing.T, first, second []int64, td int64, full bool, scheme
 	}
 }
 
+// Tests that reorgs dropping the finalized block from the canonical chain are
+// refused, whereas reorgs above it are accepted.
+func TestReorgBelowFinalized(t *testing.T) {
+	testReorgBelowFinalized(t, rawdb.HashScheme)
+	testReorgBelowFinalized(t, rawdb.PathScheme)
+}
+
+func testReorgBelowFinalized(t *testing.T, scheme string) {
+	genDb, _, blockchain, err := newCanonical(ethash.NewFaker(), 0, true, scheme)
+	if err != nil {
+		t.Fatalf("failed to create pristine chain: %v", err)
+	}
+	defer blockchain.Stop()
+
+	genesis := blockchain.GetBlockByHash(blockchain.CurrentBlock().Hash())
+	blocks, _ := GenerateChain(params.TestChainConfig, genesis, ethash.NewFaker(), genDb, 4, func(i int, b *BlockGen) {})
+	if _, err := blockchain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	blockchain.SetFinalized(blocks[1].Header())
+
+	// Fork off below the finalized block, which must be refused
+	fork, _ := GenerateChain(params.TestChainConfig, blocks[0], ethash.NewFaker(), genDb, 4, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	})
+	if _, err := blockchain.InsertChain(fork); !errors.Is(err, errReorgFinalized) {
+		t.Fatalf("reorg error mismatch: have %v, want %v", err, errReorgFinalized)
+	}
+	if head := blockchain.CurrentBlock(); head.Hash() != blocks[3].Hash() {
+		t.Fatalf("head mismatch: have %d [%x], want %d [%x]", head.Number, head.Hash(), blocks[3].NumberU64(), blocks[3].Hash())
+	}
+	// Fork off at the finalized block, which must be accepted
+	fork, _ = GenerateChain(params.TestChainConfig, blocks[1], ethash.NewFaker(), genDb, 3, func(i int, b *BlockGen) {
+		b.SetCoinbase(common.Address{0x01})
+	})
+	if _, err := blockchain.InsertChain(fork); err != nil {
+		t.Fatalf("failed to reorg above finalized block: %v", err)
+	}
+	if head := blockchain.CurrentBlock(); head.Hash() != fork[2].Hash() {
+		t.Fatalf("head mismatch: have %d [%x], want %d [%x]", head.Number, head.Hash(), fork[2].NumberU64(), fork[2].Hash())
+	}
+}
+
 // Tests chain insertions in the face of one entity containing an invalid nonce.
 func TestHeadersInsertNonceError(t *testing.T) {
 	testInsertNonceError(t, false, rawdb.HashScheme)
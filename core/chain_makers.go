@@ -113,8 +113,12 @@ func (b *BlockGen) addTx(bc *BlockChain, vmConfig vm.Config, tx *types.Transacti
 	if b.gasPool == nil {
 		b.SetCoinbase(common.Address{})
 	}
+	var chain ChainContext = b.cm
+	if bc != nil {
+		chain = bc
+	}
 	var (
-		blockContext = NewEVMBlockContext(b.header, bc, &b.header.Coinbase)
+		blockContext = NewEVMBlockContext(b.header, chain, &b.header.Coinbase)
 		evm          = vm.NewEVM(blockContext, b.statedb, b.cm.config, vmConfig)
 	)
 	b.statedb.SetTxContext(tx.Hash(), len(b.txs))
@@ -143,8 +147,8 @@ func (b *BlockGen) addTx(bc *BlockChain, vmConfig vm.Config, tx *types.Transacti
 // instruction will panic during execution if it attempts to access a block number outside
 // of the range created by GenerateChain.
 func (b *BlockGen) AddTx(tx *types.Transaction) {
-	// Wrap the chain config in an empty BlockChain object to satisfy ChainContext.
-	bc := &BlockChain{chainConfig: b.cm.config}
+	// Wrap the chain config and engine in an empty BlockChain object to satisfy ChainContext.
+	bc := &BlockChain{chainConfig: b.cm.config, engine: b.cm.engine}
 	b.addTx(bc, vm.Config{}, tx)
 }
 
@@ -389,6 +393,7 @@ func GenerateChain(config *params.ChainConfig, parent *types.Block, engine conse
 		if config.DAOForkSupport && config.DAOForkBlock != nil && config.DAOForkBlock.Cmp(b.header.Number) == 0 {
 			misc.ApplyDAOHardFork(statedb)
 		}
+		misc.ApplyPixelzxHardForks(config.Pixelzx, b.header.Number, statedb)
 
 		if config.IsPrague(b.header.Number, b.header.Time) || config.IsVerkle(b.header.Number, b.header.Time) {
 			// EIP-2935
@@ -613,6 +618,11 @@ func (cm *chainMaker) makeHeader(parent *types.Block, state *state.StateDB, engi
 			header.GasLimit = CalcGasLimit(parentGasLimit, parentGasLimit)
 		}
 	}
+	if limiter, ok := engine.(consensus.GasLimiter); ok {
+		if target, ok := limiter.GasLimitTarget(header, state); ok {
+			header.GasLimit = CalcGasLimit(parent.GasLimit(), target)
+		}
+	}
 	if cm.config.IsCancun(header.Number, header.Time) {
 		excessBlobGas := eip4844.CalcExcessBlobGas(cm.config, parentHeader, time)
 		header.ExcessBlobGas = &excessBlobGas
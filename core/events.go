@@ -33,3 +33,10 @@ type ChainEvent struct {
 type ChainHeadEvent struct {
 	Header *types.Header
 }
+
+// ChainSideEvent is posted when a block is stored that is not part of the
+// canonical chain, either because it was inserted without becoming the head or
+// because a reorg dropped it.
+type ChainSideEvent struct {
+	Header *types.Header
+}
@@ -57,13 +57,19 @@ func NewEVMBlockContext(header *types.Header, chain ChainContext, author *common
 	} else {
 		beneficiary = *author
 	}
+	// Engines distributing the fees themselves collect them into a pool first
+	if chain != nil {
+		if collector, ok := chain.Engine().(consensus.FeeCollector); ok {
+			beneficiary = collector.FeeRecipient(header)
+		}
+	}
 	if header.BaseFee != nil {
 		baseFee = new(big.Int).Set(header.BaseFee)
 	}
 	if header.ExcessBlobGas != nil {
 		blobBaseFee = eip4844.CalcBlobFee(chain.Config(), header)
 	}
-	if header.Difficulty.Sign() == 0 {
+	if header.Difficulty.Sign() == 0 || (chain != nil && chain.Config().Pixelzx != nil) {
 		random = &header.MixDigest
 	}
 	return vm.BlockContext{
@@ -268,6 +268,16 @@ func gatherForks(config *params.ChainConfig, genesis uint64) ([]uint64, []uint64
 			}
 		}
 	}
+	// Changes of the PIXELZX block reward alter the state transition like forks,
+	// along with the PIXELZX hard-forks
+	if config.Pixelzx != nil {
+		for _, entry := range config.Pixelzx.RewardSchedule {
+			forksByBlock = append(forksByBlock, entry.Block.Uint64())
+		}
+		if config.Pixelzx.GovernanceBlock != nil {
+			forksByBlock = append(forksByBlock, config.Pixelzx.GovernanceBlock.Uint64())
+		}
+	}
 	slices.Sort(forksByBlock)
 	slices.Sort(forksByTime)
 
@@ -38,6 +38,13 @@ func TestCreation(t *testing.T) {
 		time uint64
 		want ID
 	}
+	// PIXELZX testnet scheduling governance in a hard-fork
+	governedTestnet := *params.PixelzxTestnetChainConfig
+	governedPixelzx := *governedTestnet.Pixelzx
+	governedPixelzx.VotingPeriod = governedPixelzx.UnbondingPeriod
+	governedPixelzx.GovernanceBlock = big.NewInt(1_000_000)
+	governedTestnet.Pixelzx = &governedPixelzx
+
 	tests := []struct {
 		config  *params.ChainConfig
 		genesis *types.Block
@@ -125,6 +132,36 @@ func TestCreation(t *testing.T) {
 				{123, 2740434112, ID{Hash: checksumToBytes(0x0929e24e), Next: 0}},          // Future Prague block
 			},
 		},
+		// PIXELZX mainnet test cases
+		{
+			params.PixelzxMainnetChainConfig,
+			core.DefaultPixelzxGenesisBlock().ToBlock(),
+			[]testcase{
+				{0, 0, ID{Hash: checksumToBytes(0x817c56ca), Next: 10512000}},                 // Unsynced, all Ethereum forks at genesis
+				{10511999, 1767222596, ID{Hash: checksumToBytes(0x817c56ca), Next: 10512000}}, // Last block of the initial reward
+				{10512000, 1767222597, ID{Hash: checksumToBytes(0x39401ee2), Next: 21024000}}, // First block of the second reward
+				{21024000, 1798758597, ID{Hash: checksumToBytes(0x862d89db), Next: 0}},        // First block of the final reward
+			},
+		},
+		// PIXELZX testnet test cases
+		{
+			params.PixelzxTestnetChainConfig,
+			core.DefaultPixelzxTestnetGenesisBlock().ToBlock(),
+			[]testcase{
+				{0, 0, ID{Hash: checksumToBytes(0x64ca8a40), Next: 0}},            // Unsynced, all Ethereum forks at genesis
+				{123, 1735690000, ID{Hash: checksumToBytes(0x64ca8a40), Next: 0}}, // Synced
+			},
+		},
+		// PIXELZX testnet with a governance hard-fork
+		{
+			&governedTestnet,
+			core.DefaultPixelzxTestnetGenesisBlock().ToBlock(),
+			[]testcase{
+				{0, 0, ID{Hash: checksumToBytes(0x64ca8a40), Next: 1000000}},               // Unsynced, all Ethereum forks at genesis
+				{999999, 1738689997, ID{Hash: checksumToBytes(0x64ca8a40), Next: 1000000}}, // Last block before governance
+				{1000000, 1738690000, ID{Hash: checksumToBytes(0x03d76f4d), Next: 0}},      // First governed block
+			},
+		},
 	}
 	for i, tt := range tests {
 		for j, ttt := range tt.cases {
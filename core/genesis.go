@@ -223,6 +223,10 @@ func getGenesisState(db ethdb.Database, blockhash common.Hash) (alloc types.Gene
 		genesis = DefaultHoleskyGenesisBlock()
 	case params.HoodiGenesisHash:
 		genesis = DefaultHoodiGenesisBlock()
+	case params.PixelzxMainnetGenesisHash:
+		genesis = DefaultPixelzxGenesisBlock()
+	case params.PixelzxTestnetGenesisHash:
+		genesis = DefaultPixelzxTestnetGenesisBlock()
 	}
 	if genesis != nil {
 		return genesis.Alloc, nil
@@ -438,6 +442,10 @@ func (g *Genesis) chainConfigOrDefault(ghash common.Hash, stored *params.ChainCo
 		return params.SepoliaChainConfig
 	case ghash == params.HoodiGenesisHash:
 		return params.HoodiChainConfig
+	case ghash == params.PixelzxMainnetGenesisHash:
+		return params.PixelzxMainnetChainConfig
+	case ghash == params.PixelzxTestnetGenesisHash:
+		return params.PixelzxTestnetChainConfig
 	default:
 		return stored
 	}
@@ -538,6 +546,9 @@ func (g *Genesis) Commit(db ethdb.Database, triedb *triedb.Database) (*types.Blo
 	if config.Clique != nil && len(g.ExtraData) < 32+crypto.SignatureLength {
 		return nil, errors.New("can't start clique chain without signers")
 	}
+	if config.Pixelzx != nil && len(g.ExtraData) < 32+crypto.SignatureLength {
+		return nil, errors.New("can't start pixelzx chain without validators")
+	}
 	// flush the data to disk and compute the state root
 	root, err := flushAlloc(&g.Alloc, triedb)
 	if err != nil {
@@ -650,6 +661,41 @@ func DefaultHoodiGenesisBlock() *Genesis {
 	}
 }
 
+// DefaultPixelzxGenesisBlock returns the PIXELZX main network genesis block.
+//
+// The staking contract allocated at genesis registers a single bootstrap
+// validator, 0x7A851825151495bA57b33Fb79fbfc336D021ac07, bonding the minimum
+// validator stake, which seals the first epoch alone until the validators
+// staking through the contract are elected. It is committed to by the genesis
+// hash: networks bootstrapped by other validators are launched from a genesis
+// file of their own, see pixelzx.GenesisAlloc and pixelzx.GenesisExtraData.
+func DefaultPixelzxGenesisBlock() *Genesis {
+	return &Genesis{
+		Config:     params.PixelzxMainnetChainConfig,
+		ExtraData:  hexutil.MustDecode("0x504958454c5a58206d61696e6e657400000000000000000000000000000000007a851825151495ba57b33fb79fbfc336d021ac07000000003b9aca000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+		GasLimit:   60_000_000,
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+		Difficulty: big.NewInt(0),
+		Timestamp:  1735689600,
+		Alloc:      decodePrealloc(pixelzxMainnetAllocData),
+	}
+}
+
+// DefaultPixelzxTestnetGenesisBlock returns the PIXELZX test network genesis block,
+// bootstrapped like the main network by a single validator,
+// 0x14E6A98Db4D1BF675778B36579b1363CC9ADc5dE.
+func DefaultPixelzxTestnetGenesisBlock() *Genesis {
+	return &Genesis{
+		Config:     params.PixelzxTestnetChainConfig,
+		ExtraData:  hexutil.MustDecode("0x504958454c5a5820746573746e6574000000000000000000000000000000000014e6a98db4d1bf675778b36579b1363cc9adc5de00000000000186a00000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000000"),
+		GasLimit:   60_000_000,
+		BaseFee:    big.NewInt(params.InitialBaseFee),
+		Difficulty: big.NewInt(0),
+		Timestamp:  1735689600,
+		Alloc:      decodePrealloc(pixelzxTestnetAllocData),
+	}
+}
+
 // DeveloperGenesisBlock returns the 'geth --dev' genesis block.
 func DeveloperGenesisBlock(gasLimit uint64, faucet *common.Address) *Genesis {
 	// Override the default period to the user requested one
@@ -188,6 +188,8 @@ func TestGenesisHashes(t *testing.T) {
 		{DefaultSepoliaGenesisBlock(), params.SepoliaGenesisHash},
 		{DefaultHoleskyGenesisBlock(), params.HoleskyGenesisHash},
 		{DefaultHoodiGenesisBlock(), params.HoodiGenesisHash},
+		{DefaultPixelzxGenesisBlock(), params.PixelzxMainnetGenesisHash},
+		{DefaultPixelzxTestnetGenesisBlock(), params.PixelzxTestnetGenesisHash},
 	} {
 		// Test via MustCommit
 		db := rawdb.NewMemoryDatabase()
@@ -85,6 +85,26 @@ func (frdb *freezerdb) Freeze() error {
 	return nil
 }
 
+// Checkpoint writes a point-in-time copy of the database into the given
+// directory: a checkpoint of the key-value store, and a copy of the ancient store
+// in its ancient folder, mirroring the default layout. The key-value store is
+// checkpointed first, so every item migrated out of it is in the ancient copy.
+// Items appended to the ancient store during the copy may be copied partially;
+// the freezer repairs them when the copy is opened.
+func (frdb *freezerdb) Checkpoint(dir string) error {
+	checkpointer, ok := frdb.KeyValueStore.(ethdb.Checkpointer)
+	if !ok {
+		return errNotSupported
+	}
+	if err := checkpointer.Checkpoint(dir); err != nil {
+		return err
+	}
+	if err := frdb.SyncAncient(); err != nil {
+		return err
+	}
+	return copyDir(frdb.ancientRoot, filepath.Join(dir, "ancient"))
+}
+
 // nofreezedb is a database wrapper that disables freezer data retrievals.
 type nofreezedb struct {
 	ethdb.KeyValueStore
@@ -156,6 +176,16 @@ func (db *nofreezedb) AncientDatadir() (string, error) {
 	return "", errNotSupported
 }
 
+// Checkpoint writes a point-in-time copy of the key-value store into the given
+// directory.
+func (db *nofreezedb) Checkpoint(dir string) error {
+	checkpointer, ok := db.KeyValueStore.(ethdb.Checkpointer)
+	if !ok {
+		return errNotSupported
+	}
+	return checkpointer.Checkpoint(dir)
+}
+
 // NewDatabase creates a high level database on top of a given key-value data
 // store without a freezer moving immutable chain segments into cold storage.
 func NewDatabase(db ethdb.KeyValueStore) ethdb.Database {
@@ -416,6 +446,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		preimages          stat
 		beaconHeaders      stat
 		cliqueSnaps        stat
+		pixelzxSnaps       stat
 		bloomBits          stat
 		filterMapRows      stat
 		filterMapLastBlock stat
@@ -501,6 +532,8 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 				beaconHeaders.add(size)
 			case bytes.HasPrefix(key, CliqueSnapshotPrefix) && len(key) == 7+common.HashLength:
 				cliqueSnaps.add(size)
+			case bytes.HasPrefix(key, PixelzxSnapshotPrefix) && len(key) == len(PixelzxSnapshotPrefix)+common.HashLength:
+				pixelzxSnaps.add(size)
 
 			// new log index
 			case bytes.HasPrefix(key, filterMapRowPrefix) && len(key) <= len(filterMapRowPrefix)+9:
@@ -623,6 +656,7 @@ func InspectDatabase(db ethdb.Database, keyPrefix, keyStart []byte) error {
 		{"Key-Value store", "Storage snapshot", storageSnaps.sizeString(), storageSnaps.countString()},
 		{"Key-Value store", "Beacon sync headers", beaconHeaders.sizeString(), beaconHeaders.countString()},
 		{"Key-Value store", "Clique snapshots", cliqueSnaps.sizeString(), cliqueSnaps.countString()},
+		{"Key-Value store", "PIXELZX snapshots", pixelzxSnaps.sizeString(), pixelzxSnaps.countString()},
 		{"Key-Value store", "Singleton metadata", metadata.sizeString(), metadata.countString()},
 	}
 
@@ -18,6 +18,7 @@ package rawdb
 
 import (
 	"io"
+	"io/fs"
 	"os"
 	"path/filepath"
 )
@@ -76,6 +77,25 @@ func copyFrom(srcPath, destPath string, offset uint64, before func(f *os.File) e
 	return os.Rename(fname, destPath)
 }
 
+// copyDir recursively copies the files of 'srcDir' into 'destDir', which is
+// created if it doesn't exist.
+func copyDir(srcDir, destDir string) error {
+	return filepath.WalkDir(srcDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(srcDir, path)
+		if err != nil {
+			return err
+		}
+		dest := filepath.Join(destDir, rel)
+		if d.IsDir() {
+			return os.MkdirAll(dest, 0755)
+		}
+		return copyFrom(path, dest, 0, nil)
+	})
+}
+
 // openFreezerFileForAppend opens a freezer table file and seeks to the end
 func openFreezerFileForAppend(filename string) (*os.File, error) {
 	// Open the file without the O_APPEND flag
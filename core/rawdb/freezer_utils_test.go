@@ -19,6 +19,7 @@ package rawdb
 import (
 	"bytes"
 	"os"
+	"path/filepath"
 	"testing"
 )
 
@@ -73,3 +74,32 @@ func TestCopyFrom(t *testing.T) {
 		os.Remove(c.dest)
 	}
 }
+
+func TestCopyDir(t *testing.T) {
+	var (
+		src  = t.TempDir()
+		dest = filepath.Join(t.TempDir(), "copy")
+	)
+	files := map[string][]byte{
+		"FLOCK":                          nil,
+		filepath.Join("chain", "a.cdat"): {0x1, 0x2, 0x3},
+		filepath.Join("chain", "a.cidx"): {0x4, 0x5},
+		filepath.Join("state", "b.meta"): {0x6},
+	}
+	for name, content := range files {
+		os.MkdirAll(filepath.Join(src, filepath.Dir(name)), 0755)
+		os.WriteFile(filepath.Join(src, name), content, 0600)
+	}
+	if err := copyDir(src, dest); err != nil {
+		t.Fatalf("Failed to copy %v", err)
+	}
+	for name, content := range files {
+		blob, err := os.ReadFile(filepath.Join(dest, name))
+		if err != nil {
+			t.Fatalf("Failed to read %s: %v", name, err)
+		}
+		if !bytes.Equal(blob, content) {
+			t.Fatalf("Unexpected value of %s: have %x, want %x", name, blob, content)
+		}
+	}
+}
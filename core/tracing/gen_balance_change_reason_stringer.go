@@ -24,11 +24,21 @@ func _() {
 	_ = x[BalanceDecreaseSelfdestruct-13]
 	_ = x[BalanceDecreaseSelfdestructBurn-14]
 	_ = x[BalanceChangeRevert-15]
+	_ = x[BalanceIncreasePixelzxBlockReward-16]
+	_ = x[BalanceDecreasePixelzxRewardPool-17]
+	_ = x[BalanceIncreasePixelzxProposerReward-18]
+	_ = x[BalanceIncreasePixelzxDelegatorReward-19]
+	_ = x[BalanceIncreasePixelzxTreasuryReward-20]
+	_ = x[BalanceDecreasePixelzxSlash-21]
+	_ = x[BalanceIncreasePixelzxSlashedStake-22]
+	_ = x[BalanceDecreasePixelzxSlashBurn-23]
+	_ = x[BalanceDecreasePixelzxUnbonding-24]
+	_ = x[BalanceIncreasePixelzxUnbonding-25]
 }
 
-const _BalanceChangeReason_name = "UnspecifiedBalanceIncreaseRewardMineUncleBalanceIncreaseRewardMineBlockBalanceIncreaseWithdrawalBalanceIncreaseGenesisBalanceBalanceIncreaseRewardTransactionFeeBalanceDecreaseGasBuyBalanceIncreaseGasReturnBalanceIncreaseDaoContractBalanceDecreaseDaoAccountTransferTouchAccountBalanceIncreaseSelfdestructBalanceDecreaseSelfdestructBalanceDecreaseSelfdestructBurnRevert"
+const _BalanceChangeReason_name = "UnspecifiedBalanceIncreaseRewardMineUncleBalanceIncreaseRewardMineBlockBalanceIncreaseWithdrawalBalanceIncreaseGenesisBalanceBalanceIncreaseRewardTransactionFeeBalanceDecreaseGasBuyBalanceIncreaseGasReturnBalanceIncreaseDaoContractBalanceDecreaseDaoAccountTransferTouchAccountBalanceIncreaseSelfdestructBalanceDecreaseSelfdestructBalanceDecreaseSelfdestructBurnRevertBalanceIncreasePixelzxBlockRewardBalanceDecreasePixelzxRewardPoolBalanceIncreasePixelzxProposerRewardBalanceIncreasePixelzxDelegatorRewardBalanceIncreasePixelzxTreasuryRewardBalanceDecreasePixelzxSlashBalanceIncreasePixelzxSlashedStakeBalanceDecreasePixelzxSlashBurnBalanceDecreasePixelzxUnbondingBalanceIncreasePixelzxUnbonding"
 
-var _BalanceChangeReason_index = [...]uint16{0, 11, 41, 71, 96, 125, 160, 181, 205, 231, 256, 264, 276, 303, 330, 361, 367}
+var _BalanceChangeReason_index = [...]uint16{0, 11, 41, 71, 96, 125, 160, 181, 205, 231, 256, 264, 276, 303, 330, 361, 367, 400, 432, 468, 505, 541, 568, 602, 633, 664, 695}
 
 func (i BalanceChangeReason) String() string {
 	if i >= BalanceChangeReason(len(_BalanceChangeReason_index)-1) {
@@ -270,6 +270,37 @@ const (
 	// BalanceChangeRevert is emitted when the balance is reverted back to a previous value due to call failure.
 	// It is only emitted when the tracer has opted in to use the journaling wrapper (WrapWithJournal).
 	BalanceChangeRevert BalanceChangeReason = 15
+
+	// PIXELZX proof-of-stake
+	// BalanceIncreasePixelzxBlockReward is ether issued for a block by the PIXELZX engine.
+	// It is credited to the reward pool, and is the only issuance of the engine.
+	BalanceIncreasePixelzxBlockReward BalanceChangeReason = 16
+	// BalanceDecreasePixelzxRewardPool is ether (block reward and transaction tips) taken
+	// from the reward pool at the end of a block to be distributed.
+	BalanceDecreasePixelzxRewardPool BalanceChangeReason = 17
+	// BalanceIncreasePixelzxProposerReward is the share of the block rewards paid to
+	// the block proposer, including its commission.
+	BalanceIncreasePixelzxProposerReward BalanceChangeReason = 18
+	// BalanceIncreasePixelzxDelegatorReward is the share of the block rewards moved to
+	// the staking contract, for the delegators of the proposer to claim.
+	BalanceIncreasePixelzxDelegatorReward BalanceChangeReason = 19
+	// BalanceIncreasePixelzxTreasuryReward is the share of the block rewards paid to
+	// the community treasury.
+	BalanceIncreasePixelzxTreasuryReward BalanceChangeReason = 20
+	// BalanceDecreasePixelzxSlash is stake taken from the staking contract when a
+	// validator is slashed, to be paid to the community treasury.
+	BalanceDecreasePixelzxSlash BalanceChangeReason = 21
+	// BalanceIncreasePixelzxSlashedStake is slashed stake paid to the community treasury.
+	BalanceIncreasePixelzxSlashedStake BalanceChangeReason = 22
+	// BalanceDecreasePixelzxSlashBurn is slashed stake burnt from the staking contract,
+	// when no community treasury is configured to receive it.
+	BalanceDecreasePixelzxSlashBurn BalanceChangeReason = 23
+	// BalanceDecreasePixelzxUnbonding is matured unbonding stake released from the
+	// staking contract at an epoch boundary.
+	BalanceDecreasePixelzxUnbonding BalanceChangeReason = 24
+	// BalanceIncreasePixelzxUnbonding is matured unbonding stake paid back to its
+	// delegator at an epoch boundary.
+	BalanceIncreasePixelzxUnbonding BalanceChangeReason = 25
 )
 
 // GasChangeReason is used to indicate the reason for a gas change, useful
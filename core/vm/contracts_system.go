@@ -0,0 +1,117 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package vm
+
+import (
+	"errors"
+	"maps"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/tracing"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+)
+
+// ErrSystemContractContext is returned if a stateful system contract is invoked
+// through CALLCODE or DELEGATECALL, where it would operate on the caller's storage.
+var ErrSystemContractContext = errors.New("system contract must be called directly")
+
+// StatefulPrecompiledContract is a native Go contract which, unlike the plain
+// precompiles, has access to the EVM it is executed in. It is used to implement
+// the system contracts of consensus engines that keep their state on chain.
+//
+// The contract's storage lives in the state of the account it is registered at,
+// so the account must exist (e.g. pre-allocated in genesis with stub code).
+type StatefulPrecompiledContract interface {
+	PrecompiledContract
+
+	// RunStateful executes the contract on behalf of caller, which has already
+	// transferred value to the contract's account. If readOnly is set, the call
+	// must not modify the state.
+	RunStateful(evm *EVM, caller common.Address, input []byte, value *uint256.Int, readOnly bool) ([]byte, error)
+}
+
+// StatefulGasContract is implemented by the stateful system contracts whose cost
+// depends on the state, such as the methods returning lists stored on chain.
+type StatefulGasContract interface {
+	// RequiredStatefulGas returns the gas the call costs on top of RequiredGas.
+	RequiredStatefulGas(evm *EVM, input []byte) uint64
+}
+
+// SystemContractsFn returns the stateful system contracts enabled on a chain at
+// the given set of rules, or nil if the chain does not use any.
+type SystemContractsFn func(config *params.ChainConfig, rules params.Rules) PrecompiledContracts
+
+var (
+	systemContractsLock sync.RWMutex
+	systemContractsFns  []SystemContractsFn
+)
+
+// RegisterSystemContracts adds a source of system contracts which is consulted
+// whenever a new EVM is created. It is meant to be called from the init method
+// of the packages implementing the contracts.
+func RegisterSystemContracts(fn SystemContractsFn) {
+	systemContractsLock.Lock()
+	defer systemContractsLock.Unlock()
+
+	systemContractsFns = append(systemContractsFns, fn)
+}
+
+// activeSystemContracts gathers the system contracts enabled with the current
+// configuration from all registered sources.
+func activeSystemContracts(config *params.ChainConfig, rules params.Rules) PrecompiledContracts {
+	systemContractsLock.RLock()
+	defer systemContractsLock.RUnlock()
+
+	var contracts PrecompiledContracts
+	for _, fn := range systemContractsFns {
+		if active := fn(config, rules); len(active) > 0 {
+			if contracts == nil {
+				contracts = make(PrecompiledContracts)
+			}
+			maps.Copy(contracts, active)
+		}
+	}
+	return contracts
+}
+
+// runPrecompiledContract runs a precompiled contract, handing the stateful ones
+// the execution context of the call.
+func (evm *EVM) runPrecompiledContract(p PrecompiledContract, caller common.Address, input []byte, suppliedGas uint64, value *uint256.Int, readOnly bool) (ret []byte, remainingGas uint64, err error) {
+	sp, ok := p.(StatefulPrecompiledContract)
+	if !ok {
+		return RunPrecompiledContract(p, input, suppliedGas, evm.Config.Tracer)
+	}
+	gasCost := sp.RequiredGas(input)
+	if gp, ok := sp.(StatefulGasContract); ok {
+		extra := gp.RequiredStatefulGas(evm, input)
+		if gasCost+extra < gasCost {
+			return nil, 0, ErrGasUintOverflow
+		}
+		gasCost += extra
+	}
+	if suppliedGas < gasCost {
+		return nil, 0, ErrOutOfGas
+	}
+	if logger := evm.Config.Tracer; logger != nil && logger.OnGasChange != nil {
+		logger.OnGasChange(suppliedGas, suppliedGas-gasCost, tracing.GasChangeCallPrecompiledContract)
+	}
+	suppliedGas -= gasCost
+	output, err := sp.RunStateful(evm, caller, input, value, readOnly || evm.readOnly)
+	return output, suppliedGas, err
+}
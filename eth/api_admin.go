@@ -26,6 +26,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/rlp"
 )
 
@@ -79,6 +80,30 @@ func (api *AdminAPI) ExportChain(file string, first *uint64, last *uint64) (bool
 	return true, nil
 }
 
+// CheckpointDatabase writes a consistent point-in-time copy of the chain
+// database into the given local directory, which must not exist yet, while the
+// node keeps running.
+func (api *AdminAPI) CheckpointDatabase(dir string) (bool, error) {
+	if _, err := os.Stat(dir); err == nil {
+		// Same as with chain exports, refuse to overwrite anything on the drive.
+		return false, errors.New("location would overwrite an existing directory")
+	}
+	checkpointer, ok := api.eth.ChainDb().(ethdb.Checkpointer)
+	if !ok {
+		return false, errors.New("chain database does not support checkpoints")
+	}
+	// Flush the state of the head block, kept in memory otherwise, so that the
+	// checkpoint holds the state of (close to) its head block.
+	chain := api.eth.BlockChain()
+	if err := chain.TrieDB().Commit(chain.CurrentBlock().Root, false); err != nil {
+		return false, err
+	}
+	if err := checkpointer.Checkpoint(dir); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
 func hasAllBlocks(chain *core.BlockChain, bs []*types.Block) bool {
 	for _, b := range bs {
 		if !chain.HasBlock(b.Hash(), b.NumberU64()) {
@@ -40,14 +40,16 @@ func (api *MinerAPI) SetExtra(extra string) (bool, error) {
 	return true, nil
 }
 
-// SetGasPrice sets the minimum accepted gas price for the miner.
+// SetGasPrice sets the minimum accepted gas price for the miner. On networks
+// governed on chain, it is raised to the governed one if lower.
 func (api *MinerAPI) SetGasPrice(gasPrice hexutil.Big) bool {
 	api.e.lock.Lock()
 	api.e.gasPrice = (*big.Int)(&gasPrice)
+	tip := api.e.gasTip()
 	api.e.lock.Unlock()
 
-	api.e.txPool.SetGasTip((*big.Int)(&gasPrice))
-	api.e.Miner().SetGasTip((*big.Int)(&gasPrice))
+	api.e.txPool.SetGasTip(tip)
+	api.e.Miner().SetGasTip(tip)
 	return true
 }
 
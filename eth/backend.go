@@ -31,6 +31,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/filtermaps"
 	"github.com/ethereum/go-ethereum/core/rawdb"
@@ -45,6 +46,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/gasprice"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/eth/protocols/pzx"
 	"github.com/ethereum/go-ethereum/eth/protocols/snap"
 	"github.com/ethereum/go-ethereum/eth/tracers"
 	"github.com/ethereum/go-ethereum/ethdb"
@@ -114,8 +116,18 @@ type Ethereum struct {
 	APIBackend *EthAPIBackend
 
 	miner    *miner.Miner
+	sealer   *miner.Sealer // In-process block production loop of PIXELZX validators, nil until sealing is started
 	gasPrice *big.Int
 
+	governedGasPrice *big.Int           // Minimum gas price set by PIXELZX governance, nil if none
+	governanceSub    event.Subscription // Chain head subscription following governance, nil on ungoverned networks
+
+	evidence        *pixelzx.Detector  // Double sign detector of PIXELZX validators, nil on other networks
+	evidenceSub     event.Subscription // Side chain subscription feeding the detector, nil on other networks
+	evidenceAccount common.Address     // Keystore account submitting double sign evidence, zero if none
+	finality        *pixelzx.Finality  // Vote based finality gadget of PIXELZX networks, nil on other networks
+	finalitySub     event.Subscription // Chain head subscription feeding the finality gadget, nil on other networks
+
 	networkID     uint64
 	netRPCService *ethapi.NetAPI
 
@@ -317,6 +329,9 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 
 	// Permit the downloader to use the trie cache allowance during fast sync
 	cacheLimit := options.TrieCleanLimit + options.TrieDirtyLimit + options.SnapshotLimit
+	if engine, ok := eth.engine.(*pixelzx.Pixelzx); ok {
+		eth.finality = pixelzx.NewFinality(engine, eth.blockchain)
+	}
 	if eth.handler, err = newHandler(&handlerConfig{
 		NodeID:         eth.p2pServer.Self().ID(),
 		Database:       chainDb,
@@ -327,12 +342,16 @@ func New(stack *node.Node, config *ethconfig.Config) (*Ethereum, error) {
 		BloomCache:     uint64(cacheLimit),
 		EventMux:       eth.eventMux,
 		RequiredBlocks: config.RequiredBlocks,
+		Finality:       eth.finality,
 	}); err != nil {
 		return nil, err
 	}
 
 	eth.dropper = newDropper(eth.p2pServer.MaxDialedConns(), eth.p2pServer.MaxInboundConns())
 
+	if engine, ok := eth.engine.(*pixelzx.Pixelzx); ok {
+		eth.evidence = pixelzx.NewDetector(engine, eth.blockchain, eth.submitEvidence)
+	}
 	eth.miner = miner.New(eth, config.Miner, eth.engine)
 	eth.miner.SetExtra(makeExtraData(config.Miner.ExtraData))
 	eth.miner.SetPrioAddresses(config.TxPool.Locals)
@@ -379,6 +398,13 @@ func makeExtraData(extra []byte) []byte {
 func (s *Ethereum) APIs() []rpc.API {
 	apis := ethapi.GetAPIs(s.APIBackend)
 
+	// Expose the validator details of PIXELZX networks
+	if engine, ok := s.engine.(*pixelzx.Pixelzx); ok {
+		apis = append(apis, rpc.API{
+			Namespace: "pixelzx",
+			Service:   pixelzx.NewAPI(engine, s.blockchain),
+		})
+	}
 	// Append all the local APIs and return
 	return append(apis, []rpc.API{
 		{
@@ -425,6 +451,9 @@ func (s *Ethereum) Protocols() []p2p.Protocol {
 	if s.config.SnapshotCache > 0 {
 		protos = append(protos, snap.MakeProtocols((*snapHandler)(s.handler))...)
 	}
+	if s.finality != nil {
+		protos = append(protos, pzx.MakeProtocols((*pzxHandler)(s.handler))...)
+	}
 	return protos
 }
 
@@ -444,6 +473,12 @@ func (s *Ethereum) Start() error {
 	// Start the connection manager
 	s.dropper.Start(s.p2pServer, func() bool { return !s.Synced() })
 
+	// Start watching for double signing validators
+	s.startEvidence()
+	// Start attesting blocks and tracking finality
+	s.startFinality()
+	// Start following the gas price set by governance
+	s.startGovernance()
 	// start log indexer
 	s.filterMaps.Start()
 	go s.updateFilterMapsHeads()
@@ -560,12 +595,30 @@ func (s *Ethereum) setupDiscovery() error {
 // Stop implements node.Lifecycle, terminating all internal goroutines used by the
 // Ethereum protocol.
 func (s *Ethereum) Stop() error {
+	// Stop producing blocks before tearing down the networking layer
+	s.StopSealing()
+
 	// Stop all the peer-related stuff first.
 	s.discmix.Close()
 	s.dropper.Stop()
 	s.handler.Stop()
 
 	// Then stop everything else.
+	if s.evidenceSub != nil {
+		s.evidenceSub.Unsubscribe()
+	}
+	if s.evidence != nil {
+		s.evidence.Stop()
+	}
+	if s.finalitySub != nil {
+		s.finalitySub.Unsubscribe()
+	}
+	if s.finality != nil {
+		s.finality.Stop()
+	}
+	if s.governanceSub != nil {
+		s.governanceSub.Unsubscribe()
+	}
 	ch := make(chan struct{})
 	s.closeFilterMaps <- ch
 	<-ch
@@ -0,0 +1,130 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/ethereum/go-ethereum/rlp"
+)
+
+// errNoEvidenceSubmitter is returned if double sign evidence is detected, but the
+// node has no account to submit it with.
+var errNoEvidenceSubmitter = errors.New("no account to submit evidence with")
+
+// startEvidence starts the PIXELZX double sign detector, feeding it the blocks
+// dropped from, or not making it into, the canonical chain.
+func (s *Ethereum) startEvidence() {
+	if s.evidence == nil {
+		return
+	}
+	s.evidence.Start()
+
+	sides := make(chan core.ChainSideEvent, 64)
+	sub := s.blockchain.SubscribeChainSideEvent(sides)
+	s.evidenceSub = sub
+
+	go func() {
+		for {
+			select {
+			case ev := <-sides:
+				s.evidence.CheckSide(ev.Header)
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+}
+
+// SetEvidenceAccount sets the keystore account double sign evidence is submitted
+// with. Consensus keys never sign transactions, so the evidence is sent and paid
+// for by an account of its own.
+func (s *Ethereum) SetEvidenceAccount(account common.Address) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	s.evidenceAccount = account
+}
+
+// submitEvidence sends double sign evidence found by the PIXELZX detector to the
+// chain, in a system transaction signed by the evidence account. The transaction
+// pool gossips it to the rest of the network like any other transaction.
+func (s *Ethereum) submitEvidence(evidence *pixelzx.DoubleSignEvidence) error {
+	s.lock.RLock()
+	signer := s.evidenceAccount
+	s.lock.RUnlock()
+
+	if signer == (common.Address{}) {
+		return errNoEvidenceSubmitter
+	}
+	account := accounts.Account{Address: signer}
+	wallet, err := s.accountManager.Find(account)
+	if err != nil {
+		return err
+	}
+	data, err := rlp.EncodeToBytes(evidence)
+	if err != nil {
+		return err
+	}
+	// Pay the intrinsic gas of the payload and the suggested tip on top of the
+	// next block's base fee
+	var (
+		config = s.blockchain.Config()
+		head   = s.blockchain.CurrentBlock()
+		rules  = config.Rules(head.Number, true, head.Time)
+	)
+	gas, err := core.IntrinsicGas(data, nil, nil, false, rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai)
+	if err != nil {
+		return err
+	}
+	if rules.IsPrague {
+		floor, err := core.FloorDataGas(data)
+		if err != nil {
+			return err
+		}
+		gas = max(gas, floor)
+	}
+	tip, err := s.APIBackend.SuggestGasTipCap(context.Background())
+	if err != nil {
+		return err
+	}
+	feeCap := new(big.Int).Mul(eip1559.CalcBaseFee(config, head), big.NewInt(2))
+	feeCap.Add(feeCap, tip)
+
+	tx, err := wallet.SignTx(account, types.NewTx(&types.DynamicFeeTx{
+		ChainID:   config.ChainID,
+		Nonce:     s.txPool.PoolNonce(signer),
+		GasTipCap: tip,
+		GasFeeCap: feeCap,
+		Gas:       gas,
+		To:        &params.PixelzxSlashingAddress,
+		Data:      data,
+	}), config.ChainID)
+	if err != nil {
+		return err
+	}
+	return s.txPool.Add([]*types.Transaction{tx}, false)[0]
+}
@@ -0,0 +1,43 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"github.com/ethereum/go-ethereum/core"
+)
+
+// startFinality makes the PIXELZX finality gadget attest the new chain heads and
+// finalize the blocks reaching their quorum.
+func (s *Ethereum) startFinality() {
+	if s.finality == nil {
+		return
+	}
+	heads := make(chan core.ChainHeadEvent, 16)
+	sub := s.blockchain.SubscribeChainHeadEvent(heads)
+	s.finalitySub = sub
+
+	go func() {
+		for {
+			select {
+			case ev := <-heads:
+				s.finality.NewHead(ev.Header)
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+}
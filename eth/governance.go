@@ -0,0 +1,86 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/governance"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// startGovernance makes the node follow the minimum gas price set by governance
+// on PIXELZX networks governed on chain. The price is a node policy rather than
+// a consensus rule: the transaction pool and the block producer refuse the
+// transactions tipping less than it, but blocks including them stay valid.
+func (s *Ethereum) startGovernance() {
+	config := s.blockchain.Config().Pixelzx
+	if config == nil || config.VotingPeriod == 0 {
+		return
+	}
+	heads := make(chan core.ChainHeadEvent, 10)
+	sub := s.blockchain.SubscribeChainHeadEvent(heads)
+	s.governanceSub = sub
+
+	s.updateGovernedGasPrice(s.blockchain.CurrentBlock())
+	go func() {
+		for {
+			select {
+			case ev := <-heads:
+				s.updateGovernedGasPrice(ev.Header)
+			case <-sub.Err():
+				return
+			}
+		}
+	}()
+}
+
+// updateGovernedGasPrice reads the minimum gas price governed for the block after
+// the given head, applying it if it changed.
+func (s *Ethereum) updateGovernedGasPrice(head *types.Header) {
+	statedb, err := s.blockchain.StateAt(head.Root)
+	if err != nil {
+		log.Debug("Failed to read governed gas price", "number", head.Number, "err", err)
+		return
+	}
+	price, _ := governance.Value(statedb, governance.MinGasPrice, head.Number.Uint64()+1)
+
+	s.lock.Lock()
+	if (price == nil) == (s.governedGasPrice == nil) && (price == nil || price.Cmp(s.governedGasPrice) == 0) {
+		s.lock.Unlock()
+		return
+	}
+	s.governedGasPrice = price
+	tip := s.gasTip()
+	s.lock.Unlock()
+
+	s.txPool.SetGasTip(tip)
+	s.miner.SetGasTip(tip)
+	log.Info("Updated minimum gas price from governance", "governed", price, "effective", tip)
+}
+
+// gasTip returns the minimum tip transactions are accepted and included with:
+// the configured gas price, or the governed one if higher. The caller must hold
+// the lock.
+func (s *Ethereum) gasTip() *big.Int {
+	if s.governedGasPrice != nil && s.governedGasPrice.Cmp(s.gasPrice) > 0 {
+		return new(big.Int).Set(s.governedGasPrice)
+	}
+	return new(big.Int).Set(s.gasPrice)
+}
@@ -29,6 +29,7 @@ import (
 
 	"github.com/dchest/siphash"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/core/rawdb"
 	"github.com/ethereum/go-ethereum/core/txpool"
@@ -37,6 +38,7 @@ import (
 	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/eth/fetcher"
 	"github.com/ethereum/go-ethereum/eth/protocols/eth"
+	"github.com/ethereum/go-ethereum/eth/protocols/pzx"
 	"github.com/ethereum/go-ethereum/eth/protocols/snap"
 	"github.com/ethereum/go-ethereum/ethdb"
 	"github.com/ethereum/go-ethereum/event"
@@ -106,6 +108,7 @@ type handlerConfig struct {
 	BloomCache     uint64                 // Megabytes to alloc for snap sync bloom
 	EventMux       *event.TypeMux         // Legacy event mux, deprecate for `feed`
 	RequiredBlocks map[uint64]common.Hash // Hard coded map of required block hashes for sync challenges
+	Finality       *pixelzx.Finality      // Finality gadget to gossip votes for, nil on non-PIXELZX networks
 }
 
 type handler struct {
@@ -130,6 +133,14 @@ type handler struct {
 	txsSub     event.Subscription
 	blockRange *blockRangeState
 
+	finality   *pixelzx.Finality
+	pzxPeers   *pzxPeerSet
+	votesCh    chan *pixelzx.Vote
+	votesSub   event.Subscription
+	pzxHeadCh  chan core.ChainHeadEvent
+	pzxHeadSub event.Subscription
+	pzxSyncCh  chan *pzx.Peer
+
 	requiredBlocks map[uint64]common.Hash
 
 	// channels for fetcher, syncer, txsyncLoop
@@ -157,6 +168,9 @@ func newHandler(config *handlerConfig) (*handler, error) {
 		peers:          newPeerSet(),
 		txBroadcastKey: newBroadcastChoiceKey(),
 		requiredBlocks: config.RequiredBlocks,
+		finality:       config.Finality,
+		pzxPeers:       newPzxPeerSet(),
+		pzxSyncCh:      make(chan *pzx.Peer, 1),
 		quitSync:       make(chan struct{}),
 		handlerDoneCh:  make(chan struct{}),
 		handlerStartCh: make(chan struct{}),
@@ -441,6 +455,21 @@ func (h *handler) Start(maxPeers int) {
 	h.blockRange = newBlockRangeState(h.chain, h.eventMux)
 	go h.blockRangeLoop(h.blockRange)
 
+	// broadcast finality votes
+	if h.finality != nil {
+		h.wg.Add(1)
+		h.votesCh = make(chan *pixelzx.Vote, voteChanSize)
+		h.votesSub = h.finality.SubscribeVotes(h.votesCh)
+		go h.voteBroadcastLoop()
+
+		// announce new heads and catch up with the ones of the peers
+		h.wg.Add(2)
+		h.pzxHeadCh = make(chan core.ChainHeadEvent, chainHeadChanSize)
+		h.pzxHeadSub = h.chain.SubscribeChainHeadEvent(h.pzxHeadCh)
+		go h.headAnnounceLoop()
+		go h.pzxSyncLoop()
+	}
+
 	// start sync handlers
 	h.txFetcher.Start()
 
@@ -452,6 +481,10 @@ func (h *handler) Start(maxPeers int) {
 func (h *handler) Stop() {
 	h.txsSub.Unsubscribe() // quits txBroadcastLoop
 	h.blockRange.stop()
+	if h.votesSub != nil {
+		h.votesSub.Unsubscribe()   // quits voteBroadcastLoop
+		h.pzxHeadSub.Unsubscribe() // quits headAnnounceLoop
+	}
 	h.txFetcher.Stop()
 	h.downloader.Terminate()
 
@@ -464,6 +497,7 @@ func (h *handler) Stop() {
 	// sessions which are already established but not added to h.peers yet
 	// will exit when they try to register.
 	h.peers.close()
+	h.pzxPeers.close()
 	h.wg.Wait()
 
 	log.Info("Ethereum protocol stopped")
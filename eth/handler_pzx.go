@@ -0,0 +1,291 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/eth/protocols/pzx"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+// voteChanSize is the size of channel listening to new finality votes.
+const voteChanSize = 256
+
+// pzxPeerSet is the set of peers connected on the `pzx` protocol. Unlike `snap`,
+// `pzx` runs independently of the `eth` peer set, the blocks and votes being
+// relevant to every connected node.
+type pzxPeerSet struct {
+	peers  map[string]*pzx.Peer
+	lock   sync.RWMutex
+	closed bool
+}
+
+// newPzxPeerSet creates a new `pzx` peer set.
+func newPzxPeerSet() *pzxPeerSet {
+	return &pzxPeerSet{peers: make(map[string]*pzx.Peer)}
+}
+
+// register injects a new `pzx` peer into the working set.
+func (ps *pzxPeerSet) register(peer *pzx.Peer) error {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	if ps.closed {
+		return errPeerSetClosed
+	}
+	if _, ok := ps.peers[peer.ID()]; ok {
+		return errPeerAlreadyRegistered
+	}
+	ps.peers[peer.ID()] = peer
+	return nil
+}
+
+// unregister removes a `pzx` peer from the working set.
+func (ps *pzxPeerSet) unregister(id string) {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	delete(ps.peers, id)
+}
+
+// peer retrieves the registered `pzx` peer with the given id.
+func (ps *pzxPeerSet) peer(id string) *pzx.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	return ps.peers[id]
+}
+
+// peersWithoutVote retrieves a list of peers that do not have the given vote in
+// their set of known votes.
+func (ps *pzxPeerSet) peersWithoutVote(vote *pixelzx.Vote) []*pzx.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	id := vote.ID()
+	list := make([]*pzx.Peer, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		if !p.KnownVote(id) {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+// peersWithoutBlock retrieves a list of peers that do not have the given block
+// in their set of known blocks.
+func (ps *pzxPeerSet) peersWithoutBlock(hash common.Hash) []*pzx.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	list := make([]*pzx.Peer, 0, len(ps.peers))
+	for _, p := range ps.peers {
+		if !p.KnownBlock(hash) {
+			list = append(list, p)
+		}
+	}
+	return list
+}
+
+// peerWithUnknownHead retrieves the peer with the highest announced head which
+// the local chain doesn't contain, or nil if the chain knows all of them.
+func (ps *pzxPeerSet) peerWithUnknownHead(chain *core.BlockChain) *pzx.Peer {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	var (
+		best   *pzx.Peer
+		bestNr uint64
+	)
+	for _, p := range ps.peers {
+		hash, number := p.Head()
+		if hash == (common.Hash{}) || chain.HasBlock(hash, number) {
+			continue
+		}
+		if best == nil || number > bestNr {
+			best, bestNr = p, number
+		}
+	}
+	return best
+}
+
+// len returns the number of `pzx` peers in the set.
+func (ps *pzxPeerSet) len() int {
+	ps.lock.RLock()
+	defer ps.lock.RUnlock()
+
+	return len(ps.peers)
+}
+
+// close disconnects all peers and prevents new registrations.
+func (ps *pzxPeerSet) close() {
+	ps.lock.Lock()
+	defer ps.lock.Unlock()
+
+	for _, p := range ps.peers {
+		p.Disconnect(p2p.DiscQuitting)
+	}
+	ps.closed = true
+}
+
+// pzxHandler implements the pzx.Backend interface to handle the blocks and the
+// finality votes propagated by PIXELZX nodes.
+type pzxHandler handler
+
+// Chain retrieves the blockchain object to serve data.
+func (h *pzxHandler) Chain() *core.BlockChain { return h.chain }
+
+// RunPeer is invoked when a peer joins on the `pzx` protocol.
+func (h *pzxHandler) RunPeer(peer *pzx.Peer, hand pzx.Handler) error {
+	if !(*handler)(h).incHandlers() {
+		return p2p.DiscQuitting
+	}
+	defer (*handler)(h).decHandlers()
+
+	if err := h.pzxPeers.register(peer); err != nil {
+		peer.Log().Debug("PIXELZX peer registration failed", "err", err)
+		return err
+	}
+	defer h.pzxPeers.unregister(peer.ID())
+
+	// Announce the local head, so the peer can catch up with it
+	head := h.chain.CurrentBlock()
+	peer.AsyncSendNewHead(head.Hash(), head.Number.Uint64())
+
+	// Catch the peer up on the votes of the blocks not yet finalized
+	if votes := h.finality.Pending(); len(votes) > 0 {
+		peer.AsyncSendVotes(votes)
+	}
+	return hand(peer)
+}
+
+// PeerInfo retrieves all known `pzx` information about a peer.
+func (h *pzxHandler) PeerInfo(id enode.ID) interface{} {
+	if p := h.pzxPeers.peer(id.String()); p != nil {
+		return struct {
+			Version uint `json:"version"`
+		}{p.Version()}
+	}
+	return nil
+}
+
+// Handle is invoked from a peer's message handler when it receives a new remote
+// message that the handler couldn't consume and serve itself.
+func (h *pzxHandler) Handle(peer *pzx.Peer, packet pzx.Packet) error {
+	switch packet := packet.(type) {
+	case *pzx.VotesPacket:
+		for _, vote := range *packet {
+			if err := h.finality.AddVote(vote); err != nil {
+				peer.Log().Trace("Discarded finality vote", "number", vote.Target.Number, "hash", vote.Target.Hash, "err", err)
+			}
+		}
+		return nil
+
+	case *pzx.NewBlockPacket:
+		return h.handleBlock(peer, packet.Block)
+
+	case *pzx.NewHeadPacket:
+		// Blocks right on top of the local head are propagated in full, only
+		// catch up if the peer is further ahead. Forks are caught up with by
+		// the periodic sync cycle.
+		if !h.chain.HasBlock(packet.Hash, packet.Number) && packet.Number > h.chain.CurrentBlock().Number.Uint64()+1 {
+			(*handler)(h).triggerPzxSync(peer)
+		}
+		return nil
+
+	default:
+		return fmt.Errorf("unexpected pzx packet type: %T", packet)
+	}
+}
+
+// handleBlock imports a block propagated by a peer and relays it to the peers
+// not knowing about it yet, if it became the new head. Blocks with an unknown
+// parent start a sync with the peer.
+func (h *pzxHandler) handleBlock(peer *pzx.Peer, block *types.Block) error {
+	number := block.NumberU64()
+	if h.chain.HasBlock(block.Hash(), number) {
+		return nil
+	}
+	if number == 0 || !h.chain.HasBlock(block.ParentHash(), number-1) {
+		peer.Log().Debug("Propagated block with unknown parent, syncing", "number", number, "hash", block.Hash())
+		if _, head := peer.Head(); number > head {
+			peer.SetHead(block.Hash(), number)
+		}
+		(*handler)(h).triggerPzxSync(peer)
+		return nil
+	}
+	if _, err := h.chain.InsertChain(types.Blocks{block}); err != nil {
+		peer.Log().Debug("Failed to import propagated block", "number", number, "hash", block.Hash(), "err", err)
+		return nil
+	}
+	if h.chain.CurrentBlock().Hash() != block.Hash() {
+		peer.Log().Debug("Propagated block lost the fork choice", "number", number, "hash", block.Hash())
+		return nil
+	}
+	(*handler)(h).broadcastBlock(block)
+	return nil
+}
+
+// broadcastBlock propagates a block to all the peers not knowing about it yet.
+// Validator networks are small, so the entire block is sent to each of them.
+func (h *handler) broadcastBlock(block *types.Block) {
+	for _, peer := range h.pzxPeers.peersWithoutBlock(block.Hash()) {
+		peer.AsyncSendNewBlock(block)
+	}
+}
+
+// headAnnounceLoop announces the new heads of the local chain to all the peers
+// not knowing about them yet.
+func (h *handler) headAnnounceLoop() {
+	defer h.wg.Done()
+
+	for {
+		select {
+		case ev := <-h.pzxHeadCh:
+			hash, number := ev.Header.Hash(), ev.Header.Number.Uint64()
+			for _, peer := range h.pzxPeers.peersWithoutBlock(hash) {
+				peer.AsyncSendNewHead(hash, number)
+			}
+		case <-h.pzxHeadSub.Err():
+			return
+		}
+	}
+}
+
+// voteBroadcastLoop propagates the votes entering the local vote pool to all
+// the peers not knowing about them yet.
+func (h *handler) voteBroadcastLoop() {
+	defer h.wg.Done()
+
+	for {
+		select {
+		case vote := <-h.votesCh:
+			for _, peer := range h.pzxPeers.peersWithoutVote(vote) {
+				peer.AsyncSendVotes([]*pixelzx.Vote{vote})
+			}
+		case <-h.votesSub.Err():
+			return
+		}
+	}
+}
@@ -0,0 +1,204 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pzx
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+)
+
+const (
+	// maxVotesPerPacket is the maximum number of votes a single packet may carry.
+	maxVotesPerPacket = 4096
+
+	// MaxBlocksServe is the maximum number of blocks to serve in a single
+	// response to a GetBlocksPacket.
+	MaxBlocksServe = 128
+
+	// softResponseLimit is the target maximum size of replies to data retrievals.
+	softResponseLimit = 2 * 1024 * 1024
+)
+
+// Handler is a callback to invoke from an outside runner after the boilerplate
+// exchanges have passed.
+type Handler func(peer *Peer) error
+
+// Backend defines the callback methods to invoke on remote deliveries.
+type Backend interface {
+	// Chain retrieves the blockchain object to serve data.
+	Chain() *core.BlockChain
+
+	// RunPeer is invoked when a peer joins on the `pzx` protocol. The handler
+	// should do any peer maintenance work, handshakes and validations. If all
+	// is passed, control should be given back to the `handler` to process the
+	// inbound messages going forward.
+	RunPeer(peer *Peer, handler Handler) error
+
+	// PeerInfo retrieves all known `pzx` information about a peer.
+	PeerInfo(id enode.ID) interface{}
+
+	// Handle is a callback to be invoked when a data packet is received from
+	// the remote peer.
+	Handle(peer *Peer, packet Packet) error
+}
+
+// MakeProtocols constructs the P2P protocol definitions for `pzx`.
+func MakeProtocols(backend Backend) []p2p.Protocol {
+	protocols := make([]p2p.Protocol, len(ProtocolVersions))
+	for i, version := range ProtocolVersions {
+		protocols[i] = p2p.Protocol{
+			Name:    ProtocolName,
+			Version: version,
+			Length:  protocolLengths[version],
+			Run: func(p *p2p.Peer, rw p2p.MsgReadWriter) error {
+				peer := NewPeer(version, p, rw)
+				defer peer.Close()
+
+				return backend.RunPeer(peer, func(peer *Peer) error {
+					return Handle(backend, peer)
+				})
+			},
+			PeerInfo: func(id enode.ID) interface{} {
+				return backend.PeerInfo(id)
+			},
+		}
+	}
+	return protocols
+}
+
+// Handle is the callback invoked to manage the life cycle of a `pzx` peer.
+// When this function terminates, the peer is disconnected.
+func Handle(backend Backend, peer *Peer) error {
+	for {
+		if err := HandleMessage(backend, peer); err != nil {
+			peer.Log().Debug("Message handling failed in `pzx`", "err", err)
+			return err
+		}
+	}
+}
+
+// HandleMessage is invoked whenever an inbound message is received from a
+// remote peer on the `pzx` protocol. The remote connection is torn down upon
+// returning any error.
+func HandleMessage(backend Backend, peer *Peer) error {
+	// Read the next message from the remote peer, and ensure it's fully consumed
+	msg, err := peer.rw.ReadMsg()
+	if err != nil {
+		return err
+	}
+	if msg.Size > maxMessageSize {
+		return fmt.Errorf("%w: %v > %v", errMsgTooLarge, msg.Size, maxMessageSize)
+	}
+	defer msg.Discard()
+
+	// Handle the message depending on its contents
+	switch msg.Code {
+	case VotesMsg:
+		// A batch of finality votes arrived, mark them known for the peer
+		var votes VotesPacket
+		if err := msg.Decode(&votes); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if len(votes) > maxVotesPerPacket {
+			return fmt.Errorf("%w: too many votes: %d", errDecode, len(votes))
+		}
+		for i, vote := range votes {
+			// Validate and mark the remote vote
+			if vote == nil {
+				return fmt.Errorf("%w: vote %d is nil", errDecode, i)
+			}
+			peer.MarkVote(vote.ID())
+		}
+		return backend.Handle(peer, &votes)
+
+	case NewBlockMsg:
+		// A new block was propagated, mark it known for the peer
+		block := new(NewBlockPacket)
+		if err := msg.Decode(block); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if err := block.sanityCheck(); err != nil {
+			return fmt.Errorf("%w: %v", errDecode, err)
+		}
+		peer.MarkBlock(block.Block.Hash())
+		return backend.Handle(peer, block)
+
+	case NewHeadMsg:
+		// The head of the remote chain changed, track it for syncing
+		head := new(NewHeadPacket)
+		if err := msg.Decode(head); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		peer.MarkBlock(head.Hash)
+		peer.SetHead(head.Hash, head.Number)
+		return backend.Handle(peer, head)
+
+	case GetBlocksMsg:
+		// Blocks were requested, serve them from the canonical chain
+		var req GetBlocksPacket
+		if err := msg.Decode(&req); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		blocks := ServiceGetBlocksQuery(backend.Chain(), &req)
+		return p2p.Send(peer.rw, BlocksMsg, &BlocksPacket{ID: req.ID, Blocks: blocks})
+
+	case BlocksMsg:
+		// A batch of requested blocks arrived, deliver it to the requester
+		res := new(BlocksPacket)
+		if err := msg.Decode(res); err != nil {
+			return fmt.Errorf("%w: message %v: %v", errDecode, msg, err)
+		}
+		if len(res.Blocks) > MaxBlocksServe {
+			return fmt.Errorf("%w: too many blocks: %d", errDecode, len(res.Blocks))
+		}
+		for i, block := range res.Blocks {
+			if block == nil {
+				return fmt.Errorf("%w: block %d is nil", errDecode, i)
+			}
+			if err := block.SanityCheck(); err != nil {
+				return fmt.Errorf("%w: invalid block %d: %v", errDecode, i, err)
+			}
+		}
+		peer.deliverBlocks(res)
+		return nil
+
+	default:
+		return fmt.Errorf("%w: %v", errInvalidMsgCode, msg.Code)
+	}
+}
+
+// ServiceGetBlocksQuery assembles the response to a block range query. It is
+// exposed to allow external packages to test protocol behavior.
+func ServiceGetBlocksQuery(chain *core.BlockChain, req *GetBlocksPacket) []*types.Block {
+	var (
+		blocks []*types.Block
+		bytes  uint64
+	)
+	for i := uint64(0); i < min(req.Amount, MaxBlocksServe) && bytes < softResponseLimit; i++ {
+		block := chain.GetBlockByNumber(req.Origin + i)
+		if block == nil {
+			break
+		}
+		blocks = append(blocks, block)
+		bytes += block.Size()
+	}
+	return blocks
+}
@@ -0,0 +1,136 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pzx
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/p2p"
+	"github.com/ethereum/go-ethereum/p2p/enode"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// testBackend is a pzx.Backend collecting the packets delivered to it.
+type testBackend struct {
+	chain   *core.BlockChain
+	packets chan Packet
+}
+
+func (b *testBackend) Chain() *core.BlockChain                   { return b.chain }
+func (b *testBackend) RunPeer(peer *Peer, handler Handler) error { return handler(peer) }
+func (b *testBackend) PeerInfo(id enode.ID) interface{}          { return nil }
+func (b *testBackend) Handle(peer *Peer, packet Packet) error {
+	b.packets <- packet
+	return nil
+}
+
+// Tests that votes sent by a peer are delivered to the backend and marked as
+// known to the peer, and that unknown messages tear down the connection.
+func TestHandleVotes(t *testing.T) {
+	var (
+		backend       = &testBackend{packets: make(chan Packet, 1)}
+		local, remote = p2p.MsgPipe()
+		peer          = NewFakePeer(PZX1, "0123456789abcdef", local)
+		vote          = &pixelzx.Vote{Target: pixelzx.Checkpoint{Number: 1, Hash: common.Hash{0x01}}, Signature: make([]byte, 65)}
+	)
+	defer peer.Close()
+	defer local.Close()
+
+	errc := make(chan error, 1)
+	go func() { errc <- Handle(backend, peer) }()
+
+	if err := p2p.Send(remote, VotesMsg, []*pixelzx.Vote{vote}); err != nil {
+		t.Fatalf("failed to send votes: %v", err)
+	}
+	packet := <-backend.packets
+	votes, ok := packet.(*VotesPacket)
+	if !ok || len(*votes) != 1 || (*votes)[0].ID() != vote.ID() {
+		t.Fatalf("delivered packet mismatch: have %v", packet)
+	}
+	if !peer.KnownVote(vote.ID()) {
+		t.Fatalf("received vote not marked known")
+	}
+	if err := p2p.Send(remote, 0x7f, []byte{}); err != nil {
+		t.Fatalf("failed to send message: %v", err)
+	}
+	if err := <-errc; !errors.Is(err, errInvalidMsgCode) {
+		t.Fatalf("handler error mismatch: have %v, want %v", err, errInvalidMsgCode)
+	}
+}
+
+// Tests that block ranges are requested from and served by remote peers.
+func TestRequestBlocks(t *testing.T) {
+	var (
+		gspec  = &core.Genesis{Config: params.TestChainConfig}
+		engine = ethash.NewFaker()
+	)
+	_, blocks, _ := core.GenerateChainWithGenesis(gspec, engine, 10, nil)
+	chain, err := core.NewBlockChain(rawdb.NewMemoryDatabase(), gspec, engine, nil)
+	if err != nil {
+		t.Fatalf("failed to create chain: %v", err)
+	}
+	defer chain.Stop()
+	if _, err := chain.InsertChain(blocks); err != nil {
+		t.Fatalf("failed to insert chain: %v", err)
+	}
+	var (
+		server        = &testBackend{chain: chain}
+		client        = &testBackend{packets: make(chan Packet, 1)}
+		local, remote = p2p.MsgPipe()
+		requester     = NewFakePeer(PZX1, "0123456789abcdef", local)
+		responder     = NewFakePeer(PZX1, "fedcba9876543210", remote)
+	)
+	defer requester.Close()
+	defer responder.Close()
+	defer local.Close()
+
+	go Handle(client, requester)
+	go Handle(server, responder)
+
+	tests := []struct {
+		origin, amount uint64
+		want           int
+	}{
+		{origin: 3, amount: 5, want: 5},
+		{origin: 8, amount: 5, want: 3},
+		{origin: 11, amount: 5, want: 0},
+		{origin: 1, amount: 1000, want: 10},
+	}
+	for i, tt := range tests {
+		have, err := requester.RequestBlocks(tt.origin, tt.amount)
+		if err != nil {
+			t.Fatalf("test %d: failed to request blocks: %v", i, err)
+		}
+		if len(have) != tt.want {
+			t.Fatalf("test %d: block count mismatch: have %d, want %d", i, len(have), tt.want)
+		}
+		for j, block := range have {
+			if want := blocks[tt.origin-1+uint64(j)]; block.Hash() != want.Hash() {
+				t.Fatalf("test %d: block %d mismatch: have %x, want %x", i, j, block.Hash(), want.Hash())
+			}
+			if !requester.KnownBlock(block.Hash()) {
+				t.Fatalf("test %d: delivered block %d not marked known", i, j)
+			}
+		}
+	}
+}
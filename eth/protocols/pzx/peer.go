@@ -0,0 +1,337 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package pzx
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+
+	mapset "github.com/deckarep/golang-set/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/p2p"
+)
+
+const (
+	// maxKnownVotes is the maximum vote identifiers to keep in the known list
+	// before starting to randomly evict them.
+	maxKnownVotes = 32768
+
+	// maxQueuedVotes is the maximum number of vote batches to queue up before
+	// dropping broadcasts.
+	maxQueuedVotes = 128
+
+	// maxKnownBlocks is the maximum block hashes to keep in the known list
+	// before starting to randomly evict them.
+	maxKnownBlocks = 1024
+
+	// maxQueuedBlocks is the maximum number of block propagations to queue up
+	// before dropping broadcasts.
+	maxQueuedBlocks = 4
+
+	// maxQueuedHeads is the maximum number of head announcements to queue up
+	// before dropping broadcasts.
+	maxQueuedHeads = 4
+
+	// requestTimeout is the maximum time to wait for the response to a request.
+	requestTimeout = 10 * time.Second
+)
+
+var (
+	// errRequestTimeout is returned if a peer doesn't answer a request in time.
+	errRequestTimeout = errors.New("request timed out")
+
+	// errPeerClosed is returned if a peer disconnects with a pending request.
+	errPeerClosed = errors.New("peer closed")
+)
+
+// Peer is a collection of relevant information we have about a `pzx` peer.
+type Peer struct {
+	id string // Unique ID for the peer, cached
+
+	*p2p.Peer                   // The embedded P2P package peer
+	rw        p2p.MsgReadWriter // Input/output streams for pzx
+	version   uint              // Protocol version negotiated
+
+	knownVotes     mapset.Set[common.Hash] // Set of vote identifiers known to be known by this peer
+	voteBroadcast  chan []*pixelzx.Vote    // Channel used to queue vote propagation requests
+	knownBlocks    mapset.Set[common.Hash] // Set of block hashes known to be known by this peer
+	blockBroadcast chan *types.Block       // Channel used to queue block propagation requests
+	headBroadcast  chan *NewHeadPacket     // Channel used to queue head announcements
+
+	head     common.Hash                    // Latest head block announced by the peer
+	number   uint64                         // Number of the latest head block announced by the peer
+	requests map[uint64]chan []*types.Block // Pending block requests, waiting for their response
+	lock     sync.RWMutex                   // Mutex protecting the head and the pending requests
+
+	logger log.Logger // Contextual logger with the peer id injected
+	term   chan struct{}
+}
+
+// NewPeer creates a wrapper for a network connection and negotiated  protocol
+// version.
+func NewPeer(version uint, p *p2p.Peer, rw p2p.MsgReadWriter) *Peer {
+	id := p.ID().String()
+	peer := &Peer{
+		id:             id,
+		Peer:           p,
+		rw:             rw,
+		version:        version,
+		knownVotes:     mapset.NewSet[common.Hash](),
+		voteBroadcast:  make(chan []*pixelzx.Vote, maxQueuedVotes),
+		knownBlocks:    mapset.NewSet[common.Hash](),
+		blockBroadcast: make(chan *types.Block, maxQueuedBlocks),
+		headBroadcast:  make(chan *NewHeadPacket, maxQueuedHeads),
+		requests:       make(map[uint64]chan []*types.Block),
+		logger:         log.New("peer", id[:8]),
+		term:           make(chan struct{}),
+	}
+	go peer.broadcast()
+	return peer
+}
+
+// NewFakePeer creates a fake pzx peer without a backing p2p peer, for testing purposes.
+func NewFakePeer(version uint, id string, rw p2p.MsgReadWriter) *Peer {
+	peer := &Peer{
+		id:             id,
+		rw:             rw,
+		version:        version,
+		knownVotes:     mapset.NewSet[common.Hash](),
+		voteBroadcast:  make(chan []*pixelzx.Vote, maxQueuedVotes),
+		knownBlocks:    mapset.NewSet[common.Hash](),
+		blockBroadcast: make(chan *types.Block, maxQueuedBlocks),
+		headBroadcast:  make(chan *NewHeadPacket, maxQueuedHeads),
+		requests:       make(map[uint64]chan []*types.Block),
+		logger:         log.New("peer", id[:8]),
+		term:           make(chan struct{}),
+	}
+	go peer.broadcast()
+	return peer
+}
+
+// Close signals the broadcast goroutine to terminate. Only ever call this if
+// you created the peer yourself via NewPeer. Otherwise let whoever created it
+// clean it up!
+func (p *Peer) Close() {
+	close(p.term)
+}
+
+// ID retrieves the peer's unique identifier.
+func (p *Peer) ID() string {
+	return p.id
+}
+
+// Version retrieves the peer's negotiated `pzx` protocol version.
+func (p *Peer) Version() uint {
+	return p.version
+}
+
+// Log overrides the P2P logger with the higher level one containing only the id.
+func (p *Peer) Log() log.Logger {
+	return p.logger
+}
+
+// KnownVote returns whether peer is known to already have a vote.
+func (p *Peer) KnownVote(id common.Hash) bool {
+	return p.knownVotes.Contains(id)
+}
+
+// MarkVote marks a vote as known for the peer, ensuring that it will never be
+// propagated to this particular peer.
+func (p *Peer) MarkVote(id common.Hash) {
+	// If we reached the memory allowance, drop a previously known vote
+	for p.knownVotes.Cardinality() >= maxKnownVotes {
+		p.knownVotes.Pop()
+	}
+	p.knownVotes.Add(id)
+}
+
+// SendVotes sends a batch of votes to the peer and includes them in its vote
+// set for future reference.
+func (p *Peer) SendVotes(votes []*pixelzx.Vote) error {
+	for _, vote := range votes {
+		p.MarkVote(vote.ID())
+	}
+	return p2p.Send(p.rw, VotesMsg, votes)
+}
+
+// AsyncSendVotes queues a batch of votes to eventually propagate to the remote
+// peer. If the peer's broadcast queue is full, the votes are dropped.
+func (p *Peer) AsyncSendVotes(votes []*pixelzx.Vote) {
+	select {
+	case p.voteBroadcast <- votes:
+	case <-p.term:
+		p.Log().Debug("Dropping vote propagation", "count", len(votes))
+	default:
+		p.Log().Debug("Vote propagation queue full", "count", len(votes))
+	}
+}
+
+// KnownBlock returns whether peer is known to already have a block.
+func (p *Peer) KnownBlock(hash common.Hash) bool {
+	return p.knownBlocks.Contains(hash)
+}
+
+// MarkBlock marks a block as known for the peer, ensuring that it will never be
+// propagated to this particular peer.
+func (p *Peer) MarkBlock(hash common.Hash) {
+	// If we reached the memory allowance, drop a previously known block hash
+	for p.knownBlocks.Cardinality() >= maxKnownBlocks {
+		p.knownBlocks.Pop()
+	}
+	p.knownBlocks.Add(hash)
+}
+
+// SendNewBlock propagates an entire block to the remote peer.
+func (p *Peer) SendNewBlock(block *types.Block) error {
+	p.MarkBlock(block.Hash())
+	return p2p.Send(p.rw, NewBlockMsg, &NewBlockPacket{Block: block})
+}
+
+// AsyncSendNewBlock queues an entire block for propagation to the remote peer.
+// If the peer's broadcast queue is full, the block is dropped.
+func (p *Peer) AsyncSendNewBlock(block *types.Block) {
+	select {
+	case p.blockBroadcast <- block:
+		p.MarkBlock(block.Hash())
+	case <-p.term:
+		p.Log().Debug("Dropping block propagation", "number", block.NumberU64(), "hash", block.Hash())
+	default:
+		p.Log().Debug("Block propagation queue full", "number", block.NumberU64(), "hash", block.Hash())
+	}
+}
+
+// Head retrieves the latest head block announced by the peer.
+func (p *Peer) Head() (hash common.Hash, number uint64) {
+	p.lock.RLock()
+	defer p.lock.RUnlock()
+
+	return p.head, p.number
+}
+
+// SetHead updates the head block of the peer.
+func (p *Peer) SetHead(hash common.Hash, number uint64) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.head, p.number = hash, number
+}
+
+// SendNewHead announces the head block of the local chain to the remote peer.
+func (p *Peer) SendNewHead(hash common.Hash, number uint64) error {
+	p.MarkBlock(hash)
+	return p2p.Send(p.rw, NewHeadMsg, &NewHeadPacket{Hash: hash, Number: number})
+}
+
+// AsyncSendNewHead queues a head announcement for propagation to the remote
+// peer. If the peer's broadcast queue is full, the announcement is dropped.
+func (p *Peer) AsyncSendNewHead(hash common.Hash, number uint64) {
+	select {
+	case p.headBroadcast <- &NewHeadPacket{Hash: hash, Number: number}:
+		p.MarkBlock(hash)
+	case <-p.term:
+		p.Log().Debug("Dropping head announcement", "number", number, "hash", hash)
+	default:
+		p.Log().Debug("Head announcement queue full", "number", number, "hash", hash)
+	}
+}
+
+// RequestBlocks fetches a batch of consecutive canonical blocks from the remote
+// peer, starting at the given number, and waits for the response.
+func (p *Peer) RequestBlocks(origin uint64, amount uint64) ([]*types.Block, error) {
+	var (
+		id  = rand.Uint64()
+		res = make(chan []*types.Block, 1)
+	)
+	p.lock.Lock()
+	p.requests[id] = res
+	p.lock.Unlock()
+
+	defer func() {
+		p.lock.Lock()
+		delete(p.requests, id)
+		p.lock.Unlock()
+	}()
+	p.Log().Trace("Fetching batch of blocks", "origin", origin, "amount", amount)
+	if err := p2p.Send(p.rw, GetBlocksMsg, &GetBlocksPacket{ID: id, Origin: origin, Amount: amount}); err != nil {
+		return nil, err
+	}
+	timeout := time.NewTimer(requestTimeout)
+	defer timeout.Stop()
+
+	select {
+	case blocks := <-res:
+		return blocks, nil
+	case <-timeout.C:
+		return nil, errRequestTimeout
+	case <-p.term:
+		return nil, errPeerClosed
+	}
+}
+
+// deliverBlocks hands a block response over to the pending request it answers.
+// Responses to unknown or expired requests are dropped.
+func (p *Peer) deliverBlocks(res *BlocksPacket) {
+	p.lock.RLock()
+	ch, ok := p.requests[res.ID]
+	p.lock.RUnlock()
+
+	if !ok {
+		p.Log().Debug("Dropping unrequested blocks", "id", res.ID, "count", len(res.Blocks))
+		return
+	}
+	for _, block := range res.Blocks {
+		p.MarkBlock(block.Hash())
+	}
+	select {
+	case ch <- res.Blocks:
+	default:
+	}
+}
+
+// broadcast is a write loop that sends the queued blocks and votes to the remote
+// peer, so slow peers don't lock up the node internals.
+func (p *Peer) broadcast() {
+	for {
+		select {
+		case block := <-p.blockBroadcast:
+			if err := p.SendNewBlock(block); err != nil {
+				return
+			}
+			p.Log().Trace("Propagated block", "number", block.NumberU64(), "hash", block.Hash())
+
+		case head := <-p.headBroadcast:
+			if err := p.SendNewHead(head.Hash, head.Number); err != nil {
+				return
+			}
+			p.Log().Trace("Announced head", "number", head.Number, "hash", head.Hash)
+
+		case votes := <-p.voteBroadcast:
+			if err := p.SendVotes(votes); err != nil {
+				return
+			}
+			p.Log().Trace("Sent votes", "count", len(votes))
+
+		case <-p.term:
+			return
+		}
+	}
+}
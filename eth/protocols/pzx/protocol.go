@@ -0,0 +1,126 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+// Package pzx implements the PIXELZX satellite protocol, gossiping the blocks
+// sealed by the validators and their finality votes between PIXELZX nodes, and
+// serving the blocks lagging nodes catch up with.
+package pzx
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Constants to match up protocol versions and messages
+const (
+	PZX1 = 1
+)
+
+// ProtocolName is the official short name of the `pzx` protocol used during
+// devp2p capability negotiation.
+const ProtocolName = "pzx"
+
+// ProtocolVersions are the supported versions of the `pzx` protocol (first
+// is primary).
+var ProtocolVersions = []uint{PZX1}
+
+// protocolLengths are the number of implemented message corresponding to
+// different protocol versions.
+var protocolLengths = map[uint]uint64{PZX1: 5}
+
+// maxMessageSize is the maximum cap on the size of a protocol message.
+const maxMessageSize = 10 * 1024 * 1024
+
+const (
+	VotesMsg     = 0x00
+	NewBlockMsg  = 0x01
+	NewHeadMsg   = 0x02
+	GetBlocksMsg = 0x03
+	BlocksMsg    = 0x04
+)
+
+var (
+	errMsgTooLarge    = errors.New("message too long")
+	errDecode         = errors.New("invalid message")
+	errInvalidMsgCode = errors.New("invalid message code")
+)
+
+// Packet represents a p2p message in the `pzx` protocol.
+type Packet interface {
+	Name() string // Name returns a string corresponding to the message type.
+	Kind() byte   // Kind returns the message type.
+}
+
+// VotesPacket is the network packet for propagating finality votes.
+type VotesPacket []*pixelzx.Vote
+
+func (*VotesPacket) Name() string { return "Votes" }
+func (*VotesPacket) Kind() byte   { return VotesMsg }
+
+// NewBlockPacket is the network packet for the block propagation message.
+type NewBlockPacket struct {
+	Block *types.Block
+}
+
+// sanityCheck verifies that the values are reasonable, as a DoS protection
+func (request *NewBlockPacket) sanityCheck() error {
+	if request.Block == nil {
+		return errors.New("missing block")
+	}
+	if err := request.Block.SanityCheck(); err != nil {
+		return fmt.Errorf("invalid block: %w", err)
+	}
+	return nil
+}
+
+func (*NewBlockPacket) Name() string { return "NewBlock" }
+func (*NewBlockPacket) Kind() byte   { return NewBlockMsg }
+
+// NewHeadPacket is the network packet announcing the head block of a node, sent
+// when connecting and on every head change.
+type NewHeadPacket struct {
+	Hash   common.Hash
+	Number uint64
+}
+
+func (*NewHeadPacket) Name() string { return "NewHead" }
+func (*NewHeadPacket) Kind() byte   { return NewHeadMsg }
+
+// GetBlocksPacket represents a request for a range of consecutive canonical
+// blocks, starting at the given origin number.
+type GetBlocksPacket struct {
+	ID     uint64 // Request ID to match up responses with
+	Origin uint64 // Number of the first block to retrieve
+	Amount uint64 // Maximum number of blocks to retrieve
+}
+
+func (*GetBlocksPacket) Name() string { return "GetBlocks" }
+func (*GetBlocksPacket) Kind() byte   { return GetBlocksMsg }
+
+// BlocksPacket is the network packet answering a GetBlocksPacket. The blocks
+// may be fewer than requested, if the remote chain is shorter or the response
+// size limit was reached.
+type BlocksPacket struct {
+	ID     uint64         // ID of the request this is a response for
+	Blocks []*types.Block // Consecutive canonical blocks from the requested origin
+}
+
+func (*BlocksPacket) Name() string { return "Blocks" }
+func (*BlocksPacket) Kind() byte   { return BlocksMsg }
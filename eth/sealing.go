@@ -0,0 +1,75 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/miner"
+)
+
+// errSealingUnsupported is returned if in-process sealing is requested on a
+// network not running the PIXELZX engine.
+var errSealingUnsupported = errors.New("in-process sealing is only supported on PIXELZX networks")
+
+// StartSealing authorizes the consensus engine to seal blocks and attest them
+// as the given validator, signing with its consensus key through signFn, and
+// starts producing blocks in-process on every slot the validator is allowed to
+// propose.
+func (s *Ethereum) StartSealing(validator common.Address, signFn pixelzx.SignerFn) error {
+	engine, ok := s.engine.(*pixelzx.Pixelzx)
+	if !ok {
+		return errSealingUnsupported
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.sealer == nil {
+		sealer, err := miner.NewSealer(s.miner, s.handler.broadcastBlock)
+		if err != nil {
+			return err
+		}
+		s.sealer = sealer
+	}
+	engine.Authorize(validator, signFn)
+	s.sealer.Start()
+
+	log.Info("Started in-process sealing", "validator", validator)
+	return nil
+}
+
+// StopSealing terminates the in-process block production. The engine stays
+// authorized, so the validator keeps attesting blocks for finality.
+func (s *Ethereum) StopSealing() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.sealer != nil {
+		s.sealer.Stop()
+	}
+}
+
+// IsSealing returns whether blocks are being produced in-process.
+func (s *Ethereum) IsSealing() bool {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	return s.sealer != nil && s.sealer.Sealing()
+}
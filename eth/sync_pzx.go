@@ -0,0 +1,141 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package eth
+
+import (
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/eth/protocols/pzx"
+)
+
+const (
+	// pzxSyncCycle is the interval at which the heads announced by the `pzx`
+	// peers are checked, catching up with forks and missed announcements.
+	pzxSyncCycle = 10 * time.Second
+
+	// maxBlockFetch is the number of blocks to request from a peer at once.
+	maxBlockFetch = pzx.MaxBlocksServe
+)
+
+var (
+	// errNoBlocks is returned if a peer doesn't serve the blocks leading to the
+	// head it announced.
+	errNoBlocks = errors.New("peer returned no blocks")
+
+	// errNoCommonAncestor is returned if the chain of a peer doesn't share any
+	// block with the local one, apart from the genesis.
+	errNoCommonAncestor = errors.New("no common ancestor")
+
+	// errTerminated is returned if the sync is aborted by the handler stopping.
+	errTerminated = errors.New("terminated")
+)
+
+// triggerPzxSync schedules a sync with the given `pzx` peer, unless one is
+// already pending.
+func (h *handler) triggerPzxSync(peer *pzx.Peer) {
+	select {
+	case h.pzxSyncCh <- peer:
+	default:
+	}
+}
+
+// pzxSyncLoop catches the local chain up with the heads announced by the `pzx`
+// peers. PIXELZX networks have no consensus client driving the downloader, so
+// new, restarted and lagging nodes fetch the blocks they miss from their peers,
+// leaving it to the fork choice of the engine which chain becomes canonical.
+func (h *handler) pzxSyncLoop() {
+	defer h.wg.Done()
+
+	ticker := time.NewTicker(pzxSyncCycle)
+	defer ticker.Stop()
+
+	for {
+		var peer *pzx.Peer
+		select {
+		case peer = <-h.pzxSyncCh:
+		case <-ticker.C:
+			if peer = h.pzxPeers.peerWithUnknownHead(h.chain); peer == nil {
+				// All the heads of the peers are known, we're in sync
+				if !h.synced.Load() && h.pzxPeers.len() > 0 {
+					h.enableSyncedFeatures()
+				}
+				continue
+			}
+		case <-h.quitSync:
+			return
+		}
+		h.pzxSync(peer)
+	}
+}
+
+// pzxSync imports the chain of the given peer up to the head it announced.
+func (h *handler) pzxSync(peer *pzx.Peer) {
+	hash, number := peer.Head()
+	if hash == (common.Hash{}) || h.chain.HasBlock(hash, number) {
+		return
+	}
+	peer.Log().Debug("Synchronising with peer", "number", number, "hash", hash)
+
+	start := time.Now()
+	if err := h.pzxFetch(peer, hash, number); err != nil {
+		peer.Log().Debug("Synchronisation failed", "number", number, "hash", hash, "err", err)
+		return
+	}
+	head := h.chain.CurrentBlock()
+	peer.Log().Debug("Synchronised with peer", "number", number, "hash", hash,
+		"head", head.Number, "elapsed", time.Since(start))
+
+	if !h.synced.Load() {
+		h.enableSyncedFeatures()
+	}
+}
+
+// pzxFetch fetches the canonical blocks of the peer after the local head and
+// imports them, until the announced head is reached. If the first fetched block
+// doesn't attach to the local chain, the chains forked off earlier and the
+// fetching steps back until a common ancestor is found.
+func (h *handler) pzxFetch(peer *pzx.Peer, hash common.Hash, number uint64) error {
+	origin := min(h.chain.CurrentBlock().Number.Uint64(), number) + 1
+	for !h.chain.HasBlock(hash, number) {
+		select {
+		case <-h.quitSync:
+			return errTerminated
+		default:
+		}
+		blocks, err := peer.RequestBlocks(origin, maxBlockFetch)
+		if err != nil {
+			return err
+		}
+		if len(blocks) == 0 || blocks[0].NumberU64() != origin {
+			return errNoBlocks
+		}
+		if !h.chain.HasBlock(blocks[0].ParentHash(), origin-1) {
+			if origin == 1 {
+				return errNoCommonAncestor
+			}
+			origin -= min(origin-1, maxBlockFetch)
+			continue
+		}
+		if _, err := h.chain.InsertChain(blocks); err != nil {
+			return err
+		}
+		origin = blocks[len(blocks)-1].NumberU64() + 1
+	}
+	return nil
+}
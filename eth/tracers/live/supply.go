@@ -199,13 +199,13 @@ func (s *supplyTracer) onBalanceChange(a common.Address, prevBalance, newBalance
 
 	// NOTE: don't handle "BalanceIncreaseGenesisBalance" because it is handled in OnGenesisBlock
 	switch reason {
-	case tracing.BalanceIncreaseRewardMineBlock, tracing.BalanceIncreaseRewardMineUncle:
+	case tracing.BalanceIncreaseRewardMineBlock, tracing.BalanceIncreaseRewardMineUncle, tracing.BalanceIncreasePixelzxBlockReward:
 		s.delta.Issuance.Reward.Add(s.delta.Issuance.Reward, diff)
 	case tracing.BalanceIncreaseWithdrawal:
 		s.delta.Issuance.Withdrawals.Add(s.delta.Issuance.Withdrawals, diff)
-	case tracing.BalanceDecreaseSelfdestructBurn:
+	case tracing.BalanceDecreaseSelfdestructBurn, tracing.BalanceDecreasePixelzxSlashBurn:
 		// BalanceDecreaseSelfdestructBurn is non-reversible as it happens
-		// at the end of the transaction.
+		// at the end of the transaction, slashing happens outside of them.
 		s.delta.Burn.Misc.Sub(s.delta.Burn.Misc, diff)
 	default:
 		return
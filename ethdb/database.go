@@ -82,6 +82,14 @@ type KeyValueSyncer interface {
 	SyncKeyValue() error
 }
 
+// Checkpointer wraps the Checkpoint method of a backing data store.
+type Checkpointer interface {
+	// Checkpoint writes a consistent point-in-time copy of the data store into
+	// the given directory, which must not exist yet. The data store remains
+	// writable while the checkpoint is taken.
+	Checkpoint(dir string) error
+}
+
 // Compacter wraps the Compact method of a backing data store.
 type Compacter interface {
 	// Compact flattens the underlying data store for the given key range. In essence,
@@ -17,7 +17,9 @@
 package pebble
 
 import (
+	"bytes"
 	"errors"
+	"path/filepath"
 	"testing"
 
 	"github.com/cockroachdb/pebble"
@@ -78,3 +80,37 @@ func TestPebbleLogData(t *testing.T) {
 		t.Fatal("Unknown database entry")
 	}
 }
+
+func TestPebbleCheckpoint(t *testing.T) {
+	dir := t.TempDir()
+	db, err := New(filepath.Join(dir, "db"), 16, 16, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+
+	if err := db.Put([]byte("before"), []byte{0x01}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Checkpoint(filepath.Join(dir, "checkpoint")); err != nil {
+		t.Fatalf("failed to take checkpoint: %v", err)
+	}
+	if err := db.Put([]byte("after"), []byte{0x02}); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.Checkpoint(filepath.Join(dir, "checkpoint")); err == nil {
+		t.Fatal("checkpoint overwrote an existing directory")
+	}
+	checkpoint, err := New(filepath.Join(dir, "checkpoint"), 16, 16, "", true)
+	if err != nil {
+		t.Fatalf("failed to open checkpoint: %v", err)
+	}
+	defer checkpoint.Close()
+
+	if val, err := checkpoint.Get([]byte("before")); err != nil || !bytes.Equal(val, []byte{0x01}) {
+		t.Fatalf("checkpoint entry mismatch: have %x (%v), want 01", val, err)
+	}
+	if ok, _ := checkpoint.Has([]byte("after")); ok {
+		t.Fatal("checkpoint contains write made after it was taken")
+	}
+}
@@ -18,15 +18,16 @@
 package web3ext
 
 var Modules = map[string]string{
-	"admin":  AdminJs,
-	"clique": CliqueJs,
-	"debug":  DebugJs,
-	"eth":    EthJs,
-	"miner":  MinerJs,
-	"net":    NetJs,
-	"rpc":    RpcJs,
-	"txpool": TxpoolJs,
-	"dev":    DevJs,
+	"admin":   AdminJs,
+	"clique":  CliqueJs,
+	"debug":   DebugJs,
+	"eth":     EthJs,
+	"miner":   MinerJs,
+	"net":     NetJs,
+	"pixelzx": PixelzxJs,
+	"rpc":     RpcJs,
+	"txpool":  TxpoolJs,
+	"dev":     DevJs,
 }
 
 const CliqueJs = `
@@ -86,6 +87,38 @@ web3._extend({
 });
 `
 
+const PixelzxJs = `
+web3._extend({
+	property: 'pixelzx',
+	methods: [
+		new web3._extend.Method({
+			name: 'getValidators',
+			call: 'pixelzx_getValidators',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getLiveness',
+			call: 'pixelzx_getLiveness',
+			params: 1,
+			inputFormatter: [web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getMissedSlots',
+			call: 'pixelzx_getMissedSlots',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+		new web3._extend.Method({
+			name: 'getUnbondings',
+			call: 'pixelzx_getUnbondings',
+			params: 2,
+			inputFormatter: [web3._extend.formatters.inputAddressFormatter, web3._extend.formatters.inputBlockNumberFormatter]
+		}),
+	]
+});
+`
+
 const AdminJs = `
 web3._extend({
 	property: 'admin',
@@ -116,6 +149,11 @@ web3._extend({
 			params: 3,
 			inputFormatter: [null, null, null]
 		}),
+		new web3._extend.Method({
+			name: 'checkpointDatabase',
+			call: 'admin_checkpointDatabase',
+			params: 1
+		}),
 		new web3._extend.Method({
 			name: 'importChain',
 			call: 'admin_importChain',
@@ -249,6 +287,11 @@ web3._extend({
 			inputFormatter: [null],
 			outputFormatter: console.log
 		}),
+		new web3._extend.Method({
+			name: 'metrics',
+			call: 'debug_metrics',
+			params: 0,
+		}),
 		new web3._extend.Method({
 			name: 'freeOSMemory',
 			call: 'debug_freeOSMemory',
@@ -0,0 +1,241 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/log"
+)
+
+// chainHeadChanSize is the size of channel listening to ChainHeadEvent.
+const chainHeadChanSize = 10
+
+var (
+	// errNotSlotEngine is returned if the sealer is created for a consensus engine
+	// that doesn't assign block proposals to validators.
+	errNotSlotEngine = errors.New("consensus engine does not support in-process sealing")
+
+	// errZeroPeriod is returned if the sealer is created for a chain without a
+	// block period, which would only ever seal blocks on demand.
+	errZeroPeriod = errors.New("in-process sealing requires a non-zero block period")
+)
+
+// SlotEngine is implemented by consensus engines which assign block proposals
+// to validators in fixed time slots, allowing validator nodes to produce blocks
+// without an external consensus client.
+type SlotEngine interface {
+	consensus.Engine
+
+	// Signer returns the address of the local validator the engine seals blocks
+	// with.
+	Signer() common.Address
+
+	// NextSlot returns the timestamp the local validator may propose the block
+	// on top of parent at, and whether it is the in-turn proposer of the slot.
+	// An error is returned if the validator may not propose the block at all.
+	NextSlot(chain consensus.ChainHeaderReader, parent *types.Header) (uint64, bool, error)
+}
+
+// Sealer is the in-process block production loop of validator nodes. Instead of
+// building payloads on the request of a consensus client, it follows the chain
+// head, and whenever the local validator may propose the next block, it builds
+// the block right before its slot, seals it, imports it and hands it over to be
+// broadcast to the network. The work on a slot is abandoned as soon as another
+// block becomes the chain head.
+type Sealer struct {
+	miner     *Miner
+	engine    SlotEngine
+	broadcast func(block *types.Block)
+
+	exit    chan struct{}
+	running bool
+	lock    sync.Mutex // Protects the running state
+	wg      sync.WaitGroup
+}
+
+// NewSealer creates a block production loop for the given miner, handing the
+// sealed blocks over to the broadcast callback after importing them.
+func NewSealer(miner *Miner, broadcast func(block *types.Block)) (*Sealer, error) {
+	engine, ok := miner.engine.(SlotEngine)
+	if !ok {
+		return nil, errNotSlotEngine
+	}
+	if config := miner.chainConfig.Pixelzx; config == nil || config.Period == 0 {
+		return nil, errZeroPeriod
+	}
+	return &Sealer{
+		miner:     miner,
+		engine:    engine,
+		broadcast: broadcast,
+	}, nil
+}
+
+// Start launches the block production loop, if it's not running yet.
+func (s *Sealer) Start() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.running {
+		return
+	}
+	s.running = true
+	s.exit = make(chan struct{})
+
+	s.wg.Add(1)
+	go s.loop(s.exit)
+}
+
+// Stop terminates the block production loop, interrupting the block being
+// produced, if any.
+func (s *Sealer) Stop() {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if !s.running {
+		return
+	}
+	close(s.exit)
+	s.wg.Wait()
+	s.running = false
+}
+
+// Sealing returns whether the block production loop is running.
+func (s *Sealer) Sealing() bool {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	return s.running
+}
+
+// loop follows the chain head, starting the production of a block on top of
+// every new head and interrupting the one in progress.
+func (s *Sealer) loop(exit chan struct{}) {
+	defer s.wg.Done()
+
+	var (
+		headCh  = make(chan core.ChainHeadEvent, chainHeadChanSize)
+		headSub = s.miner.chain.SubscribeChainHeadEvent(headCh)
+		parent  common.Hash
+		stop    chan struct{}
+	)
+	defer headSub.Unsubscribe()
+
+	interrupt := func() {
+		if stop != nil {
+			close(stop)
+			stop = nil
+		}
+	}
+	defer interrupt()
+
+	commit := func(head *types.Header) {
+		if head.Hash() == parent {
+			return
+		}
+		interrupt()
+		parent, stop = head.Hash(), make(chan struct{})
+
+		s.wg.Add(1)
+		go s.propose(head, stop)
+	}
+	commit(s.miner.chain.CurrentBlock())
+
+	for {
+		select {
+		case ev := <-headCh:
+			commit(ev.Header)
+		case <-headSub.Err():
+			return
+		case <-exit:
+			return
+		}
+	}
+}
+
+// propose produces the block on top of parent if the local validator may propose
+// it, waiting for its slot unless interrupted via stop.
+func (s *Sealer) propose(parent *types.Header, stop <-chan struct{}) {
+	defer s.wg.Done()
+
+	number := parent.Number.Uint64() + 1
+	timestamp, inturn, err := s.engine.NextSlot(s.miner.chain, parent)
+	if err != nil {
+		log.Trace("Not proposing block", "number", number, "err", err)
+		return
+	}
+	// Build the block right before the slot, to include the freshest transactions
+	if wait := time.Until(time.Unix(int64(timestamp), 0)) - s.miner.config.Recommit; wait > 0 {
+		select {
+		case <-time.After(wait):
+		case <-stop:
+			return
+		}
+	}
+	result := s.miner.generateWork(&generateParams{
+		timestamp:  timestamp,
+		parentHash: parent.Hash(),
+		coinbase:   s.engine.Signer(),
+		beaconRoot: new(common.Hash),
+	}, false)
+	if result.err != nil {
+		log.Error("Failed to build block", "number", number, "err", result.err)
+		return
+	}
+	// Seal the block, which waits for the slot to arrive
+	results := make(chan *types.Block, 1)
+	if err := s.engine.Seal(s.miner.chain, result.block, results, stop); err != nil {
+		log.Warn("Block sealing failed", "number", number, "err", err)
+		return
+	}
+	var block *types.Block
+	select {
+	case block = <-results:
+	case <-stop:
+		log.Debug("Block sealing interrupted", "number", number)
+		return
+	}
+	// Bail out if a competing block arrived in the meantime
+	select {
+	case <-stop:
+		log.Debug("Discarding sealed block", "number", number, "hash", block.Hash())
+		return
+	default:
+	}
+	if _, err := s.miner.chain.InsertChain(types.Blocks{block}); err != nil {
+		log.Error("Failed to import sealed block", "number", number, "hash", block.Hash(), "err", err)
+		return
+	}
+	// A competing block may have arrived meanwhile and won the fork choice, the
+	// sealed one is kept as a side block then
+	if s.miner.chain.CurrentBlock().Hash() != block.Hash() {
+		log.Info("Sealed block lost the fork choice", "number", number, "hash", block.Hash(), "inturn", inturn)
+		return
+	}
+	log.Info("Successfully sealed new block", "number", number, "hash", block.Hash(), "inturn", inturn,
+		"txs", len(block.Transactions()), "gas", block.GasUsed())
+
+	if s.broadcast != nil {
+		s.broadcast(block)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright 2025 The go-ethereum Authors
+// This file is part of the go-ethereum library.
+//
+// The go-ethereum library is free software: you can redistribute it and/or modify
+// it under the terms of the GNU Lesser General Public License as published by
+// the Free Software Foundation, either version 3 of the License, or
+// (at your option) any later version.
+//
+// The go-ethereum library is distributed in the hope that it will be useful,
+// but WITHOUT ANY WARRANTY; without even the implied warranty of
+// MERCHANTABILITY or FITNESS FOR A PARTICULAR PURPOSE. See the
+// GNU Lesser General Public License for more details.
+//
+// You should have received a copy of the GNU Lesser General Public License
+// along with the go-ethereum library. If not, see <http://www.gnu.org/licenses/>.
+
+package miner
+
+import (
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/consensus/ethash"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx/staking"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/txpool"
+	"github.com/ethereum/go-ethereum/core/txpool/legacypool"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+)
+
+// newTestSealer creates a single validator PIXELZX chain with the test bank as
+// validator, and a sealer producing its blocks.
+func newTestSealer(t *testing.T, broadcast func(block *types.Block)) (*Sealer, *testWorkerBackend) {
+	config := *params.AllPixelzxProtocolChanges
+	config.Pixelzx = &params.PixelzxConfig{Period: 1, Epoch: 8, MinValidatorStake: big.NewInt(params.PZX)}
+
+	extra := make([]byte, 32)
+	extra = append(extra, testBankAddress[:]...)
+	extra = binary.BigEndian.AppendUint64(extra, 1)
+	extra = append(extra, make([]byte, crypto.SignatureLength)...)
+
+	gspec := &core.Genesis{
+		Config:    &config,
+		ExtraData: extra,
+		BaseFee:   big.NewInt(params.InitialBaseFee),
+		Alloc: types.GenesisAlloc{
+			testBankAddress:              {Balance: testBankFunds},
+			params.PixelzxStakingAddress: staking.GenesisAccount([]staking.GenesisValidator{{Address: testBankAddress, Stake: big.NewInt(params.PZX)}}),
+		},
+	}
+	db := rawdb.NewMemoryDatabase()
+	engine := pixelzx.New(config.Pixelzx, db)
+	engine.Authorize(testBankAddress, func(account accounts.Account, mimeType string, message []byte) ([]byte, error) {
+		return crypto.Sign(crypto.Keccak256(message), testBankKey)
+	})
+	chain, err := core.NewBlockChain(db, gspec, engine, &core.BlockChainConfig{ArchiveMode: true})
+	if err != nil {
+		t.Fatalf("core.NewBlockChain failed: %v", err)
+	}
+	t.Cleanup(chain.Stop)
+
+	pool := legacypool.New(testTxPoolConfig, chain)
+	txpool, _ := txpool.New(testTxPoolConfig.PriceLimit, chain, []txpool.SubPool{pool})
+	t.Cleanup(func() { txpool.Close() })
+
+	backend := &testWorkerBackend{db: db, chain: chain, txPool: txpool, genesis: gspec}
+	sealer, err := NewSealer(New(backend, testConfig, engine), broadcast)
+	if err != nil {
+		t.Fatalf("failed to create sealer: %v", err)
+	}
+	return sealer, backend
+}
+
+// Tests that the sealer produces, imports and broadcasts the blocks of the local
+// validator, including the pending transactions.
+func TestSealerProducesBlocks(t *testing.T) {
+	broadcasts := make(chan *types.Block, 16)
+	sealer, b := newTestSealer(t, func(block *types.Block) { broadcasts <- block })
+
+	tx := types.MustSignNewTx(testBankKey, types.LatestSigner(b.genesis.Config), &types.DynamicFeeTx{
+		ChainID:   b.genesis.Config.ChainID,
+		Nonce:     0,
+		To:        &testUserAddress,
+		Value:     big.NewInt(1000),
+		Gas:       params.TxGas,
+		GasTipCap: big.NewInt(params.GWei),
+		GasFeeCap: big.NewInt(10 * params.GWei),
+	})
+	if err := b.txPool.Add([]*types.Transaction{tx}, true)[0]; err != nil {
+		t.Fatalf("failed to add transaction: %v", err)
+	}
+	sealer.Start()
+	defer sealer.Stop()
+
+	for number := uint64(1); number <= 2; number++ {
+		select {
+		case block := <-broadcasts:
+			if block.NumberU64() != number {
+				t.Fatalf("broadcast block number mismatch: have %d, want %d", block.NumberU64(), number)
+			}
+			if head := b.chain.CurrentBlock(); head.Hash() != block.Hash() {
+				t.Fatalf("broadcast block %d not imported as head", number)
+			}
+			if block.Coinbase() != testBankAddress {
+				t.Fatalf("block %d coinbase mismatch: have %x, want %x", number, block.Coinbase(), testBankAddress)
+			}
+			if number == 1 && (len(block.Transactions()) != 1 || block.Transactions()[0].Hash() != tx.Hash()) {
+				t.Fatalf("pending transaction not included")
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("block %d not sealed", number)
+		}
+	}
+	if !sealer.Sealing() {
+		t.Fatalf("sealer not reported running")
+	}
+	sealer.Stop()
+	if sealer.Sealing() {
+		t.Fatalf("sealer reported running after stop")
+	}
+}
+
+// Tests that the sealer is only available for engines assigning slots.
+func TestSealerUnsupportedEngine(t *testing.T) {
+	w, _ := newTestWorker(t, params.TestChainConfig, ethash.NewFaker(), rawdb.NewMemoryDatabase(), 0)
+	if _, err := NewSealer(w, nil); !errors.Is(err, errNotSlotEngine) {
+		t.Fatalf("error mismatch: have %v, want %v", err, errNotSlotEngine)
+	}
+}
@@ -24,6 +24,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/consensus"
+	"github.com/ethereum/go-ethereum/consensus/misc"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip1559"
 	"github.com/ethereum/go-ethereum/consensus/misc/eip4844"
 	"github.com/ethereum/go-ethereum/core"
@@ -250,6 +252,8 @@ func (miner *Miner) prepareWork(genParams *generateParams, witness bool) (*envir
 		log.Error("Failed to create sealing context", "err", err)
 		return nil, err
 	}
+	// Mutate the state according to any hard-fork specs
+	misc.ApplyPixelzxHardForks(miner.chainConfig.Pixelzx, header.Number, env.state)
 	if header.ParentBeaconRoot != nil {
 		core.ProcessBeaconBlockRoot(*header.ParentBeaconRoot, env.evm)
 	}
@@ -273,6 +277,13 @@ func (miner *Miner) makeEnv(parent *types.Header, header *types.Header, coinbase
 		}
 		state.StartPrefetcher("miner", bundle, nil)
 	}
+	// Move the gas limit towards the target governed on chain, if any, in place
+	// of the locally configured gas ceiling.
+	if limiter, ok := miner.engine.(consensus.GasLimiter); ok {
+		if target, ok := limiter.GasLimitTarget(header, state); ok {
+			header.GasLimit = core.CalcGasLimit(parent.GasLimit, target)
+		}
+	}
 	// Note the passed coinbase may be different with header.Coinbase.
 	return &environment{
 		signer:   types.MakeSigner(miner.chainConfig, header.Number, header.Time),
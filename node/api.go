@@ -25,6 +25,7 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/internal/debug"
 	"github.com/ethereum/go-ethereum/log"
+	"github.com/ethereum/go-ethereum/metrics"
 	"github.com/ethereum/go-ethereum/p2p"
 	"github.com/ethereum/go-ethereum/p2p/discover"
 	"github.com/ethereum/go-ethereum/p2p/enode"
@@ -43,6 +44,9 @@ func (n *Node) apis() []rpc.API {
 		}, {
 			Namespace: "debug",
 			Service:   &p2pDebugAPI{n},
+		}, {
+			Namespace: "debug",
+			Service:   new(metricsAPI),
 		}, {
 			Namespace: "web3",
 			Service:   &web3API{n},
@@ -338,6 +342,15 @@ func (s *web3API) Sha3(input hexutil.Bytes) hexutil.Bytes {
 	return crypto.Keccak256(input)
 }
 
+// metricsAPI provides access to the metrics collected by the node.
+type metricsAPI struct{}
+
+// Metrics retrieves the current values of all metrics in the default registry,
+// keyed by metric name. Metrics are only collected if enabled on startup.
+func (api *metricsAPI) Metrics() map[string]map[string]interface{} {
+	return metrics.DefaultRegistry.GetAll()
+}
+
 // p2pDebugAPI provides access to p2p internals for debugging.
 type p2pDebugAPI struct {
 	stack *Node
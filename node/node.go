@@ -784,6 +784,16 @@ func (db *closeTrackingDB) Close() error {
 	return db.Database.Close()
 }
 
+// Checkpoint writes a point-in-time copy of the database into the given
+// directory, if the wrapped database supports it.
+func (db *closeTrackingDB) Checkpoint(dir string) error {
+	checkpointer, ok := db.Database.(ethdb.Checkpointer)
+	if !ok {
+		return errors.New("database does not support checkpoints")
+	}
+	return checkpointer.Checkpoint(dir)
+}
+
 // wrapDatabase ensures the database will be auto-closed when Node is closed.
 func (n *Node) wrapDatabase(db ethdb.Database) ethdb.Database {
 	wrapper := &closeTrackingDB{db, n}
@@ -39,10 +39,21 @@ var (
 
 	// HoodiGenesisHash is the hash of the hoodi genesis block.
 	HoodiGenesisHash = common.HexToHash("0xa9e6de19ff638183b1328e7072a491c578786172dd9d650275cfbfb42119b186")
+
+	// PixelzxMainnetGenesisHash is the hash of the PIXELZX mainnet genesis block.
+	PixelzxMainnetGenesisHash = common.HexToHash("0x27f98c0be267d7743769972d5a68e51e971f4a2f7e861bc923659781a8d071e3")
+
+	// PixelzxTestnetGenesisHash is the hash of the PIXELZX testnet genesis block.
+	PixelzxTestnetGenesisHash = common.HexToHash("0x702a24a17f51e3a558a01062a44fb1b2a5c7f76de43f2381767b139998a95a5b")
 )
 
 func newUint64(val uint64) *uint64 { return &val }
 
+// pzx converts an amount of whole PZX into wei.
+func pzx(amount int64) *big.Int {
+	return new(big.Int).Mul(big.NewInt(amount), big.NewInt(PZX))
+}
+
 var (
 	MainnetTerminalTotalDifficulty, _ = new(big.Int).SetString("58_750_000_000_000_000_000_000", 0)
 
@@ -165,6 +176,92 @@ var (
 			Prague: DefaultPragueBlobConfig,
 		},
 	}
+	// PixelzxMainnetChainConfig contains the chain parameters to run a node on the
+	// PIXELZX main network.
+	PixelzxMainnetChainConfig = &ChainConfig{
+		ChainID:                 big.NewInt(8888),
+		HomesteadBlock:          big.NewInt(0),
+		DAOForkBlock:            nil,
+		DAOForkSupport:          false,
+		EIP150Block:             big.NewInt(0),
+		EIP155Block:             big.NewInt(0),
+		EIP158Block:             big.NewInt(0),
+		ByzantiumBlock:          big.NewInt(0),
+		ConstantinopleBlock:     big.NewInt(0),
+		PetersburgBlock:         big.NewInt(0),
+		IstanbulBlock:           big.NewInt(0),
+		MuirGlacierBlock:        big.NewInt(0),
+		BerlinBlock:             big.NewInt(0),
+		LondonBlock:             big.NewInt(0),
+		ArrowGlacierBlock:       nil,
+		GrayGlacierBlock:        nil,
+		TerminalTotalDifficulty: big.NewInt(0),
+		MergeNetsplitBlock:      nil,
+		ShanghaiTime:            newUint64(0),
+		CancunTime:              newUint64(0),
+		PragueTime:              newUint64(0),
+		Pixelzx: &PixelzxConfig{
+			Period:            3,
+			Epoch:             200,
+			MinValidatorStake: pzx(1_000_000_000),
+			MaxValidators:     125,
+			UnbondingPeriod:   604_800, // 21 days of 3 second blocks
+			DoubleSignSlash:   500,     // 5% of the self stake
+			LivenessWindow:    1_200,   // 1 hour of 3 second blocks
+			MaxMissedSlots:    100,
+			RewardSchedule: []PixelzxReward{
+				{Block: big.NewInt(0), Reward: pzx(2)},
+				{Block: big.NewInt(10_512_000), Reward: pzx(1)}, // ~1 year
+				{Block: big.NewInt(21_024_000), Reward: new(big.Int).Div(pzx(1), big.NewInt(2))},
+			},
+		},
+		BlobScheduleConfig: &BlobScheduleConfig{
+			Cancun: DefaultCancunBlobConfig,
+			Prague: DefaultPragueBlobConfig,
+		},
+	}
+	// PixelzxTestnetChainConfig contains the chain parameters to run a node on the
+	// PIXELZX test network.
+	PixelzxTestnetChainConfig = &ChainConfig{
+		ChainID:                 big.NewInt(8889),
+		HomesteadBlock:          big.NewInt(0),
+		DAOForkBlock:            nil,
+		DAOForkSupport:          false,
+		EIP150Block:             big.NewInt(0),
+		EIP155Block:             big.NewInt(0),
+		EIP158Block:             big.NewInt(0),
+		ByzantiumBlock:          big.NewInt(0),
+		ConstantinopleBlock:     big.NewInt(0),
+		PetersburgBlock:         big.NewInt(0),
+		IstanbulBlock:           big.NewInt(0),
+		MuirGlacierBlock:        big.NewInt(0),
+		BerlinBlock:             big.NewInt(0),
+		LondonBlock:             big.NewInt(0),
+		ArrowGlacierBlock:       nil,
+		GrayGlacierBlock:        nil,
+		TerminalTotalDifficulty: big.NewInt(0),
+		MergeNetsplitBlock:      nil,
+		ShanghaiTime:            newUint64(0),
+		CancunTime:              newUint64(0),
+		PragueTime:              newUint64(0),
+		Pixelzx: &PixelzxConfig{
+			Period:            3,
+			Epoch:             200,
+			MinValidatorStake: pzx(100_000),
+			MaxValidators:     10,
+			UnbondingPeriod:   1_200, // 1 hour of 3 second blocks
+			DoubleSignSlash:   500,   // 5% of the self stake
+			LivenessWindow:    1_200, // 1 hour of 3 second blocks
+			MaxMissedSlots:    100,
+			RewardSchedule: []PixelzxReward{
+				{Block: big.NewInt(0), Reward: pzx(2)},
+			},
+		},
+		BlobScheduleConfig: &BlobScheduleConfig{
+			Cancun: DefaultCancunBlobConfig,
+			Prague: DefaultPragueBlobConfig,
+		},
+	}
 	// AllEthashProtocolChanges contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers into the Ethash consensus.
 	AllEthashProtocolChanges = &ChainConfig{
@@ -250,6 +347,41 @@ var (
 		Clique:                  &CliqueConfig{Period: 0, Epoch: 30000},
 	}
 
+	// AllPixelzxProtocolChanges contains every protocol change (EIPs) introduced
+	// and accepted by the Ethereum core developers into the PIXELZX consensus.
+	AllPixelzxProtocolChanges = &ChainConfig{
+		ChainID:                 big.NewInt(1337),
+		HomesteadBlock:          big.NewInt(0),
+		DAOForkBlock:            nil,
+		DAOForkSupport:          false,
+		EIP150Block:             big.NewInt(0),
+		EIP155Block:             big.NewInt(0),
+		EIP158Block:             big.NewInt(0),
+		ByzantiumBlock:          big.NewInt(0),
+		ConstantinopleBlock:     big.NewInt(0),
+		PetersburgBlock:         big.NewInt(0),
+		IstanbulBlock:           big.NewInt(0),
+		MuirGlacierBlock:        big.NewInt(0),
+		BerlinBlock:             big.NewInt(0),
+		LondonBlock:             big.NewInt(0),
+		ArrowGlacierBlock:       nil,
+		GrayGlacierBlock:        nil,
+		MergeNetsplitBlock:      nil,
+		ShanghaiTime:            newUint64(0),
+		CancunTime:              newUint64(0),
+		PragueTime:              newUint64(0),
+		OsakaTime:               nil,
+		VerkleTime:              nil,
+		TerminalTotalDifficulty: big.NewInt(0),
+		Ethash:                  nil,
+		Clique:                  nil,
+		Pixelzx:                 &PixelzxConfig{Period: 0, Epoch: 200},
+		BlobScheduleConfig: &BlobScheduleConfig{
+			Cancun: DefaultCancunBlobConfig,
+			Prague: DefaultPragueBlobConfig,
+		},
+	}
+
 	// TestChainConfig contains every protocol change (EIPs) introduced
 	// and accepted by the Ethereum core developers for testing purposes.
 	TestChainConfig = &ChainConfig{
@@ -380,6 +512,9 @@ var NetworkNames = map[string]string{
 	SepoliaChainConfig.ChainID.String(): "sepolia",
 	HoleskyChainConfig.ChainID.String(): "holesky",
 	HoodiChainConfig.ChainID.String():   "hoodi",
+
+	PixelzxMainnetChainConfig.ChainID.String(): "pixelzx",
+	PixelzxTestnetChainConfig.ChainID.String(): "pixelzx-testnet",
 }
 
 // ChainConfig is the core config which determines the blockchain settings.
@@ -446,6 +581,7 @@ type ChainConfig struct {
 	// Various consensus engines
 	Ethash             *EthashConfig       `json:"ethash,omitempty"`
 	Clique             *CliqueConfig       `json:"clique,omitempty"`
+	Pixelzx            *PixelzxConfig      `json:"pixelzx,omitempty"`
 	BlobScheduleConfig *BlobScheduleConfig `json:"blobSchedule,omitempty"`
 }
 
@@ -468,6 +604,187 @@ func (c CliqueConfig) String() string {
 	return fmt.Sprintf("clique(period: %d, epoch: %d)", c.Period, c.Epoch)
 }
 
+// PixelzxConfig is the consensus engine configs for PIXELZX proof-of-stake
+// based sealing.
+type PixelzxConfig struct {
+	Period            uint64          `json:"period"`            // Number of seconds between blocks to enforce
+	Epoch             uint64          `json:"epoch"`             // Epoch length to checkpoint and rotate the validator set
+	MinValidatorStake *big.Int        `json:"minValidatorStake"` // Minimum self-stake (in wei) to become a validator
+	MaxValidators     uint64          `json:"maxValidators"`     // Maximum number of active validators per epoch
+	UnbondingPeriod   uint64          `json:"unbondingPeriod"`   // Number of blocks undelegated stake stays locked and slashable
+	RewardSchedule    []PixelzxReward `json:"rewardSchedule"`    // Block rewards issued to proposers, by activation block
+
+	Treasury      *common.Address `json:"treasury,omitempty"`      // Community treasury receiving a share of the block rewards (optional)
+	TreasuryShare uint64          `json:"treasuryShare,omitempty"` // Share of the block rewards paid to the treasury, in basis points
+
+	DoubleSignSlash uint64 `json:"doubleSignSlash,omitempty"` // Share of the self stake slashed for double signing, in basis points
+	LivenessWindow  uint64 `json:"livenessWindow,omitempty"`  // Number of recent blocks the missed proposal slots are counted over (0 = disabled)
+	MaxMissedSlots  uint64 `json:"maxMissedSlots,omitempty"`  // Missed slots within the window above which a validator is jailed
+
+	VotingPeriod   uint64 `json:"votingPeriod,omitempty"`   // Number of blocks governance proposals are open for voting (0 = governance disabled)
+	ExecutionDelay uint64 `json:"executionDelay,omitempty"` // Number of blocks approved proposals are queued for before being applied
+
+	GovernanceBlock *big.Int `json:"governanceBlock,omitempty"` // Block enabling governance on networks launched without it (nil = from genesis)
+}
+
+// PixelzxReward is an entry of the PIXELZX block reward schedule: starting from
+// the given block, every block issues the given amount of wei.
+type PixelzxReward struct {
+	Block  *big.Int `json:"block"`  // First block issuing the reward
+	Reward *big.Int `json:"reward"` // Amount of wei issued per block
+}
+
+// String implements the stringer interface, returning the consensus engine details.
+func (c PixelzxConfig) String() string {
+	return fmt.Sprintf("pixelzx(period: %d, epoch: %d, minStake: %v, maxValidators: %d, unbonding: %d)",
+		c.Period, c.Epoch, c.MinValidatorStake, c.MaxValidators, c.UnbondingPeriod)
+}
+
+// governanceBlock returns the block on-chain governance is enabled from, or nil
+// if it is not enabled.
+func (c *PixelzxConfig) governanceBlock() *big.Int {
+	if c.VotingPeriod == 0 {
+		return nil
+	}
+	if c.GovernanceBlock == nil {
+		return common.Big0
+	}
+	return c.GovernanceBlock
+}
+
+// IsGovernance returns whether on-chain governance is enabled at the given block.
+func (c *PixelzxConfig) IsGovernance(num *big.Int) bool {
+	return isBlockForked(c.governanceBlock(), num)
+}
+
+// BlockReward returns the amount of wei issued by the block with the given number,
+// according to the reward schedule.
+func (c *PixelzxConfig) BlockReward(number *big.Int) *big.Int {
+	reward := new(big.Int)
+	for _, entry := range c.RewardSchedule {
+		if entry.Block.Cmp(number) > 0 {
+			break
+		}
+		reward.Set(entry.Reward)
+	}
+	return reward
+}
+
+// validate checks the consistency of the PIXELZX consensus parameters.
+func (c *PixelzxConfig) validate() error {
+	if c.MinValidatorStake != nil && c.MinValidatorStake.Sign() < 0 {
+		return errors.New("negative minValidatorStake")
+	}
+	if c.TreasuryShare > 10_000 {
+		return fmt.Errorf("treasuryShare %d above 10000 basis points", c.TreasuryShare)
+	}
+	if c.TreasuryShare > 0 && c.Treasury == nil {
+		return errors.New("treasuryShare set without treasury address")
+	}
+	if c.DoubleSignSlash > 10_000 {
+		return fmt.Errorf("doubleSignSlash %d above 10000 basis points", c.DoubleSignSlash)
+	}
+	if c.LivenessWindow > 0 && c.MaxMissedSlots >= c.LivenessWindow {
+		return fmt.Errorf("maxMissedSlots %d not below livenessWindow %d", c.MaxMissedSlots, c.LivenessWindow)
+	}
+	// Stake undelegated during a vote must stay bonded until the vote ends, or
+	// it could be withdrawn, bonded again and counted twice
+	if c.VotingPeriod > c.UnbondingPeriod {
+		return fmt.Errorf("votingPeriod %d above unbondingPeriod %d", c.VotingPeriod, c.UnbondingPeriod)
+	}
+	if c.GovernanceBlock != nil {
+		if c.GovernanceBlock.Sign() < 0 {
+			return errors.New("negative governanceBlock")
+		}
+		if c.VotingPeriod == 0 {
+			return errors.New("governanceBlock set without votingPeriod")
+		}
+	}
+	for i, entry := range c.RewardSchedule {
+		if entry.Block == nil || entry.Reward == nil {
+			return fmt.Errorf("rewardSchedule entry %d incomplete", i)
+		}
+		if entry.Block.Sign() < 0 || entry.Reward.Sign() < 0 {
+			return fmt.Errorf("rewardSchedule entry %d negative", i)
+		}
+		if i > 0 && c.RewardSchedule[i-1].Block.Cmp(entry.Block) >= 0 {
+			return fmt.Errorf("unsupported rewardSchedule ordering: entry %d at block %v, but entry %d at block %v",
+				i-1, c.RewardSchedule[i-1].Block, i, entry.Block)
+		}
+	}
+	return nil
+}
+
+// checkCompatible checks whether the PIXELZX consensus parameters may be changed
+// on a chain already at the given head. The consensus rules apply from genesis
+// on, apart from the reward schedule entries and governance, which are scheduled
+// like forks.
+func (c *PixelzxConfig) checkCompatible(newcfg *PixelzxConfig, headNumber *big.Int) *ConfigCompatError {
+	if headNumber.Sign() == 0 {
+		return nil
+	}
+	genesis := new(big.Int)
+	if (c == nil) != (newcfg == nil) {
+		return newBlockCompatError("PIXELZX consensus engine", genesis, genesis)
+	}
+	if c == nil {
+		return nil
+	}
+	switch {
+	case c.Period != newcfg.Period:
+		return newBlockCompatError("PIXELZX block period", genesis, genesis)
+	case c.Epoch != newcfg.Epoch:
+		return newBlockCompatError("PIXELZX epoch length", genesis, genesis)
+	case !configBlockEqual(c.MinValidatorStake, newcfg.MinValidatorStake):
+		return newBlockCompatError("PIXELZX minimum validator stake", genesis, genesis)
+	case c.MaxValidators != newcfg.MaxValidators:
+		return newBlockCompatError("PIXELZX maximum validator count", genesis, genesis)
+	case c.UnbondingPeriod != newcfg.UnbondingPeriod:
+		return newBlockCompatError("PIXELZX unbonding period", genesis, genesis)
+	case (c.Treasury == nil) != (newcfg.Treasury == nil) || (c.Treasury != nil && *c.Treasury != *newcfg.Treasury):
+		return newBlockCompatError("PIXELZX treasury", genesis, genesis)
+	case c.TreasuryShare != newcfg.TreasuryShare:
+		return newBlockCompatError("PIXELZX treasury share", genesis, genesis)
+	case c.DoubleSignSlash != newcfg.DoubleSignSlash:
+		return newBlockCompatError("PIXELZX double sign slash", genesis, genesis)
+	case c.LivenessWindow != newcfg.LivenessWindow:
+		return newBlockCompatError("PIXELZX liveness window", genesis, genesis)
+	case c.MaxMissedSlots != newcfg.MaxMissedSlots:
+		return newBlockCompatError("PIXELZX maximum missed slots", genesis, genesis)
+	}
+	// The governance parameters may change until governance is enabled
+	stored, next := c.governanceBlock(), newcfg.governanceBlock()
+	if isForkBlockIncompatible(stored, next, headNumber) {
+		return newBlockCompatError("PIXELZX governance fork block", stored, next)
+	}
+	if isBlockForked(stored, headNumber) {
+		switch {
+		case c.VotingPeriod != newcfg.VotingPeriod:
+			return newBlockCompatError("PIXELZX governance voting period", stored, next)
+		case c.ExecutionDelay != newcfg.ExecutionDelay:
+			return newBlockCompatError("PIXELZX governance execution delay", stored, next)
+		}
+	}
+	for i := 0; i < len(c.RewardSchedule) || i < len(newcfg.RewardSchedule); i++ {
+		var stored, next PixelzxReward
+		if i < len(c.RewardSchedule) {
+			stored = c.RewardSchedule[i]
+		}
+		if i < len(newcfg.RewardSchedule) {
+			next = newcfg.RewardSchedule[i]
+		}
+		if configBlockEqual(stored.Block, next.Block) && configBlockEqual(stored.Reward, next.Reward) {
+			continue
+		}
+		// The first differing entry determines the first block issuing a different reward
+		if isBlockForked(stored.Block, headNumber) || isBlockForked(next.Block, headNumber) {
+			return newBlockCompatError("PIXELZX reward schedule", stored.Block, next.Block)
+		}
+		break
+	}
+	return nil
+}
+
 // Description returns a human-readable description of ChainConfig.
 func (c *ChainConfig) Description() string {
 	var banner string
@@ -479,6 +796,8 @@ func (c *ChainConfig) Description() string {
 	}
 	banner += fmt.Sprintf("Chain ID:  %v (%s)\n", c.ChainID, network)
 	switch {
+	case c.Pixelzx != nil:
+		banner += "Consensus: PIXELZX (proof-of-stake)\n"
 	case c.Ethash != nil:
 		banner += "Consensus: Beacon (proof-of-stake), merged from Ethash (proof-of-work)\n"
 	case c.Clique != nil:
@@ -558,6 +877,11 @@ func (c *ChainConfig) Description() string {
 	if c.BPO5Time != nil {
 		banner += fmt.Sprintf(" - BPO5:                      @%-10v\n", *c.BPO5Time)
 	}
+	if c.Pixelzx != nil && c.Pixelzx.GovernanceBlock != nil {
+		banner += "\n"
+		banner += "PIXELZX hard forks (block based):\n"
+		banner += fmt.Sprintf(" - Governance:                  #%-8v\n", c.Pixelzx.GovernanceBlock)
+	}
 	return banner
 }
 
@@ -643,6 +967,11 @@ func (c *ChainConfig) IsLondon(num *big.Int) bool {
 	return isBlockForked(c.LondonBlock, num)
 }
 
+// IsPixelzxGovernance returns whether num enables the PIXELZX on-chain governance.
+func (c *ChainConfig) IsPixelzxGovernance(num *big.Int) bool {
+	return c.Pixelzx != nil && c.Pixelzx.IsGovernance(num)
+}
+
 // IsArrowGlacier returns whether num is either equal to the Arrow Glacier (EIP-4345) fork block or greater.
 func (c *ChainConfig) IsArrowGlacier(num *big.Int) bool {
 	return isBlockForked(c.ArrowGlacierBlock, num)
@@ -827,6 +1156,16 @@ func (c *ChainConfig) CheckConfigForkOrder() error {
 		}
 	}
 
+	// Check that the PIXELZX consensus parameters are sane.
+	if c.Pixelzx != nil {
+		if c.Ethash != nil || c.Clique != nil {
+			return errors.New("invalid chain configuration: pixelzx combined with another consensus engine")
+		}
+		if err := c.Pixelzx.validate(); err != nil {
+			return fmt.Errorf("invalid chain configuration in pixelzx: %v", err)
+		}
+	}
+
 	// Check that all forks with blobs explicitly define the blob schedule configuration.
 	bsc := c.BlobScheduleConfig
 	if bsc == nil {
@@ -960,6 +1299,9 @@ func (c *ChainConfig) checkCompatible(newcfg *ChainConfig, headNumber *big.Int,
 	if isForkTimestampIncompatible(c.BPO5Time, newcfg.BPO5Time, headTimestamp) {
 		return newTimestampCompatError("BPO5 fork timestamp", c.BPO5Time, newcfg.BPO5Time)
 	}
+	if err := c.Pixelzx.checkCompatible(newcfg.Pixelzx, headNumber); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -1185,6 +1527,7 @@ type Rules struct {
 	IsBerlin, IsLondon                                      bool
 	IsMerge, IsShanghai, IsCancun, IsPrague, IsOsaka        bool
 	IsVerkle                                                bool
+	IsPixelzxGovernance                                     bool
 }
 
 // Rules ensures c's ChainID is not nil.
@@ -1193,6 +1536,12 @@ func (c *ChainConfig) Rules(num *big.Int, isMerge bool, timestamp uint64) Rules
 	if chainID == nil {
 		chainID = new(big.Int)
 	}
+	// PIXELZX uses the block difficulty to weigh in-turn blocks in its fork choice
+	// rather than to signal the merge. It has no proof-of-work phase, so always
+	// runs with the post-merge rules.
+	if c.Pixelzx != nil {
+		isMerge = true
+	}
 	// disallow setting Merge out of order
 	isMerge = isMerge && c.IsLondon(num)
 	isVerkle := isMerge && c.IsVerkle(num, timestamp)
@@ -1216,5 +1565,7 @@ func (c *ChainConfig) Rules(num *big.Int, isMerge bool, timestamp uint64) Rules
 		IsOsaka:          isMerge && c.IsOsaka(num, timestamp),
 		IsVerkle:         isVerkle,
 		IsEIP4762:        isVerkle,
+
+		IsPixelzxGovernance: c.IsPixelzxGovernance(num),
 	}
 }
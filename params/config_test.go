@@ -17,12 +17,14 @@
 package params
 
 import (
+	"encoding/json"
 	"math"
 	"math/big"
 	"reflect"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/stretchr/testify/require"
 )
 
@@ -110,6 +112,68 @@ func TestCheckCompatible(t *testing.T) {
 				RewindToTime: 9,
 			},
 		},
+		{
+			stored:    &ChainConfig{Pixelzx: &PixelzxConfig{Period: 3, Epoch: 200}},
+			new:       &ChainConfig{Pixelzx: &PixelzxConfig{Period: 3, Epoch: 100}},
+			headBlock: 0,
+			wantErr:   nil,
+		},
+		{
+			stored:    &ChainConfig{Pixelzx: &PixelzxConfig{Period: 3, Epoch: 200}},
+			new:       &ChainConfig{Pixelzx: &PixelzxConfig{Period: 3, Epoch: 100}},
+			headBlock: 10,
+			wantErr: &ConfigCompatError{
+				What:          "PIXELZX epoch length",
+				StoredBlock:   big.NewInt(0),
+				NewBlock:      big.NewInt(0),
+				RewindToBlock: 0,
+			},
+		},
+		{
+			stored:    &ChainConfig{Pixelzx: &PixelzxConfig{RewardSchedule: []PixelzxReward{{Block: big.NewInt(0), Reward: big.NewInt(2)}, {Block: big.NewInt(100), Reward: big.NewInt(1)}}}},
+			new:       &ChainConfig{Pixelzx: &PixelzxConfig{RewardSchedule: []PixelzxReward{{Block: big.NewInt(0), Reward: big.NewInt(2)}, {Block: big.NewInt(200), Reward: big.NewInt(1)}}}},
+			headBlock: 50,
+			wantErr:   nil,
+		},
+		{
+			stored:    &ChainConfig{Pixelzx: &PixelzxConfig{RewardSchedule: []PixelzxReward{{Block: big.NewInt(0), Reward: big.NewInt(2)}, {Block: big.NewInt(100), Reward: big.NewInt(1)}}}},
+			new:       &ChainConfig{Pixelzx: &PixelzxConfig{RewardSchedule: []PixelzxReward{{Block: big.NewInt(0), Reward: big.NewInt(2)}, {Block: big.NewInt(200), Reward: big.NewInt(1)}}}},
+			headBlock: 150,
+			wantErr: &ConfigCompatError{
+				What:          "PIXELZX reward schedule",
+				StoredBlock:   big.NewInt(100),
+				NewBlock:      big.NewInt(200),
+				RewindToBlock: 99,
+			},
+		},
+		{
+			stored:    &ChainConfig{Pixelzx: &PixelzxConfig{UnbondingPeriod: 100}},
+			new:       &ChainConfig{Pixelzx: &PixelzxConfig{UnbondingPeriod: 100, VotingPeriod: 50, GovernanceBlock: big.NewInt(100)}},
+			headBlock: 50,
+			wantErr:   nil,
+		},
+		{
+			stored:    &ChainConfig{Pixelzx: &PixelzxConfig{UnbondingPeriod: 100}},
+			new:       &ChainConfig{Pixelzx: &PixelzxConfig{UnbondingPeriod: 100, VotingPeriod: 50, GovernanceBlock: big.NewInt(100)}},
+			headBlock: 150,
+			wantErr: &ConfigCompatError{
+				What:          "PIXELZX governance fork block",
+				StoredBlock:   nil,
+				NewBlock:      big.NewInt(100),
+				RewindToBlock: 99,
+			},
+		},
+		{
+			stored:    &ChainConfig{Pixelzx: &PixelzxConfig{UnbondingPeriod: 100, VotingPeriod: 50, GovernanceBlock: big.NewInt(100)}},
+			new:       &ChainConfig{Pixelzx: &PixelzxConfig{UnbondingPeriod: 100, VotingPeriod: 80, GovernanceBlock: big.NewInt(100)}},
+			headBlock: 150,
+			wantErr: &ConfigCompatError{
+				What:          "PIXELZX governance voting period",
+				StoredBlock:   big.NewInt(100),
+				NewBlock:      big.NewInt(100),
+				RewindToBlock: 99,
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -155,3 +219,62 @@ func TestTimestampCompatError(t *testing.T) {
 	require.Equal(t, newTimestampCompatError(errWhat, newUint64(0), newUint64(1681338455)).Error(),
 		"mismatching Shanghai fork timestamp in database (have timestamp 0, want timestamp 1681338455, rewindto timestamp 0)")
 }
+
+func TestPixelzxConfig(t *testing.T) {
+	// Verify the reward schedule lookups
+	config := PixelzxMainnetChainConfig.Pixelzx
+	for _, tt := range []struct {
+		number uint64
+		want   *big.Int
+	}{
+		{0, pzx(2)},
+		{10_511_999, pzx(2)},
+		{10_512_000, pzx(1)},
+		{21_024_000, big.NewInt(PZX / 2)},
+		{math.MaxInt64, big.NewInt(PZX / 2)},
+	} {
+		if have := config.BlockReward(new(big.Int).SetUint64(tt.number)); have.Cmp(tt.want) != 0 {
+			t.Errorf("block %d: reward mismatch: have %v, want %v", tt.number, have, tt.want)
+		}
+	}
+	// Verify that the built-in networks pass validation and unsorted schedules don't
+	for _, config := range []*ChainConfig{PixelzxMainnetChainConfig, PixelzxTestnetChainConfig, AllPixelzxProtocolChanges} {
+		require.NoError(t, config.CheckConfigForkOrder())
+	}
+	invalid := *PixelzxTestnetChainConfig
+	invalid.Pixelzx = &PixelzxConfig{Period: 3, Epoch: 200, RewardSchedule: []PixelzxReward{
+		{Block: big.NewInt(10), Reward: big.NewInt(1)},
+		{Block: big.NewInt(10), Reward: big.NewInt(2)},
+	}}
+	require.Error(t, invalid.CheckConfigForkOrder())
+
+	invalid.Pixelzx = &PixelzxConfig{Period: 3, Epoch: 200, TreasuryShare: 1000}
+	require.Error(t, invalid.CheckConfigForkOrder())
+
+	invalid.Pixelzx = &PixelzxConfig{Period: 3, Epoch: 200, Treasury: &common.Address{0x01}, TreasuryShare: 10_001}
+	require.Error(t, invalid.CheckConfigForkOrder())
+
+	invalid.Pixelzx = &PixelzxConfig{Period: 3, Epoch: 200, DoubleSignSlash: 10_001}
+	require.Error(t, invalid.CheckConfigForkOrder())
+
+	invalid.Pixelzx = &PixelzxConfig{Period: 3, Epoch: 200, LivenessWindow: 100, MaxMissedSlots: 100}
+	require.Error(t, invalid.CheckConfigForkOrder())
+
+	invalid.Pixelzx = &PixelzxConfig{Period: 3, Epoch: 200, UnbondingPeriod: 100, VotingPeriod: 101}
+	require.Error(t, invalid.CheckConfigForkOrder())
+
+	invalid.Pixelzx = &PixelzxConfig{Period: 3, Epoch: 200, GovernanceBlock: big.NewInt(100)}
+	require.Error(t, invalid.CheckConfigForkOrder())
+
+	invalid.Pixelzx = PixelzxTestnetChainConfig.Pixelzx
+	invalid.Clique = &CliqueConfig{Period: 3, Epoch: 200}
+	require.Error(t, invalid.CheckConfigForkOrder())
+
+	// Verify that the config survives a JSON round trip
+	blob, err := json.Marshal(PixelzxMainnetChainConfig)
+	require.NoError(t, err)
+
+	var decoded ChainConfig
+	require.NoError(t, json.Unmarshal(blob, &decoded))
+	require.Equal(t, PixelzxMainnetChainConfig.Pixelzx, decoded.Pixelzx)
+}
@@ -303,6 +303,14 @@ var (
 		accounts.MimetypeClique,
 		0x02,
 	}
+	ApplicationPixelzx = SigFormat{
+		accounts.MimetypePixelzx,
+		0x02,
+	}
+	ApplicationPixelzxVote = SigFormat{
+		accounts.MimetypePixelzxVote,
+		0x02,
+	}
 	TextPlain = SigFormat{
 		accounts.MimetypeTextPlain,
 		0x45,
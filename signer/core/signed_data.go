@@ -27,6 +27,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/consensus/clique"
+	"github.com/ethereum/go-ethereum/consensus/pixelzx"
 	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/rlp"
@@ -164,6 +165,57 @@ func (api *SignerAPI) determineSignatureFormat(ctx context.Context, contentType
 		// Clique uses V on the form 0 or 1
 		useEthereumV = false
 		req = &SignDataRequest{ContentType: mediaType, Rawdata: cliqueRlp, Messages: messages, Hash: sighash}
+	case apitypes.ApplicationPixelzx.Mime:
+		// PIXELZX headers are sealed like Clique ones, by the validator
+		pixelzxData, err := fromHex(data)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		header := &types.Header{}
+		if err := rlp.DecodeBytes(pixelzxData, header); err != nil {
+			return nil, useEthereumV, err
+		}
+		// Add space in the extradata to put the signature
+		newExtra := make([]byte, len(header.Extra)+65)
+		copy(newExtra, header.Extra)
+		header.Extra = newExtra
+
+		// Get back the rlp data, encoded by us
+		sighash, pixelzxRlp := pixelzx.SealHash(header).Bytes(), pixelzx.PixelzxRLP(header)
+		messages := []*apitypes.NameValueType{
+			{
+				Name:  "PIXELZX header",
+				Typ:   "pixelzx",
+				Value: fmt.Sprintf("pixelzx header %d [%#x]", header.Number, header.Hash()),
+			},
+		}
+		// PIXELZX uses V on the form 0 or 1
+		useEthereumV = false
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: pixelzxRlp, Messages: messages, Hash: sighash}
+	case apitypes.ApplicationPixelzxVote.Mime:
+		// PIXELZX votes attest a block for finality
+		voteData, err := fromHex(data)
+		if err != nil {
+			return nil, useEthereumV, err
+		}
+		var vote struct {
+			Source pixelzx.Checkpoint
+			Target pixelzx.Checkpoint
+		}
+		if err := rlp.DecodeBytes(voteData, &vote); err != nil {
+			return nil, useEthereumV, err
+		}
+		voteRlp := pixelzx.VoteRLP(vote.Source, vote.Target)
+		messages := []*apitypes.NameValueType{
+			{
+				Name:  "PIXELZX vote",
+				Typ:   "pixelzx",
+				Value: fmt.Sprintf("pixelzx vote for block %d [%#x] from block %d [%#x]", vote.Target.Number, vote.Target.Hash, vote.Source.Number, vote.Source.Hash),
+			},
+		}
+		// PIXELZX uses V on the form 0 or 1
+		useEthereumV = false
+		req = &SignDataRequest{ContentType: mediaType, Rawdata: voteRlp, Messages: messages, Hash: crypto.Keccak256(voteRlp)}
 	case apitypes.DataTyped.Mime:
 		// EIP-712 conformant typed data
 		var err error